@@ -0,0 +1,278 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	anyFullName            = (*obs_grpc.Any)(nil).ProtoReflect().Descriptor().FullName()
+	abstractObjectFullName = (*obs_grpc.AbstractObject)(nil).ProtoReflect().Descriptor().FullName()
+)
+
+// splitArgs splits a command line into words. Words may be quoted with
+// single or double quotes, and a backslash escapes the next character
+// (except within single quotes).
+func splitArgs(line string) ([]string, error) {
+	var (
+		result  []string
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+	for _, c := range line {
+		switch {
+		case escaped:
+			word.WriteRune(c)
+			escaped = false
+		case c == '\\' && quote != '\'':
+			escaped = true
+			inWord = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+				continue
+			}
+			word.WriteRune(c)
+		case c == '"' || c == '\'':
+			quote = c
+			inWord = true
+		case c == ' ' || c == '\t':
+			if inWord {
+				result = append(result, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(c)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote %c", quote)
+	}
+	if escaped {
+		return nil, fmt.Errorf("unterminated escape sequence")
+	}
+	if inWord {
+		result = append(result, word.String())
+	}
+	return result, nil
+}
+
+// setArgs sets the fields of the message from arguments in format
+// "key=value". The key is a dot-separated path of field names (or keys
+// of a map), for example "inputSettings.device_id=default".
+func setArgs(msg protoreflect.Message, args []string) error {
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return fmt.Errorf("invalid argument '%s': expected format 'key=value'", arg)
+		}
+		if err := setByPath(msg, strings.Split(key, "."), value); err != nil {
+			return fmt.Errorf("unable to set '%s': %w", key, err)
+		}
+	}
+	return nil
+}
+
+func findField(
+	desc protoreflect.MessageDescriptor,
+	name string,
+) protoreflect.FieldDescriptor {
+	fields := desc.Fields()
+	if fd := fields.ByName(protoreflect.Name(name)); fd != nil {
+		return fd
+	}
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if strings.EqualFold(string(fd.Name()), name) {
+			return fd
+		}
+	}
+	return nil
+}
+
+func setByPath(
+	msg protoreflect.Message,
+	path []string,
+	value string,
+) error {
+	desc := msg.Descriptor()
+	switch desc.FullName() {
+	case anyFullName:
+		anyValue := msg.Interface().(*obs_grpc.Any)
+		if len(path) == 0 {
+			anyValue.Union = parseAnyValue(value).Union
+			return nil
+		}
+		obj := anyValue.GetObject()
+		if obj == nil {
+			obj = &obs_grpc.AbstractObject{}
+			anyValue.Union = &obs_grpc.Any_Object{Object: obj}
+		}
+		return setByPath(obj.ProtoReflect(), path, value)
+	case abstractObjectFullName:
+		if len(path) == 0 {
+			return fmt.Errorf("the key within the object is not specified")
+		}
+		obj := msg.Interface().(*obs_grpc.AbstractObject)
+		if obj.Fields == nil {
+			obj.Fields = map[string]*obs_grpc.Any{}
+		}
+		item := obj.Fields[path[0]]
+		if item == nil {
+			item = &obs_grpc.Any{}
+			obj.Fields[path[0]] = item
+		}
+		return setByPath(item.ProtoReflect(), path[1:], value)
+	}
+
+	if len(path) == 0 {
+		return fmt.Errorf("the field of %s is not specified", desc.FullName())
+	}
+	fd := findField(desc, path[0])
+	if fd == nil {
+		return fmt.Errorf("%s has no field '%s'", desc.FullName(), path[0])
+	}
+	path = path[1:]
+
+	switch {
+	case fd.IsMap():
+		if len(path) == 0 {
+			return fmt.Errorf("the key of map '%s' is not specified", fd.Name())
+		}
+		m := msg.Mutable(fd).Map()
+		mapKey := protoreflect.ValueOfString(path[0]).MapKey()
+		path = path[1:]
+		if fd.MapValue().Message() != nil {
+			item := m.Mutable(mapKey).Message()
+			return setByPath(item, path, value)
+		}
+		if len(path) != 0 {
+			return fmt.Errorf("map '%s' has scalar values", fd.Name())
+		}
+		v, err := parseScalar(fd.MapValue(), value)
+		if err != nil {
+			return err
+		}
+		m.Set(mapKey, v)
+		return nil
+	case fd.IsList():
+		list := msg.Mutable(fd).List()
+		if fd.Message() != nil {
+			item := list.NewElement()
+			if err := setByPath(item.Message(), path, value); err != nil {
+				return err
+			}
+			list.Append(item)
+			return nil
+		}
+		if len(path) != 0 {
+			return fmt.Errorf("field '%s' is a list of scalars", fd.Name())
+		}
+		v, err := parseScalar(fd, value)
+		if err != nil {
+			return err
+		}
+		list.Append(v)
+		return nil
+	case fd.Message() != nil:
+		return setByPath(msg.Mutable(fd).Message(), path, value)
+	default:
+		if len(path) != 0 {
+			return fmt.Errorf("field '%s' is not a message", fd.Name())
+		}
+		v, err := parseScalar(fd, value)
+		if err != nil {
+			return err
+		}
+		msg.Set(fd, v)
+		return nil
+	}
+}
+
+func parseScalar(
+	fd protoreflect.FieldDescriptor,
+	value string,
+) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(value), nil
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(value)), nil
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("unable to parse '%s' as a bool: %w", value, err)
+		}
+		return protoreflect.ValueOfBool(v), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(value, 0, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("unable to parse '%s' as an integer: %w", value, err)
+		}
+		return protoreflect.ValueOfInt32(int32(v)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(value, 0, 64)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("unable to parse '%s' as an integer: %w", value, err)
+		}
+		return protoreflect.ValueOfInt64(v), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("unable to parse '%s' as an unsigned integer: %w", value, err)
+		}
+		return protoreflect.ValueOfUint32(uint32(v)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(value, 0, 64)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("unable to parse '%s' as an unsigned integer: %w", value, err)
+		}
+		return protoreflect.ValueOfUint64(v), nil
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(value, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("unable to parse '%s' as a number: %w", value, err)
+		}
+		return protoreflect.ValueOfFloat32(float32(v)), nil
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("unable to parse '%s' as a number: %w", value, err)
+		}
+		return protoreflect.ValueOfFloat64(v), nil
+	case protoreflect.EnumKind:
+		if enumValue := fd.Enum().Values().ByName(protoreflect.Name(value)); enumValue != nil {
+			return protoreflect.ValueOfEnum(enumValue.Number()), nil
+		}
+		v, err := strconv.ParseInt(value, 0, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown value '%s' of enum %s", value, fd.Enum().FullName())
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), nil
+	}
+	return protoreflect.Value{}, fmt.Errorf("fields of kind %s are not supported", fd.Kind())
+}
+
+// parseAnyValue guesses the type of the value: a bool, an integer, a float,
+// or otherwise a string.
+func parseAnyValue(value string) *obs_grpc.Any {
+	switch value {
+	case "true", "false":
+		return &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: value == "true"}}
+	}
+	if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return &obs_grpc.Any{Union: &obs_grpc.Any_Integer{Integer: v}}
+	}
+	if v, err := strconv.ParseFloat(value, 64); err == nil {
+		return &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: v}}
+	}
+	return &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte(value)}}
+}
@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+)
+
+func TestSetArgs(t *testing.T) {
+	args, err := splitArgs(`inputName="Mic/Aux" inputSettings.device_id=default inputSettings.gain=1.5 overlay=true`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"inputName=Mic/Aux", "inputSettings.device_id=default", "inputSettings.gain=1.5", "overlay=true"}, args)
+
+	req := &obs_grpc.SetInputSettingsRequest{}
+	require.NoError(t, setArgs(req.ProtoReflect(), args))
+	require.Equal(t, "Mic/Aux", req.GetInputName())
+	require.Equal(t, []byte("default"), req.InputSettings.Fields["device_id"].GetString_())
+	require.Equal(t, 1.5, req.InputSettings.Fields["gain"].GetFloat())
+	require.True(t, req.GetOverlay())
+
+	require.Error(t, setArgs(req.ProtoReflect(), []string{"noSuchField=1"}))
+	require.Error(t, setArgs(req.ProtoReflect(), []string{"overlay=maybe"}))
+}
+
+func TestCompleter(t *testing.T) {
+	line := []rune("SetCurrentProgramScene scene")
+	candidates, length := completer{}.Do(line, len(line))
+	require.Equal(t, len("scene"), length)
+	require.Equal(t, [][]rune{[]rune("Name="), []rune("UUID=")}, candidates)
+}
@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/spf13/cobra"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+var (
+	Root = &cobra.Command{
+		Use:   filepath.Base(os.Args[0]),
+		Short: "a client of the OBS gRPC proxy",
+		Args:  cobra.NoArgs,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+			l := logger.FromCtx(ctx).WithLevel(LoggerLevel)
+			ctx = logger.CtxWithLogger(ctx, l)
+			cmd.SetContext(ctx)
+			logger.Debugf(ctx, "log-level: %v", LoggerLevel)
+		},
+		Run: call,
+	}
+
+	Shell = &cobra.Command{
+		Use:   "shell",
+		Short: "an interactive shell with tab completion of the methods and the request fields",
+		Args:  cobra.NoArgs,
+		Run:   shell,
+	}
+
+	LoggerLevel   = logger.LevelInfo
+	GRPCProxyAddr string
+	MethodName    string
+	RequestData   string
+	HistoryFile   string
+)
+
+func init() {
+	Root.PersistentFlags().Var(&LoggerLevel, "log-level", "Log level")
+	Root.PersistentFlags().StringVar(&GRPCProxyAddr, "grpc-proxy-addr", "localhost:4456", "the address of the OBS gRPC proxy")
+	Root.Flags().StringVar(&MethodName, "method-name", "", fmt.Sprintf("available values: %s", strings.Join(methodNames(), ", ")))
+	Root.Flags().StringVar(&RequestData, "request-data", "", "the JSON of the data to be sent to the server")
+	Shell.Flags().StringVar(&HistoryFile, "history-file", defaultHistoryFile(), "the file to keep the history of the commands in (empty value disables the history file)")
+	Root.AddCommand(Shell)
+}
+
+func assertNoError(ctx context.Context, err error) {
+	if err != nil {
+		logger.Panic(ctx, err)
+	}
+}
+
+func defaultHistoryFile() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(homeDir, ".obsgrpccli_history")
+}
+
+func newClientConn() (*grpc.ClientConn, error) {
+	return grpc.NewClient(GRPCProxyAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+func obsService() protoreflect.ServiceDescriptor {
+	return obs_grpc.File_obs_proto.Services().ByName("OBS")
+}
+
+// unaryMethods returns the methods which could be called with a single
+// request and a single response.
+func unaryMethods() []protoreflect.MethodDescriptor {
+	methods := obsService().Methods()
+	result := make([]protoreflect.MethodDescriptor, 0, methods.Len())
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		if method.IsStreamingClient() || method.IsStreamingServer() {
+			continue
+		}
+		result = append(result, method)
+	}
+	return result
+}
+
+func methodNames() []string {
+	var result []string
+	for _, method := range unaryMethods() {
+		result = append(result, string(method.Name()))
+	}
+	return result
+}
+
+func findMethod(name string) (protoreflect.MethodDescriptor, error) {
+	for _, method := range unaryMethods() {
+		if string(method.Name()) == name {
+			return method, nil
+		}
+	}
+	return nil, fmt.Errorf("method '%s' not found, available methods: %s", name, strings.Join(methodNames(), ", "))
+}
+
+func newMessage(desc protoreflect.MessageDescriptor) (proto.Message, error) {
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, fmt.Errorf("unable to find message type '%s': %w", desc.FullName(), err)
+	}
+	return msgType.New().Interface(), nil
+}
+
+func invoke(
+	ctx context.Context,
+	conn grpc.ClientConnInterface,
+	method protoreflect.MethodDescriptor,
+	req proto.Message,
+) (proto.Message, error) {
+	resp, err := newMessage(method.Output())
+	if err != nil {
+		return nil, err
+	}
+	fullMethodName := fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name())
+	if err := conn.Invoke(ctx, fullMethodName, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func call(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	if MethodName == "" {
+		assertNoError(ctx, cmd.Help())
+		return
+	}
+
+	method, err := findMethod(MethodName)
+	assertNoError(ctx, err)
+
+	req, err := newMessage(method.Input())
+	assertNoError(ctx, err)
+	assertNoError(ctx, decodeRequest([]byte(RequestData), req))
+
+	conn, err := newClientConn()
+	assertNoError(ctx, err)
+	defer conn.Close()
+
+	resp, err := invoke(ctx, conn, method, req)
+	assertNoError(ctx, err)
+	assertNoError(ctx, printResponse(cmd.OutOrStdout(), resp))
+}
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// completer implements readline.AutoCompleter: the first word is completed
+// to a method name, the next words are completed to the field names of
+// the request of the method (and to the values of bool and enum fields).
+type completer struct{}
+
+func (completer) Do(line []rune, pos int) ([][]rune, int) {
+	text := string(line[:pos])
+	wordStart := strings.LastIndexAny(text, " \t") + 1
+	word := text[wordStart:]
+
+	var candidates []string
+	if strings.TrimSpace(text[:wordStart]) == "" {
+		candidates = append(methodNames(), shellBuiltins...)
+	} else {
+		firstWord := strings.Fields(text)[0]
+		switch firstWord {
+		case "help":
+			candidates = methodNames()
+		default:
+			method, err := findMethod(firstWord)
+			if err != nil {
+				return nil, 0
+			}
+			candidates = completeArg(method.Input(), word)
+		}
+	}
+	sort.Strings(candidates)
+
+	var result [][]rune
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, word) {
+			result = append(result, []rune(candidate[len(word):]))
+		}
+	}
+	return result, len([]rune(word))
+}
+
+// completeArg returns the candidates to complete the argument in format
+// "key=value" (or its beginning) to.
+func completeArg(
+	desc protoreflect.MessageDescriptor,
+	word string,
+) []string {
+	key, _, hasValue := strings.Cut(word, "=")
+	path := strings.Split(key, ".")
+	for _, name := range path[:len(path)-1] {
+		fd := findField(desc, name)
+		if fd == nil || fd.IsMap() || fd.Message() == nil {
+			return nil
+		}
+		desc = fd.Message()
+	}
+	prefix := strings.Join(path[:len(path)-1], ".")
+	if prefix != "" {
+		prefix += "."
+	}
+
+	if hasValue {
+		fd := findField(desc, path[len(path)-1])
+		if fd == nil {
+			return nil
+		}
+		var result []string
+		for _, value := range fieldValues(fd) {
+			result = append(result, key+"="+value)
+		}
+		return result
+	}
+
+	var result []string
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		suffix := "="
+		switch {
+		case fd.IsMap():
+			suffix = "."
+		case fd.Message() != nil && fd.Message().FullName() != anyFullName:
+			suffix = "."
+		}
+		result = append(result, prefix+string(fd.Name())+suffix)
+	}
+	return result
+}
+
+func fieldValues(fd protoreflect.FieldDescriptor) []string {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return []string{"true", "false"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		result := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			result = append(result, string(values.Get(i).Name()))
+		}
+		return result
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsprotojson"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	OutputFormat    string
+	EmitUnpopulated bool
+	UseProtoNames   bool
+	BytesAsText     bool
+)
+
+func init() {
+	Root.PersistentFlags().StringVarP(&OutputFormat, "output", "o", "json", "the format of the responses: json, yaml, table")
+	Root.PersistentFlags().BoolVar(&EmitUnpopulated, "emit-unpopulated", false, "print also the fields which are not set")
+	Root.PersistentFlags().BoolVar(&UseProtoNames, "use-proto-names", false, "print the field names as in the .proto file instead of their JSON names")
+	Root.PersistentFlags().BoolVar(&BytesAsText, "bytes-as-text", true, "treat the values of 'bytes' fields as text (OBS strings are 'bytes' fields), instead of base64")
+}
+
+func decodeRequest(data []byte, req proto.Message) error {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	var err error
+	if BytesAsText {
+		err = obsprotojson.Unmarshal(protojson.UnmarshalOptions{}, data, req)
+	} else {
+		err = protojson.Unmarshal(data, req)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to unserialize the input to %T: %w", req, err)
+	}
+	return nil
+}
+
+func marshalJSON(msg proto.Message) ([]byte, error) {
+	opts := protojson.MarshalOptions{
+		EmitUnpopulated: EmitUnpopulated,
+		UseProtoNames:   UseProtoNames,
+	}
+	var (
+		b   []byte
+		err error
+	)
+	if BytesAsText {
+		b, err = obsprotojson.Marshal(opts, msg)
+	} else {
+		b, err = opts.Marshal(msg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize %T: %w", msg, err)
+	}
+	return b, nil
+}
+
+// marshalJSONLine serializes the message into a single line of JSON.
+func marshalJSONLine(msg proto.Message) ([]byte, error) {
+	b, err := marshalJSON(msg)
+	if err != nil {
+		return nil, err
+	}
+	// protojson intentionally randomizes the whitespaces, normalizing them:
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, b); err != nil {
+		return nil, fmt.Errorf("unable to compact the JSON: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func marshalYAML(msg proto.Message) ([]byte, error) {
+	b, err := marshalJSON(msg)
+	if err != nil {
+		return nil, err
+	}
+	// JSON is a subset of YAML, so it could be parsed as YAML (which keeps
+	// the order of the fields) and then printed in the block style.
+	var node yaml.Node
+	if err := yaml.Unmarshal(b, &node); err != nil {
+		return nil, fmt.Errorf("unable to parse the JSON as YAML: %w", err)
+	}
+	resetYAMLStyle(&node)
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return nil, fmt.Errorf("unable to serialize YAML: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("unable to serialize YAML: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
+
+func printResponse(out io.Writer, resp proto.Message) error {
+	switch OutputFormat {
+	case "json":
+		b, err := marshalJSON(resp)
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, b, "", " "); err != nil {
+			return fmt.Errorf("unable to indent the JSON: %w", err)
+		}
+		_, err = fmt.Fprintf(out, "%s\n", buf.Bytes())
+		return err
+	case "yaml":
+		b, err := marshalYAML(resp)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(b)
+		return err
+	case "table":
+		return printTable(out, resp.ProtoReflect())
+	default:
+		return fmt.Errorf("unknown output format '%s'", OutputFormat)
+	}
+}
+
+// printTable prints the scalar fields of the message as rows "field value",
+// and the lists of messages (like the list of scenes) as separate tables,
+// one row per item.
+func printTable(out io.Writer, msg protoreflect.Message) error {
+	var lists []protoreflect.FieldDescriptor
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsList() && fd.Message() != nil {
+			lists = append(lists, fd)
+		}
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, field := range flattenFields(msg) {
+		if isWithinFields(field.Path, lists) {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\n", field.Path, field.Value)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for _, fd := range lists {
+		list := msg.Get(fd).List()
+		if list.Len() == 0 && !EmitUnpopulated {
+			continue
+		}
+		fmt.Fprintf(out, "\n%s:\n", fd.Name())
+
+		var (
+			columns   []string
+			columnSet = map[string]struct{}{}
+			rows      []map[string]string
+		)
+		for idx := 0; idx < list.Len(); idx++ {
+			row := map[string]string{}
+			for _, field := range flattenFields(list.Get(idx).Message()) {
+				if _, ok := columnSet[field.Path]; !ok {
+					columnSet[field.Path] = struct{}{}
+					columns = append(columns, field.Path)
+				}
+				row[field.Path] = field.Value
+			}
+			rows = append(rows, row)
+		}
+
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "%s\n", strings.ToUpper(strings.Join(columns, "\t")))
+		for _, row := range rows {
+			values := make([]string, 0, len(columns))
+			for _, column := range columns {
+				values = append(values, row[column])
+			}
+			fmt.Fprintf(w, "%s\n", strings.Join(values, "\t"))
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isWithinFields(path string, fields []protoreflect.FieldDescriptor) bool {
+	for _, fd := range fields {
+		if strings.HasPrefix(path, string(fd.Name())+".") {
+			return true
+		}
+	}
+	return false
+}
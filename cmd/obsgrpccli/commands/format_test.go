@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPrintResponse(t *testing.T) {
+	t.Cleanup(func() {
+		OutputFormat, BytesAsText = "json", true
+	})
+	BytesAsText = true
+
+	resp := &obs_grpc.GetSceneCollectionListResponse{
+		CurrentSceneCollectionName: "Main",
+		SceneCollections:           [][]byte{[]byte("Main"), []byte("Backup")},
+	}
+
+	var out bytes.Buffer
+	OutputFormat = "json"
+	require.NoError(t, printResponse(&out, resp))
+	require.Equal(t, "{\n \"currentSceneCollectionName\": \"Main\",\n \"sceneCollections\": [\n  \"Main\",\n  \"Backup\"\n ]\n}\n", out.String())
+
+	out.Reset()
+	OutputFormat = "yaml"
+	require.NoError(t, printResponse(&out, resp))
+	require.Equal(t, "currentSceneCollectionName: Main\nsceneCollections:\n  - Main\n  - Backup\n", out.String())
+
+	out.Reset()
+	OutputFormat = "table"
+	require.NoError(t, printResponse(&out, &obs_grpc.GetSceneListResponse{
+		CurrentProgramSceneName: "Live",
+		Scenes: []*obs_grpc.Scene{
+			{SceneName: proto.String("Intro"), SceneIndex: proto.Int64(0)},
+			{SceneName: proto.String("Live"), SceneIndex: proto.Int64(1)},
+		},
+	}))
+	require.Contains(t, out.String(), "currentProgramSceneName  Live\n")
+	require.Contains(t, out.String(), "scenes:\nSCENEINDEX  SCENENAME\n0           Intro\n1           Live\n")
+
+	req := &obs_grpc.GetSceneCollectionListResponse{}
+	require.NoError(t, decodeRequest([]byte(`{"sceneCollections": ["Main"]}`), req))
+	require.Equal(t, [][]byte{[]byte("Main")}, req.SceneCollections)
+}
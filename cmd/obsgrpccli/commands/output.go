@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type fieldValue struct {
+	Path  string
+	Value string
+}
+
+// flattenFields returns the populated fields of the message as pairs of
+// a dot-separated path (in the same format as accepted by setArgs) and
+// a value.
+func flattenFields(msg protoreflect.Message) []fieldValue {
+	var result []fieldValue
+	flattenFieldsTo(&result, "", msg)
+	return result
+}
+
+func flattenFieldsTo(
+	result *[]fieldValue,
+	prefix string,
+	msg protoreflect.Message,
+) {
+	switch msg.Descriptor().FullName() {
+	case anyFullName:
+		anyValue := msg.Interface().(*obs_grpc.Any)
+		if obj := anyValue.GetObject(); obj != nil {
+			flattenFieldsTo(result, prefix, obj.ProtoReflect())
+			return
+		}
+		if list := anyValue.GetList(); list != nil {
+			for idx, item := range list.GetItems() {
+				flattenFieldsTo(result, fmt.Sprintf("%s%d.", prefix, idx), item.ProtoReflect())
+			}
+			return
+		}
+		*result = append(*result, fieldValue{Path: strings.TrimSuffix(prefix, "."), Value: anyValueString(anyValue)})
+		return
+	case abstractObjectFullName:
+		obj := msg.Interface().(*obs_grpc.AbstractObject)
+		keys := make([]string, 0, len(obj.Fields))
+		for k := range obj.Fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			flattenFieldsTo(result, prefix+k+".", obj.Fields[k].ProtoReflect())
+		}
+		return
+	}
+
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !msg.Has(fd) && (fd.HasPresence() || fd.Cardinality() == protoreflect.Repeated) {
+			continue
+		}
+		path := prefix + string(fd.Name())
+		v := msg.Get(fd)
+		switch {
+		case fd.IsMap():
+			var keys []protoreflect.MapKey
+			v.Map().Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+				keys = append(keys, k)
+				return true
+			})
+			sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+			for _, k := range keys {
+				itemPath := path + "." + k.String()
+				item := v.Map().Get(k)
+				if fd.MapValue().Message() != nil {
+					flattenFieldsTo(result, itemPath+".", item.Message())
+					continue
+				}
+				*result = append(*result, fieldValue{Path: itemPath, Value: scalarString(fd.MapValue(), item)})
+			}
+		case fd.IsList():
+			list := v.List()
+			for idx := 0; idx < list.Len(); idx++ {
+				itemPath := fmt.Sprintf("%s.%d", path, idx)
+				if fd.Message() != nil {
+					flattenFieldsTo(result, itemPath+".", list.Get(idx).Message())
+					continue
+				}
+				*result = append(*result, fieldValue{Path: itemPath, Value: scalarString(fd, list.Get(idx))})
+			}
+		case fd.Message() != nil:
+			flattenFieldsTo(result, path+".", v.Message())
+		default:
+			*result = append(*result, fieldValue{Path: path, Value: scalarString(fd, v)})
+		}
+	}
+}
+
+func scalarString(
+	fd protoreflect.FieldDescriptor,
+	v protoreflect.Value,
+) string {
+	switch fd.Kind() {
+	case protoreflect.BytesKind:
+		return string(v.Bytes())
+	case protoreflect.EnumKind:
+		if enumValue := fd.Enum().Values().ByNumber(v.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+	}
+	return v.String()
+}
+
+func anyValueString(v *obs_grpc.Any) string {
+	switch u := v.GetUnion().(type) {
+	case *obs_grpc.Any_Integer:
+		return strconv.FormatInt(u.Integer, 10)
+	case *obs_grpc.Any_Float:
+		return strconv.FormatFloat(u.Float, 'g', -1, 64)
+	case *obs_grpc.Any_String_:
+		return string(u.String_)
+	case *obs_grpc.Any_Bool:
+		return strconv.FormatBool(u.Bool)
+	}
+	return ""
+}
+
+// formatFields formats the fields as space-separated "key=value" words,
+// quoting the values if required.
+func formatFields(fields []fieldValue) string {
+	words := make([]string, 0, len(fields))
+	for _, field := range fields {
+		value := field.Value
+		if value == "" || strings.ContainsAny(value, " \t\"'\\\n") {
+			value = strconv.Quote(value)
+		}
+		words = append(words, field.Path+"="+value)
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,623 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/spf13/cobra"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	Run = &cobra.Command{
+		Use:   "run <script.yaml>",
+		Short: "execute a sequence of steps described in a YAML file",
+		Long: "execute a sequence of steps described in a YAML file, for example:\n\n" +
+			"  vars:\n" +
+			"    scene: Live\n" +
+			"  steps:\n" +
+			"    - call: GetSceneItemId\n" +
+			"      args: {sceneName: '${scene}', sourceName: Camera}\n" +
+			"      capture: {cameraID: sceneItemID}\n" +
+			"    - call: SetSceneItemEnabled\n" +
+			"      args: {sceneName: '${scene}', sceneItemID: '${cameraID}', sceneItemEnabled: true}\n" +
+			"    - call: StartStream\n" +
+			"      if: '${startStream} == true'\n" +
+			"    - waitForEvent: StreamStateChanged.outputState=OBS_WEBSOCKET_OUTPUT_STARTED\n" +
+			"      timeout: 1m\n" +
+			"    - sleep: 5s\n" +
+			"    - sequence:\n" +
+			"        haltOnFailure: true\n" +
+			"        steps:\n" +
+			"          - call: SetCurrentProgramScene\n" +
+			"            args: {sceneName: '${scene}'}\n" +
+			"          - call: GetCurrentProgramScene\n" +
+			"            print: true\n\n" +
+			"The calls of a 'sequence' are sent to the proxy as a single request, which\n" +
+			"only saves the round trips between the client and the proxy: it is not\n" +
+			"an obs-websocket request batch, the proxy still sends the calls to OBS\n" +
+			"one by one.\n",
+		Args: cobra.ExactArgs(1),
+		Run:  run,
+	}
+
+	RunVars []string
+)
+
+func init() {
+	Run.Flags().StringArrayVar(&RunVars, "var", nil, "set a variable, in format 'name=value' (overrides the value from the file)")
+	Root.AddCommand(Run)
+}
+
+// Script is a sequence of steps executed by command "run".
+type Script struct {
+	Vars  map[string]string `yaml:"vars"`
+	Steps []ScriptStep      `yaml:"steps"`
+}
+
+// ScriptStep is a single step of a Script. Exactly one of Call, Sleep,
+// WaitForEvent and Sequence should be set.
+type ScriptStep struct {
+	Name string `yaml:"name"`
+
+	// If is a condition to execute the step, in format
+	// "<value> <operator> <value>" (operators: ==, !=, <, <=, >, >=)
+	// or just "<value>" (which should be "true" or "false").
+	If string `yaml:"if"`
+
+	// Call is the name of the method to call.
+	Call string `yaml:"call"`
+	// Args are the fields of the request (nested objects are supported).
+	Args map[string]any `yaml:"args"`
+	// Capture is a map "variable name" -> "field path in the response".
+	Capture map[string]string `yaml:"capture"`
+	// Print prints the response.
+	Print bool `yaml:"print"`
+	// IgnoreError continues the execution if the call fails.
+	IgnoreError bool `yaml:"ignoreError"`
+
+	Sleep time.Duration `yaml:"sleep"`
+
+	// WaitForEvent waits for an event matching the condition (in the same
+	// format as in 'watch --until'). The events received since the previous
+	// WaitForEvent (or since the start of the script) are considered, too.
+	WaitForEvent string        `yaml:"waitForEvent"`
+	Timeout      time.Duration `yaml:"timeout"`
+
+	Sequence *ScriptSequence `yaml:"sequence"`
+}
+
+// ScriptSequence is a sequence of calls sent as a single request to the proxy
+// (see ExecuteRequestSequence). The variables captured within the sequence
+// could be used only after it.
+type ScriptSequence struct {
+	HaltOnFailure bool         `yaml:"haltOnFailure"`
+	Steps         []ScriptStep `yaml:"steps"`
+}
+
+func (step *ScriptStep) String() string {
+	if step.Name != "" {
+		return step.Name
+	}
+	switch {
+	case step.Call != "":
+		return step.Call
+	case step.Sleep != 0:
+		return fmt.Sprintf("sleep %v", step.Sleep)
+	case step.WaitForEvent != "":
+		return fmt.Sprintf("wait for %s", step.WaitForEvent)
+	case step.Sequence != nil:
+		return fmt.Sprintf("sequence of %d calls", len(step.Sequence.Steps))
+	}
+	return "<empty step>"
+}
+
+func (step *ScriptStep) validate() error {
+	count := 0
+	for _, isSet := range []bool{step.Call != "", step.Sleep != 0, step.WaitForEvent != "", step.Sequence != nil} {
+		if isSet {
+			count++
+		}
+	}
+	if count != 1 {
+		return fmt.Errorf("exactly one of 'call', 'sleep', 'waitForEvent' and 'sequence' should be set")
+	}
+	if step.Call != "" {
+		if _, err := findMethod(step.Call); err != nil {
+			return err
+		}
+	}
+	if step.WaitForEvent != "" {
+		if _, err := parseEventCondition(step.WaitForEvent); err != nil {
+			return err
+		}
+	}
+	if step.Sequence != nil {
+		for idx := range step.Sequence.Steps {
+			subStep := &step.Sequence.Steps[idx]
+			if subStep.Call == "" {
+				return fmt.Errorf("step #%d of the sequence: only 'call' steps are allowed in a sequence", idx+1)
+			}
+			if err := subStep.validate(); err != nil {
+				return fmt.Errorf("step #%d of the sequence: %w", idx+1, err)
+			}
+		}
+	}
+	return nil
+}
+
+func parseScript(b []byte) (*Script, error) {
+	var script Script
+	dec := yaml.NewDecoder(strings.NewReader(string(b)))
+	dec.KnownFields(true)
+	if err := dec.Decode(&script); err != nil {
+		return nil, fmt.Errorf("unable to parse the script: %w", err)
+	}
+	for idx := range script.Steps {
+		if err := script.Steps[idx].validate(); err != nil {
+			return nil, fmt.Errorf("step #%d: %w", idx+1, err)
+		}
+	}
+	return &script, nil
+}
+
+func (script *Script) needsEvents() bool {
+	for _, step := range script.Steps {
+		if step.WaitForEvent != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func run(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	b, err := os.ReadFile(args[0])
+	assertNoError(ctx, err)
+
+	script, err := parseScript(b)
+	assertNoError(ctx, err)
+
+	if script.Vars == nil {
+		script.Vars = map[string]string{}
+	}
+	for _, v := range RunVars {
+		name, value, ok := strings.Cut(v, "=")
+		if !ok {
+			assertNoError(ctx, fmt.Errorf("invalid variable '%s': expected format 'name=value'", v))
+		}
+		script.Vars[name] = value
+	}
+
+	conn, err := newClientConn()
+	assertNoError(ctx, err)
+	defer conn.Close()
+
+	assertNoError(ctx, runScript(ctx, conn, cmd.OutOrStdout(), script))
+}
+
+type scriptRunner struct {
+	conn   grpc.ClientConnInterface
+	out    io.Writer
+	vars   map[string]string
+	events <-chan *obs_grpc.EventEnvelope
+}
+
+func runScript(
+	ctx context.Context,
+	conn grpc.ClientConnInterface,
+	out io.Writer,
+	script *Script,
+) error {
+	ctx, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
+
+	r := &scriptRunner{
+		conn: conn,
+		out:  out,
+		vars: map[string]string{},
+	}
+	for k, v := range script.Vars {
+		r.vars[k] = v
+	}
+
+	if script.needsEvents() {
+		events, err := subscribeToEvents(ctx, conn)
+		if err != nil {
+			return err
+		}
+		r.events = events
+	}
+
+	for idx := range script.Steps {
+		step := &script.Steps[idx]
+		if err := r.execStep(ctx, step); err != nil {
+			return fmt.Errorf("step #%d (%s): %w", idx+1, step, err)
+		}
+	}
+	return nil
+}
+
+// subscribeToEvents returns the channel of the events; the channel is
+// closed when the subscription ends.
+func subscribeToEvents(
+	ctx context.Context,
+	conn grpc.ClientConnInterface,
+) (<-chan *obs_grpc.EventEnvelope, error) {
+	stream, err := obs_grpc.NewOBSClient(conn).SubscribeToEvents(ctx, &obs_grpc.SubscribeToEventsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to subscribe to the events: %w", err)
+	}
+	ch := make(chan *obs_grpc.EventEnvelope, 1024)
+	go func() {
+		defer close(ch)
+		for {
+			ev, err := stream.Recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+					logger.Errorf(ctx, "unable to receive an event: %v", err)
+				}
+				return
+			}
+			select {
+			case ch <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+func (r *scriptRunner) execStep(
+	ctx context.Context,
+	step *ScriptStep,
+) error {
+	if step.If != "" {
+		ok, err := evalCondition(step.If, r.vars)
+		if err != nil {
+			return fmt.Errorf("unable to evaluate condition '%s': %w", step.If, err)
+		}
+		if !ok {
+			logger.Debugf(ctx, "skipping step '%s': the condition is false", step)
+			return nil
+		}
+	}
+	logger.Debugf(ctx, "executing step '%s'", step)
+
+	switch {
+	case step.Call != "":
+		return r.execCall(ctx, step)
+	case step.Sleep != 0:
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(step.Sleep):
+			return nil
+		}
+	case step.WaitForEvent != "":
+		return r.waitForEvent(ctx, step)
+	case step.Sequence != nil:
+		return r.execSequence(ctx, step.Sequence)
+	}
+	return fmt.Errorf("empty step")
+}
+
+func (r *scriptRunner) buildRequest(step *ScriptStep) (proto.Message, error) {
+	method, err := findMethod(step.Call)
+	if err != nil {
+		return nil, err
+	}
+	req, err := newMessage(method.Input())
+	if err != nil {
+		return nil, err
+	}
+	var args []string
+	for _, arg := range flattenScriptArgs("", step.Args) {
+		key, value, _ := strings.Cut(arg, "=")
+		value, err := expandVars(value, r.vars)
+		if err != nil {
+			return nil, fmt.Errorf("unable to expand the value of '%s': %w", key, err)
+		}
+		args = append(args, key+"="+value)
+	}
+	if err := setArgs(req.ProtoReflect(), args); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// flattenScriptArgs converts the arguments into the format accepted by
+// setArgs.
+func flattenScriptArgs(prefix string, args map[string]any) []string {
+	keys := make([]string, 0, len(args))
+	for k := range args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var result []string
+	for _, k := range keys {
+		result = append(result, flattenScriptArg(prefix+k, args[k])...)
+	}
+	return result
+}
+
+func flattenScriptArg(key string, value any) []string {
+	switch value := value.(type) {
+	case map[string]any:
+		return flattenScriptArgs(key+".", value)
+	case []any:
+		var result []string
+		for _, item := range value {
+			result = append(result, flattenScriptArg(key, item)...)
+		}
+		return result
+	case nil:
+		return nil
+	default:
+		return []string{fmt.Sprintf("%s=%v", key, value)}
+	}
+}
+
+func (r *scriptRunner) execCall(
+	ctx context.Context,
+	step *ScriptStep,
+) error {
+	method, err := findMethod(step.Call)
+	if err != nil {
+		return err
+	}
+	req, err := r.buildRequest(step)
+	if err != nil {
+		return err
+	}
+	resp, err := invoke(ctx, r.conn, method, req)
+	if err != nil {
+		if step.IgnoreError {
+			logger.Warnf(ctx, "step '%s' failed (ignored): %v", step, err)
+			return nil
+		}
+		return err
+	}
+	return r.processResponse(step, resp)
+}
+
+func (r *scriptRunner) processResponse(
+	step *ScriptStep,
+	resp proto.Message,
+) error {
+	if len(step.Capture) > 0 {
+		fields := flattenFields(resp.ProtoReflect())
+		for varName, path := range step.Capture {
+			value, ok := lookupField(fields, path)
+			if !ok {
+				return fmt.Errorf("field '%s' is not set in the response", path)
+			}
+			r.vars[varName] = value
+		}
+	}
+	if step.Print {
+		return printResponse(r.out, resp)
+	}
+	return nil
+}
+
+func lookupField(fields []fieldValue, path string) (string, bool) {
+	for _, field := range fields {
+		if strings.EqualFold(field.Path, path) {
+			return field.Value, true
+		}
+	}
+	return "", false
+}
+
+func (r *scriptRunner) execSequence(
+	ctx context.Context,
+	sequence *ScriptSequence,
+) error {
+	req := &obs_grpc.ExecuteRequestSequenceRequest{
+		HaltOnFailure: sequence.HaltOnFailure,
+	}
+	var steps []*ScriptStep
+	for idx := range sequence.Steps {
+		step := &sequence.Steps[idx]
+		if step.If != "" {
+			ok, err := evalCondition(step.If, r.vars)
+			if err != nil {
+				return fmt.Errorf("unable to evaluate condition '%s' of step '%s': %w", step.If, step, err)
+			}
+			if !ok {
+				continue
+			}
+		}
+		msg, err := r.buildRequest(step)
+		if err != nil {
+			return fmt.Errorf("unable to build the request of step '%s': %w", step, err)
+		}
+		b, err := proto.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("unable to serialize the request of step '%s': %w", step, err)
+		}
+		req.Requests = append(req.Requests, &obs_grpc.RequestSequenceItem{
+			RequestType: step.Call,
+			RequestData: b,
+		})
+		steps = append(steps, step)
+	}
+
+	resp, err := obs_grpc.NewOBSClient(r.conn).ExecuteRequestSequence(ctx, req)
+	if err != nil {
+		return err
+	}
+	for idx, result := range resp.GetResults() {
+		if idx >= len(steps) {
+			return fmt.Errorf("received more results (%d) than sent requests (%d)", len(resp.GetResults()), len(steps))
+		}
+		step := steps[idx]
+		if !result.GetRequestStatusResult() {
+			err := fmt.Errorf("step '%s': %s (%d): %s",
+				step,
+				obs_grpc.RequestStatus(result.GetRequestStatusCode()),
+				result.GetRequestStatusCode(),
+				result.GetRequestStatusComment(),
+			)
+			if step.IgnoreError {
+				logger.Warnf(ctx, "%v (ignored)", err)
+				continue
+			}
+			return err
+		}
+		method, err := findMethod(step.Call)
+		if err != nil {
+			return err
+		}
+		stepResp, err := newMessage(method.Output())
+		if err != nil {
+			return err
+		}
+		if err := proto.Unmarshal(result.GetResponseData(), stepResp); err != nil {
+			return fmt.Errorf("unable to parse the response of step '%s': %w", step, err)
+		}
+		if err := r.processResponse(step, stepResp); err != nil {
+			return fmt.Errorf("step '%s': %w", step, err)
+		}
+	}
+	if len(resp.GetResults()) < len(steps) && !sequence.HaltOnFailure {
+		return fmt.Errorf("received less results (%d) than sent requests (%d)", len(resp.GetResults()), len(steps))
+	}
+	return nil
+}
+
+func (r *scriptRunner) waitForEvent(
+	ctx context.Context,
+	step *ScriptStep,
+) error {
+	condStr, err := expandVars(step.WaitForEvent, r.vars)
+	if err != nil {
+		return err
+	}
+	cond, err := parseEventCondition(condStr)
+	if err != nil {
+		return err
+	}
+	if step.Timeout > 0 {
+		var cancelFn context.CancelFunc
+		ctx, cancelFn = context.WithTimeout(ctx, step.Timeout)
+		defer cancelFn()
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("the event was not received: %w", ctx.Err())
+		case ev, ok := <-r.events:
+			if !ok {
+				return fmt.Errorf("the event stream ended")
+			}
+			if cond.Match(ev) {
+				return nil
+			}
+		}
+	}
+}
+
+var varRegexp = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// expandVars replaces "${name}" with the values of the variables.
+func expandVars(s string, vars map[string]string) (string, error) {
+	var err error
+	result := varRegexp.ReplaceAllStringFunc(s, func(match string) string {
+		name := varRegexp.FindStringSubmatch(match)[1]
+		value, ok := vars[name]
+		if !ok && err == nil {
+			err = fmt.Errorf("variable '%s' is not defined", name)
+		}
+		return value
+	})
+	return result, err
+}
+
+var conditionOperators = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+func evalCondition(cond string, vars map[string]string) (bool, error) {
+	// the operator is searched before expanding the variables, so that
+	// the values of the variables could contain anything
+	masked := varRegexp.ReplaceAllStringFunc(cond, func(match string) string {
+		return strings.Repeat("_", len(match))
+	})
+	op, opIdx := "", -1
+	for _, candidate := range conditionOperators {
+		if idx := strings.Index(masked, candidate); idx >= 0 && (opIdx < 0 || idx < opIdx) {
+			op, opIdx = candidate, idx
+		}
+	}
+
+	if opIdx < 0 {
+		value, err := expandVars(cond, vars)
+		if err != nil {
+			return false, err
+		}
+		return strconv.ParseBool(unquote(value))
+	}
+
+	lhs, err := expandVars(cond[:opIdx], vars)
+	if err != nil {
+		return false, err
+	}
+	rhs, err := expandVars(cond[opIdx+len(op):], vars)
+	if err != nil {
+		return false, err
+	}
+	lhs, rhs = unquote(lhs), unquote(rhs)
+
+	cmp := strings.Compare(lhs, rhs)
+	lhsNum, lhsErr := strconv.ParseFloat(lhs, 64)
+	rhsNum, rhsErr := strconv.ParseFloat(rhs, 64)
+	if lhsErr == nil && rhsErr == nil {
+		switch {
+		case lhsNum < rhsNum:
+			cmp = -1
+		case lhsNum > rhsNum:
+			cmp = 1
+		default:
+			cmp = 0
+		}
+	}
+
+	switch op {
+	case "==":
+		return cmp == 0, nil
+	case "!=":
+		return cmp != 0, nil
+	case "<":
+		return cmp < 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">":
+		return cmp > 0, nil
+	case ">=":
+		return cmp >= 0, nil
+	}
+	return false, fmt.Errorf("unknown operator '%s'", op)
+}
+
+func unquote(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
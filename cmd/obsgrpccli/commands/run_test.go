@@ -0,0 +1,140 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+
+	"github.com/andreykaipov/goobs/api/events"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeScriptServer struct {
+	fakeEventsServer
+	EnabledItems map[int64]bool
+}
+
+func (srv *fakeScriptServer) GetSceneItemId(
+	ctx context.Context,
+	req *obs_grpc.GetSceneItemIdRequest,
+) (*obs_grpc.GetSceneItemIdResponse, error) {
+	return &obs_grpc.GetSceneItemIdResponse{SceneItemID: int64(len(req.GetSourceName()))}, nil
+}
+
+func (srv *fakeScriptServer) SetSceneItemEnabled(
+	ctx context.Context,
+	req *obs_grpc.SetSceneItemEnabledRequest,
+) (*obs_grpc.SetSceneItemEnabledResponse, error) {
+	srv.EnabledItems[req.GetSceneItemID()] = req.GetSceneItemEnabled()
+	return &obs_grpc.SetSceneItemEnabledResponse{}, nil
+}
+
+func (srv *fakeScriptServer) ExecuteRequestSequence(
+	ctx context.Context,
+	req *obs_grpc.ExecuteRequestSequenceRequest,
+) (*obs_grpc.ExecuteRequestSequenceResponse, error) {
+	resp := &obs_grpc.ExecuteRequestSequenceResponse{}
+	for _, item := range req.Requests {
+		for _, method := range obs_grpc.OBS_ServiceDesc.Methods {
+			if method.MethodName != item.RequestType {
+				continue
+			}
+			result, err := method.Handler(srv, ctx, func(v any) error {
+				return proto.Unmarshal(item.RequestData, v.(proto.Message))
+			}, nil)
+			if err != nil {
+				return nil, err
+			}
+			b, err := proto.Marshal(result.(proto.Message))
+			if err != nil {
+				return nil, err
+			}
+			resp.Results = append(resp.Results, &obs_grpc.RequestSequenceResult{
+				RequestType:         item.RequestType,
+				RequestStatusResult: true,
+				ResponseData:        b,
+			})
+		}
+	}
+	return resp, nil
+}
+
+func TestRunScript(t *testing.T) {
+	script, err := parseScript([]byte(`
+vars:
+  source: Camera
+steps:
+  - call: GetSceneItemId
+    args: {sceneName: Live, sourceName: "${source}"}
+    capture: {itemID: sceneItemId}
+  - call: SetSceneItemEnabled
+    if: ${itemID} > 5
+    args: {sceneName: Live, sceneItemID: "${itemID}", sceneItemEnabled: true}
+  - call: SetSceneItemEnabled
+    if: ${itemID} == 1
+    args: {sceneName: Live, sceneItemID: 1, sceneItemEnabled: true}
+  - waitForEvent: StreamStateChanged.outputActive=true
+    timeout: 10s
+  - sleep: 1ms
+  - sequence:
+      steps:
+        - call: GetSceneItemId
+          args: {sceneName: Live, sourceName: Mic}
+          capture: {micID: sceneItemID}
+          print: true
+        - call: SetSceneItemEnabled
+          args: {sceneName: Live, sceneItemID: 2, sceneItemEnabled: false}
+  - call: SetSceneItemEnabled
+    args: {sceneName: Live, sceneItemID: "${micID}", sceneItemEnabled: true}
+`))
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &fakeScriptServer{
+		fakeEventsServer: fakeEventsServer{
+			Events: []any{&events.StreamStateChanged{OutputActive: true}},
+		},
+		EnabledItems: map[int64]bool{},
+	}
+	grpcServer := grpc.NewServer()
+	obs_grpc.RegisterOBSServer(grpcServer, srv)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var out bytes.Buffer
+	require.NoError(t, runScript(context.Background(), conn, &out, script))
+	require.Equal(t, map[int64]bool{6: true, 2: false, 3: true}, srv.EnabledItems)
+	require.Equal(t, "{\n \"sceneItemID\": \"3\"\n}\n", out.String())
+
+	_, err = parseScript([]byte("steps:\n  - call: NoSuchMethod\n"))
+	require.Error(t, err)
+	_, err = parseScript([]byte("steps:\n  - call: GetVersion\n    sleep: 1s\n"))
+	require.Error(t, err)
+}
+
+func TestEvalCondition(t *testing.T) {
+	vars := map[string]string{"a": "10", "b": "9", "s": "a == b", "t": "true"}
+	for cond, expected := range map[string]bool{
+		"${a} > ${b}":      true,
+		"${a} <= 9":        false,
+		"${s} == 'a == b'": true,
+		"${s} != x":        true,
+		"${t}":             true,
+	} {
+		result, err := evalCondition(cond, vars)
+		require.NoError(t, err, cond)
+		require.Equal(t, expected, result, cond)
+	}
+	_, err := evalCondition("${undefined} == 1", vars)
+	require.Error(t, err)
+}
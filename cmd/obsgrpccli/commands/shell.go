@@ -0,0 +1,176 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/chzyer/readline"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var shellBuiltins = []string{"help", "exit", "quit"}
+
+func shell(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	conn, err := newClientConn()
+	assertNoError(ctx, err)
+	defer conn.Close()
+
+	rl, err := readline.NewEx(&readline.Config{
+		Prompt:            "obs> ",
+		HistoryFile:       HistoryFile,
+		HistorySearchFold: true,
+		AutoComplete:      completer{},
+		InterruptPrompt:   "^C",
+		EOFPrompt:         "exit",
+	})
+	assertNoError(ctx, err)
+	defer rl.Close()
+
+	for {
+		line, err := rl.Readline()
+		switch {
+		case errors.Is(err, readline.ErrInterrupt):
+			if line == "" {
+				return
+			}
+			continue
+		case errors.Is(err, io.EOF):
+			return
+		case err != nil:
+			assertNoError(ctx, err)
+		}
+
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+			continue
+		case line == "exit" || line == "quit":
+			return
+		}
+
+		if err := execShellLine(ctx, conn, rl.Stdout(), line); err != nil {
+			fmt.Fprintf(rl.Stderr(), "error: %v\n", err)
+		}
+	}
+}
+
+// execShellLine executes a line in format "<Method> [key=value ...]" or
+// "<Method> <JSON>".
+func execShellLine(
+	ctx context.Context,
+	conn grpc.ClientConnInterface,
+	out io.Writer,
+	line string,
+) error {
+	methodName, rest, _ := strings.Cut(line, " ")
+	rest = strings.TrimSpace(rest)
+
+	if methodName == "help" {
+		return printShellHelp(out, rest)
+	}
+
+	method, err := findMethod(methodName)
+	if err != nil {
+		return err
+	}
+	req, err := newMessage(method.Input())
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(rest, "{") {
+		if err := decodeRequest([]byte(rest), req); err != nil {
+			return err
+		}
+	} else {
+		args, err := splitArgs(rest)
+		if err != nil {
+			return err
+		}
+		if err := setArgs(req.ProtoReflect(), args); err != nil {
+			return err
+		}
+	}
+
+	// Ctrl+C interrupts the call instead of the shell:
+	ctx, cancelFn := signal.NotifyContext(ctx, os.Interrupt)
+	defer cancelFn()
+
+	resp, err := invoke(ctx, conn, method, req)
+	if err != nil {
+		return err
+	}
+	return printResponse(out, resp)
+}
+
+func printShellHelp(out io.Writer, methodName string) error {
+	if methodName == "" {
+		_, err := fmt.Fprintf(out,
+			"usage:\n"+
+				"  <Method> [key=value ...]\n"+
+				"  <Method> <JSON>\n"+
+				"  help [<Method>]\n"+
+				"  exit\n"+
+				"\n"+
+				"nested fields are set as 'parent.child=value', repeated fields by repeating the key\n"+
+				"\n"+
+				"methods:\n  %s\n",
+			strings.Join(methodNames(), "\n  "),
+		)
+		return err
+	}
+
+	method, err := findMethod(methodName)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%s(%s) returns %s\n", method.Name(), method.Input().Name(), method.Output().Name())
+	printFields(out, method.Input(), "  ")
+	return nil
+}
+
+func printFields(
+	out io.Writer,
+	desc protoreflect.MessageDescriptor,
+	indent string,
+) {
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		var attrs []string
+		switch {
+		case fd.IsList():
+			attrs = append(attrs, "repeated")
+		case fd.HasOptionalKeyword():
+			attrs = append(attrs, "optional")
+		}
+		fmt.Fprintf(out, "%s%s: %s", indent, fd.Name(), fieldTypeName(fd))
+		if len(attrs) > 0 {
+			fmt.Fprintf(out, " (%s)", strings.Join(attrs, ", "))
+		}
+		if values := fieldValues(fd); fd.Kind() == protoreflect.EnumKind && len(values) > 0 {
+			fmt.Fprintf(out, " [%s]", strings.Join(values, ", "))
+		}
+		fmt.Fprintf(out, "\n")
+	}
+}
+
+func fieldTypeName(fd protoreflect.FieldDescriptor) string {
+	switch {
+	case fd.IsMap():
+		return fmt.Sprintf("map<%s, %s>", fieldTypeName(fd.MapKey()), fieldTypeName(fd.MapValue()))
+	case fd.Message() != nil:
+		return string(fd.Message().Name())
+	case fd.Enum() != nil:
+		return string(fd.Enum().Name())
+	}
+	return fd.Kind().String()
+}
@@ -0,0 +1,256 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	Watch = &cobra.Command{
+		Use:   "watch",
+		Short: "print the events received from OBS",
+		Long: "print the events received from OBS\n\n" +
+			"Example (wait until the stream starts):\n" +
+			"  obsgrpccli watch --until StreamStateChanged.outputState=OBS_WEBSOCKET_OUTPUT_STARTED --timeout 1m",
+		Args: cobra.NoArgs,
+		Run:  watch,
+	}
+
+	WatchEventTypes  []string
+	WatchCategories  []string
+	WatchFormat      string
+	WatchUntil       string
+	WatchTimeout     time.Duration
+	WatchAfterSeqNo  uint64
+	WatchReplayedAge time.Duration
+)
+
+func init() {
+	Watch.Flags().StringSliceVar(&WatchEventTypes, "event-type", nil, "print only the events of these types (for example: StreamStateChanged)")
+	Watch.Flags().StringSliceVar(&WatchCategories, "category", nil, "print only the events of these categories (for example: outputs)")
+	Watch.Flags().StringVar(&WatchFormat, "format", "text", "the output format: text, json (one object per line), yaml, table")
+	Watch.Flags().StringVar(&WatchUntil, "until", "", "exit after an event matching the condition in format '<EventType>[.<field>=<value>]'")
+	Watch.Flags().DurationVar(&WatchTimeout, "timeout", 0, "stop watching after the duration (it is an error if the --until condition is not met by then)")
+	Watch.Flags().Uint64Var(&WatchAfterSeqNo, "after-seq-no", 0, "also print the events after this sequence number, kept in the history of the proxy")
+	Watch.Flags().DurationVar(&WatchReplayedAge, "replay", 0, "also print the events received within the duration, kept in the history of the proxy")
+	Root.AddCommand(Watch)
+}
+
+// eventCondition is a condition in format "<EventType>[.<field>=<value>]".
+type eventCondition struct {
+	EventType string
+	FieldPath string
+	Value     string
+}
+
+func parseEventCondition(s string) (*eventCondition, error) {
+	cond, value, hasValue := strings.Cut(s, "=")
+	eventType, fieldPath, hasField := strings.Cut(cond, ".")
+	if eventType == "" || hasValue != hasField || (hasField && fieldPath == "") {
+		return nil, fmt.Errorf("invalid condition '%s': expected format '<EventType>[.<field>=<value>]'", s)
+	}
+	return &eventCondition{
+		EventType: eventType,
+		FieldPath: fieldPath,
+		Value:     value,
+	}, nil
+}
+
+func (cond *eventCondition) Match(ev *obs_grpc.EventEnvelope) bool {
+	if ev.GetEventType() != cond.EventType {
+		return false
+	}
+	if cond.FieldPath == "" {
+		return true
+	}
+	for _, field := range eventFields(ev) {
+		if strings.EqualFold(field.Path, cond.FieldPath) && field.Value == cond.Value {
+			return true
+		}
+	}
+	return false
+}
+
+// eventFields returns the fields of the payload of the event.
+func eventFields(ev *obs_grpc.EventEnvelope) []fieldValue {
+	msg := ev.ProtoReflect()
+	fd := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("Union"))
+	if fd == nil {
+		return nil
+	}
+	return flattenFields(msg.Get(fd).Message())
+}
+
+type eventFilter struct {
+	EventTypes []string
+	Categories []string
+}
+
+func (f eventFilter) Match(ev *obs_grpc.EventEnvelope) bool {
+	if len(f.EventTypes) > 0 && !containsFold(f.EventTypes, ev.GetEventType()) {
+		return false
+	}
+	if len(f.Categories) > 0 && !containsFold(f.Categories, ev.GetEventCategory()) {
+		return false
+	}
+	return true
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+type eventPrinter struct {
+	Format        string
+	Out           io.Writer
+	headerPrinted bool
+}
+
+func (p *eventPrinter) Print(ev *obs_grpc.EventEnvelope) error {
+	switch p.Format {
+	case "json":
+		b, err := marshalJSONLine(ev)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(p.Out, "%s\n", b)
+		return err
+	case "yaml":
+		b, err := marshalYAML(ev)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(p.Out, "---\n%s", b)
+		return err
+	case "text":
+		if gap := ev.GetGap(); gap != nil {
+			_, err := fmt.Fprintf(p.Out, "%s\n", gapDescription(gap))
+			return err
+		}
+		_, err := fmt.Fprintf(p.Out, "%s #%d %s %s\n",
+			time.Unix(0, ev.GetTimestampUnixNano()).Format(time.RFC3339Nano),
+			ev.GetSeqNo(),
+			ev.GetEventType(),
+			formatFields(eventFields(ev)),
+		)
+		return err
+	case "table":
+		const rowFormat = "%-12s %8s  %-12s %-36s %s\n"
+		if !p.headerPrinted {
+			if _, err := fmt.Fprintf(p.Out, rowFormat, "TIME", "SEQ", "CATEGORY", "TYPE", "DATA"); err != nil {
+				return err
+			}
+			p.headerPrinted = true
+		}
+		if gap := ev.GetGap(); gap != nil {
+			_, err := fmt.Fprintf(p.Out, rowFormat, "", "", "", "(gap)", gapDescription(gap))
+			return err
+		}
+		_, err := fmt.Fprintf(p.Out, rowFormat,
+			time.Unix(0, ev.GetTimestampUnixNano()).Format("15:04:05.000"),
+			fmt.Sprint(ev.GetSeqNo()),
+			ev.GetEventCategory(),
+			ev.GetEventType(),
+			formatFields(eventFields(ev)),
+		)
+		return err
+	default:
+		return fmt.Errorf("unknown format '%s'", p.Format)
+	}
+}
+
+func gapDescription(gap *obs_grpc.EventsGap) string {
+	if gap.GetFromSeqNo() == 0 {
+		return "some events were missed"
+	}
+	return fmt.Sprintf("events #%d-#%d were missed", gap.GetFromSeqNo(), gap.GetToSeqNo())
+}
+
+func watch(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+	assertNoError(ctx, watchEvents(ctx, cmd.OutOrStdout()))
+}
+
+func watchEvents(ctx context.Context, out io.Writer) error {
+	var until *eventCondition
+	if WatchUntil != "" {
+		var err error
+		until, err = parseEventCondition(WatchUntil)
+		if err != nil {
+			return err
+		}
+	}
+	if WatchTimeout > 0 {
+		var cancelFn context.CancelFunc
+		ctx, cancelFn = context.WithTimeout(ctx, WatchTimeout)
+		defer cancelFn()
+	}
+
+	req := &obs_grpc.SubscribeToEventsRequest{}
+	if WatchAfterSeqNo > 0 {
+		req.AfterSeqNo = &WatchAfterSeqNo
+	}
+	if WatchReplayedAge > 0 {
+		req.MaxAgeNanoseconds = proto.Int64(WatchReplayedAge.Nanoseconds())
+	}
+
+	conn, err := newClientConn()
+	if err != nil {
+		return fmt.Errorf("unable to connect to the proxy: %w", err)
+	}
+	defer conn.Close()
+
+	stream, err := obs_grpc.NewOBSClient(conn).SubscribeToEvents(ctx, req)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to the events: %w", err)
+	}
+
+	filter := eventFilter{
+		EventTypes: WatchEventTypes,
+		Categories: WatchCategories,
+	}
+	printer := &eventPrinter{
+		Format: WatchFormat,
+		Out:    out,
+	}
+	for {
+		ev, err := stream.Recv()
+		switch {
+		case err == nil:
+		case errors.Is(err, io.EOF):
+			if until != nil {
+				return fmt.Errorf("the event stream ended before the condition was met")
+			}
+			return nil
+		case ctx.Err() != nil:
+			if until != nil {
+				return fmt.Errorf("the condition was not met within %v", WatchTimeout)
+			}
+			return nil
+		default:
+			return fmt.Errorf("unable to receive an event: %w", err)
+		}
+
+		if ev.GetGap() != nil || filter.Match(ev) {
+			if err := printer.Print(ev); err != nil {
+				return err
+			}
+		}
+		if until != nil && until.Match(ev) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andreykaipov/goobs/api/events"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsgrpcproxy"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+)
+
+type fakeEventsServer struct {
+	obs_grpc.UnimplementedOBSServer
+	Events []any
+}
+
+func (srv *fakeEventsServer) SubscribeToEvents(
+	req *obs_grpc.SubscribeToEventsRequest,
+	stream obs_grpc.OBS_SubscribeToEventsServer,
+) error {
+	for idx, ev := range srv.Events {
+		envelope, err := obsgrpcproxy.EventGo2Protobuf(ev)
+		if err != nil {
+			return err
+		}
+		envelope.SeqNo = uint64(idx + 1)
+		if err := stream.Send(envelope); err != nil {
+			return err
+		}
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+func TestWatchUntil(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	obs_grpc.RegisterOBSServer(grpcServer, &fakeEventsServer{
+		Events: []any{
+			&events.CurrentProgramSceneChanged{SceneName: "Intro"},
+			&events.StreamStateChanged{OutputState: "OBS_WEBSOCKET_OUTPUT_STARTING"},
+			&events.StreamStateChanged{OutputActive: true, OutputState: "OBS_WEBSOCKET_OUTPUT_STARTED"},
+			&events.CurrentProgramSceneChanged{SceneName: "Live"},
+		},
+	})
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	t.Cleanup(func() {
+		GRPCProxyAddr, WatchCategories, WatchUntil, WatchTimeout = "localhost:4456", nil, "", 0
+	})
+	GRPCProxyAddr = listener.Addr().String()
+	WatchCategories = []string{"outputs"}
+	WatchUntil = "StreamStateChanged.outputState=OBS_WEBSOCKET_OUTPUT_STARTED"
+	WatchTimeout = 10 * time.Second
+
+	var out bytes.Buffer
+	require.NoError(t, watchEvents(context.Background(), &out))
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	require.Contains(t, lines[0], "#2 StreamStateChanged outputActive=false outputState=OBS_WEBSOCKET_OUTPUT_STARTING")
+	require.Contains(t, lines[1], "#3 StreamStateChanged outputActive=true outputState=OBS_WEBSOCKET_OUTPUT_STARTED")
+
+	WatchUntil = "StreamStateChanged.outputState=OBS_WEBSOCKET_OUTPUT_STOPPED"
+	WatchTimeout = 100 * time.Millisecond
+	require.Error(t, watchEvents(context.Background(), &bytes.Buffer{}))
+}
@@ -2,53 +2,441 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/fs"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
 
 	"github.com/andreykaipov/goobs"
 	"github.com/andreykaipov/goobs/api/events/subscriptions"
 	"github.com/facebookincubator/go-belt/tool/logger"
 	xlogrus "github.com/facebookincubator/go-belt/tool/logger/implementation/logrus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/pflag"
 	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsgrpcproxy"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsgrpcweb"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obslisteners"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsmetrics"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsrecord"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsrestgateway"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obstunnel"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsupstream"
 	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
 )
 
 func main() {
 	logLevel := logger.LevelInfo
 	pflag.Var(&logLevel, "log-level", "Log level")
-	listenAddr := pflag.String("listen-addr", "localhost:4456", "the address to listen for gRPC connections on")
+	listenAddr := pflag.String("listen-addr", "localhost:4456", "the address to listen for gRPC connections on; empty value disables the TCP listener (the default value is not used if systemd passed the sockets)")
+	listenUnix := pflag.String("listen-unix", "", "the path of a unix domain socket to listen for gRPC connections on (in addition to --listen-addr)")
+	listenUnixMode := pflag.String("listen-unix-mode", "0660", "the permissions of the unix domain socket (octal)")
+	listenUnixGroup := pflag.String("listen-unix-group", "", "the group (a name or an ID) of the unix domain socket; empty value keeps the default group")
 	obsWSAddr := pflag.String("obs-ws-addr", "localhost:4455", "OBS WebSocket address")
 	obsPassword := pflag.String("obs-password", "", "OBS WebSocket password")
+	obsRecordFile := pflag.String("obs-record-file", "", "the file to record the obs-websocket sessions to (see cmd/obsreplay); empty value disables the recording")
+	upstreamAddrs := pflag.StringSlice("upstream-addrs", nil, "the addresses of upstream gRPC proxies (in the order of preference) to forward the calls to instead of connecting to OBS directly (--obs-ws-addr and --obs-password are ignored)")
+	tunnelListenAddr := pflag.String("tunnel-listen-addr", "", "the address to accept reverse tunnels from other proxies on (see --tunnel-addr); if set, the calls are forwarded to the proxies via the tunnels (the OBS instance is selected with gRPC metadata '"+obstunnel.InstanceIDMetadataKey+"') instead of connecting to OBS directly")
+	tunnelAddr := pflag.String("tunnel-addr", "", "the address of a backend to open a reverse tunnel to, so that the backend could call this proxy (see --tunnel-listen-addr); empty value disables the tunnel")
+	tunnelInstanceID := pflag.String("tunnel-instance-id", hostname(), "the ID of the OBS instance reported via the reverse tunnel")
+	tunnelToken := pflag.String("tunnel-token", "", "the token the agents authenticate with on the tunnel backend (see --tunnel-addr and --tunnel-listen-addr); empty value disables the token authentication")
+	tunnelTLSCertFile := pflag.String("tunnel-tls-cert-file", "", "the TLS certificate of the tunnel: the server certificate with --tunnel-listen-addr, or the client certificate (for mutual TLS) with --tunnel-addr")
+	tunnelTLSKeyFile := pflag.String("tunnel-tls-key-file", "", "the private key of --tunnel-tls-cert-file")
+	tunnelTLSCAFile := pflag.String("tunnel-tls-ca-file", "", "the CA certificates to verify the peer of the tunnel with: the client certificates (which are then required) with --tunnel-listen-addr, or the server certificate with --tunnel-addr (empty value means the system CAs)")
+	tunnelInsecure := pflag.Bool("tunnel-insecure", false, "use plaintext (no TLS) for the tunnel")
+	metricsAddr := pflag.String("metrics-addr", "", "the address to serve Prometheus metrics on (at path /metrics); empty value disables the metrics")
+	metricsOBSScrapeInterval := pflag.Duration("metrics-obs-scrape-interval", 5*time.Second, "how often to request statistics from OBS for the metrics")
+	httpAddr := pflag.String("http-addr", "", "the address to serve the HTTP/JSON REST gateway on (at path /v1/); empty value disables the gateway")
+	grpcWeb := pflag.Bool("grpc-web", false, "serve gRPC-Web (for browsers) on --listen-addr in addition to gRPC")
+	grpcWebAddr := pflag.String("grpc-web-addr", "", "the address to serve gRPC-Web (and gRPC) on in addition to --listen-addr; empty value disables the separate port")
+	corsAllowedOrigins := pflag.StringSlice("cors-allowed-origins", nil, "the origins allowed to make cross-origin gRPC-Web requests (for example: https://panel.example.com); '*' allows any origin")
+	otlpEndpoint := pflag.String("otlp-endpoint", "", "the address of an OTLP/gRPC collector to export the OpenTelemetry traces to (for example: localhost:4317); empty value disables the tracing")
 	pflag.Parse()
 
 	ctx := logger.CtxWithLogger(context.Background(), xlogrus.Default().WithLevel(logLevel))
 
-	listener, err := net.Listen("tcp", *listenAddr)
+	if *metricsAddr != "" && (*tunnelListenAddr != "" || len(*upstreamAddrs) > 0) {
+		// the event, connection and deprecation metrics are collected from
+		// the connection to OBS, which the proxy does not have in these modes
+		log.Fatalf("--metrics-addr is supported only if the proxy connects to OBS directly (not with --upstream-addrs or --tunnel-listen-addr): collect the metrics on the proxy connected to OBS")
+	}
+
+	grpcListeners, err := newListeners(*listenAddr, pflag.CommandLine.Changed("listen-addr"), *listenUnix, *listenUnixMode, *listenUnixGroup)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	proxy := obsgrpcproxy.New(
-		context.Background(),
-		func(ctx context.Context) (*goobs.Client, context.CancelFunc, error) {
-			client, err := goobs.New(
-				*obsWSAddr,
-				goobs.WithPassword(*obsPassword),
-				goobs.WithEventSubscriptions(subscriptions.All|subscriptions.InputActiveStateChanged),
-			)
-			logger.Debugf(ctx, "connection to OBS result: %v %v", client, err)
+	var (
+		proxyOpts       obsgrpcproxy.Options
+		grpcServerOpts  []grpc.ServerOption
+		metrics         *obsmetrics.Metrics
+		metricsRegistry *prometheus.Registry
+	)
+	if *otlpEndpoint != "" {
+		tracerProvider, err := newTracerProvider(ctx, *otlpEndpoint)
+		if err != nil {
+			log.Fatalf("unable to initialize the tracing: %v", err)
+		}
+		defer tracerProvider.Shutdown(ctx)
+		otel.SetTracerProvider(tracerProvider)
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+			propagation.TraceContext{},
+			propagation.Baggage{},
+		))
+		grpcServerOpts = append(grpcServerOpts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
+	}
+
+	if *metricsAddr != "" {
+		metricsRegistry = prometheus.NewRegistry()
+		metricsRegistry.MustRegister(
+			collectors.NewGoCollector(),
+			collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		)
+		metrics = obsmetrics.New(metricsRegistry)
+		proxyOpts = append(proxyOpts,
+			obsgrpcproxy.OptionEventHook{EventHook: metrics},
+			obsgrpcproxy.OptionConnectionHook{ConnectionHook: metrics},
+			obsgrpcproxy.OptionDeprecationHook{DeprecationHook: metrics},
+		)
+		grpcServerOpts = append(grpcServerOpts,
+			grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor()),
+		)
+	}
+
+	var (
+		obsServer obs_grpc.OBSServer
+		obsClient obs_grpc.OBSClient
+	)
+	switch {
+	case *tunnelListenAddr != "":
+		creds, err := tunnelCredentials(true, *tunnelTLSCertFile, *tunnelTLSKeyFile, *tunnelTLSCAFile, *tunnelInsecure)
+		if err != nil {
+			log.Fatalf("unable to initialize the credentials of the tunnels: %v", err)
+		}
+		hub := obstunnel.NewHub(obstunnel.OptionToken(*tunnelToken))
+		tunnelListener, err := net.Listen("tcp", *tunnelListenAddr)
+		if err != nil {
+			log.Fatalf("failed to listen: %v", err)
+		}
+		tunnelServer := grpc.NewServer(
+			grpc.Creds(creds),
+			grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+				MinTime:             tunnelKeepAliveInterval / 2,
+				PermitWithoutStream: true,
+			}),
+		)
+		obs_grpc.RegisterOBSTunnelServer(tunnelServer, hub)
+		go func() {
+			logger.Infof(ctx, "started the tunnel server at '%s'", tunnelListener.Addr())
+			err := tunnelServer.Serve(tunnelListener)
+			logger.Panicf(ctx, "unable to serve the tunnels: %v", err)
+		}()
+		obsClient = obs_grpc.NewOBSClient(hub.Conn(""))
+		obsServer = &obsgrpcproxy.ClientAsServer{OBSClient: obsClient}
+	case len(*upstreamAddrs) > 0:
+		var upstreamOpts obsupstream.Options
+		if *otlpEndpoint != "" {
+			upstreamOpts = append(upstreamOpts, obsupstream.OptionDialOptions{grpc.WithStatsHandler(otelgrpc.NewClientHandler())})
+		}
+		upstreams, err := obsupstream.New(ctx, *upstreamAddrs, upstreamOpts...)
+		if err != nil {
+			log.Fatalf("unable to initialize the upstreams: %v", err)
+		}
+		defer upstreams.Close()
+		obsClient = obs_grpc.NewOBSClient(upstreams)
+		obsServer = &obsgrpcproxy.ClientAsServer{OBSClient: obsClient}
+	default:
+		obsAddr := *obsWSAddr
+		if *obsRecordFile != "" {
+			obsAddr, err = startRecording(ctx, *obsRecordFile, *obsWSAddr)
 			if err != nil {
-				return nil, nil, err
+				log.Fatalf("unable to start recording: %v", err)
 			}
-			return client, func() { client.Disconnect() }, err
-		},
-	)
+		}
+		proxy := obsgrpcproxy.New(
+			context.Background(),
+			func(ctx context.Context) (*goobs.Client, context.CancelFunc, error) {
+				client, err := goobs.New(
+					obsAddr,
+					append([]goobs.Option{
+						goobs.WithPassword(*obsPassword),
+						goobs.WithEventSubscriptions(subscriptions.All | subscriptions.InputActiveStateChanged),
+					}, obsgrpcproxy.GoOBSOptions(proxyOpts...)...)...,
+				)
+				logger.Debugf(ctx, "connection to OBS result: %v %v", client, err)
+				if err != nil {
+					return nil, nil, err
+				}
+				return client, func() { client.Disconnect() }, err
+			},
+			proxyOpts...,
+		)
+		obsServer = proxy
+		obsClient = (*obsgrpcproxy.ProxyAsClient)(proxy)
+	}
+
+	if *tunnelAddr != "" {
+		creds, err := tunnelCredentials(false, *tunnelTLSCertFile, *tunnelTLSKeyFile, *tunnelTLSCAFile, *tunnelInsecure)
+		if err != nil {
+			log.Fatalf("unable to initialize the credentials of the tunnel: %v", err)
+		}
+		conn, err := grpc.NewClient(
+			*tunnelAddr,
+			grpc.WithTransportCredentials(creds),
+			grpc.WithKeepaliveParams(keepalive.ClientParameters{
+				Time:                tunnelKeepAliveInterval,
+				PermitWithoutStream: true,
+			}),
+		)
+		if err != nil {
+			log.Fatalf("unable to initialize a client for the tunnel to '%s': %v", *tunnelAddr, err)
+		}
+		defer conn.Close()
+		go func() {
+			logger.Infof(ctx, "opening a tunnel to '%s' as OBS instance '%s'", *tunnelAddr, *tunnelInstanceID)
+			err := obstunnel.ServeAgent(ctx, obs_grpc.NewOBSTunnelClient(conn), *tunnelInstanceID, obsServer, obstunnel.OptionToken(*tunnelToken))
+			logger.Panicf(ctx, "unable to serve the tunnel: %v", err)
+		}()
+	}
+
+	if metrics != nil {
+		go metrics.ServeOBSScraping(ctx, obsClient, *metricsOBSScrapeInterval)
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{}))
+		go func() {
+			logger.Infof(ctx, "started the metrics server at '%s'", *metricsAddr)
+			err := http.ListenAndServe(*metricsAddr, mux)
+			logger.Panicf(ctx, "unable to serve the metrics: %v", err)
+		}()
+	}
 
-	grpcServer := grpc.NewServer()
-	obs_grpc.RegisterOBSServer(grpcServer, proxy)
-	logger.Infof(ctx, "started the server at '%s'", listener.Addr())
-	err = grpcServer.Serve(listener)
+	if *httpAddr != "" {
+		gateway := obsrestgateway.New(obsClient)
+		go func() {
+			logger.Infof(ctx, "started the REST gateway at '%s'", *httpAddr)
+			err := http.ListenAndServe(*httpAddr, gateway)
+			logger.Panicf(ctx, "unable to serve the REST gateway: %v", err)
+		}()
+	}
+
+	grpcServer := grpc.NewServer(grpcServerOpts...)
+	obs_grpc.RegisterOBSServer(grpcServer, obsServer)
+	var grpcWebHandler *obsgrpcweb.Handler
+	if *grpcWeb || *grpcWebAddr != "" {
+		grpcWebHandler, err = obsgrpcweb.New(grpcServer, obsgrpcweb.OptionAllowedOrigins(*corsAllowedOrigins))
+		if err != nil {
+			log.Fatalf("unable to initialize gRPC-Web: %v", err)
+		}
+		defer grpcWebHandler.Close()
+	}
+	grpcWebListeners := map[net.Listener]bool{}
+	if *grpcWeb {
+		for _, listener := range grpcListeners {
+			grpcWebListeners[listener] = true
+		}
+	}
+	if *grpcWebAddr != "" {
+		listener, err := net.Listen("tcp", *grpcWebAddr)
+		if err != nil {
+			log.Fatalf("failed to listen: %v", err)
+		}
+		grpcListeners = append(grpcListeners, listener)
+		grpcWebListeners[listener] = true
+	}
+
+	errCh := make(chan error, 2*len(grpcListeners))
+	for _, listener := range grpcListeners {
+		isGRPCWeb := grpcWebListeners[listener]
+		if isGRPCWeb {
+			// the native gRPC clients are still served by grpcServer.Serve
+			var httpListener net.Listener
+			listener, httpListener = obsgrpcweb.SplitListener(listener)
+			go func() {
+				errCh <- http.Serve(httpListener, grpcWebHandler)
+			}()
+		}
+		listener := listener
+		go func() {
+			logger.Infof(ctx, "started the server at '%s' (gRPC-Web: %v)", listener.Addr(), isGRPCWeb)
+			errCh <- grpcServer.Serve(listener)
+		}()
+	}
+	err = <-errCh
 	logger.Panicf(ctx, "unable to serve gRPC: %v", err)
 }
+
+// newListeners returns the listeners for the gRPC connections: the TCP one,
+// the unix domain socket and the sockets passed by systemd (socket
+// activation), whichever are configured. If systemd passed the sockets,
+// then the TCP listener is used only if tcpAddr is set explicitly.
+func newListeners(
+	tcpAddr string,
+	isTCPAddrExplicit bool,
+	unixPath string,
+	unixMode string,
+	unixGroup string,
+) ([]net.Listener, error) {
+	result, err := obslisteners.Systemd()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the listeners passed by systemd: %w", err)
+	}
+	if len(result) > 0 && !isTCPAddrExplicit {
+		tcpAddr = ""
+	}
+	if tcpAddr != "" {
+		listener, err := net.Listen("tcp", tcpAddr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to listen on '%s': %w", tcpAddr, err)
+		}
+		result = append(result, listener)
+	}
+	if unixPath != "" {
+		mode, err := strconv.ParseUint(unixMode, 8, 32)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the permissions '%s': %w", unixMode, err)
+		}
+		listener, err := obslisteners.ListenUnix(unixPath, fs.FileMode(mode), unixGroup)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, listener)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no listeners configured")
+	}
+	return result, nil
+}
+
+// tunnelKeepAliveInterval is short enough to keep the NAT mappings of
+// the reverse tunnels.
+const tunnelKeepAliveInterval = 30 * time.Second
+
+// tunnelCredentials returns the transport credentials of the tunnel
+// backend (isServer) or of the agent. The tunnel uses TLS unless isInsecure
+// is set; the backend requires a certificate, and if caFile is set, then
+// the client certificates are required (mutual TLS).
+func tunnelCredentials(
+	isServer bool,
+	certFile string,
+	keyFile string,
+	caFile string,
+	isInsecure bool,
+) (credentials.TransportCredentials, error) {
+	if isInsecure {
+		if certFile != "" || caFile != "" {
+			return nil, fmt.Errorf("the TLS files are set, but the tunnel is insecure")
+		}
+		return insecure.NewCredentials(), nil
+	}
+
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the certificate '%s' with key '%s': %w", certFile, keyFile, err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	if isServer && len(cfg.Certificates) == 0 {
+		return nil, fmt.Errorf("the tunnel backend requires a TLS certificate (or the insecure mode)")
+	}
+	if caFile != "" {
+		caPEM, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read '%s': %w", caFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates found in '%s'", caFile)
+		}
+		if isServer {
+			cfg.ClientCAs = pool
+			cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		} else {
+			cfg.RootCAs = pool
+		}
+	}
+	return credentials.NewTLS(cfg), nil
+}
+
+// startRecording starts a local relay to OBS recording the sessions to
+// the file, and returns the address of the relay.
+func startRecording(
+	ctx context.Context,
+	path string,
+	obsAddr string,
+) (string, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", fmt.Errorf("unable to open '%s': %w", path, err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		f.Close()
+		return "", fmt.Errorf("unable to listen: %w", err)
+	}
+	go func() {
+		logger.Infof(ctx, "recording the obs-websocket sessions to '%s'", path)
+		err := http.Serve(listener, obsrecord.NewRecorder(f).Relay(obsAddr))
+		logger.Panicf(ctx, "unable to serve the recording relay: %v", err)
+	}()
+	return listener.Addr().String(), nil
+}
+
+func hostname() string {
+	name, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return name
+}
+
+func newTracerProvider(
+	ctx context.Context,
+	otlpEndpoint string,
+) (*sdktrace.TracerProvider, error) {
+	exporter, err := otlptracegrpc.New(
+		ctx,
+		otlptracegrpc.WithEndpoint(otlpEndpoint),
+		otlptracegrpc.WithInsecure(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the OTLP exporter: %w", err)
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName("obsgrpcproxy"),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the resource description: %w", err)
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	), nil
+}
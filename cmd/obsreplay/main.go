@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"os"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	xlogrus "github.com/facebookincubator/go-belt/tool/logger/implementation/logrus"
+	"github.com/spf13/pflag"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsrecord"
+)
+
+func main() {
+	logLevel := logger.LevelInfo
+	pflag.Var(&logLevel, "log-level", "Log level")
+	listenAddr := pflag.String("listen-addr", "localhost:4455", "the address to listen for obs-websocket connections on")
+	timeScale := pflag.Float64("time-scale", 0, "keep the recorded pauses between the frames multiplied by the value (1 is the real time); 0 sends the frames without pauses")
+	pflag.Usage = func() {
+		os.Stderr.WriteString("syntax: obsreplay [options] <recording>\n")
+		pflag.PrintDefaults()
+	}
+	pflag.Parse()
+	if pflag.NArg() != 1 {
+		pflag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := logger.CtxWithLogger(context.Background(), xlogrus.Default().WithLevel(logLevel))
+
+	f, err := os.Open(pflag.Arg(0))
+	if err != nil {
+		log.Fatalf("unable to open the recording: %v", err)
+	}
+	frames, err := obsrecord.ReadFrames(f)
+	f.Close()
+	if err != nil {
+		log.Fatalf("unable to read the recording: %v", err)
+	}
+
+	replayer := obsrecord.NewReplayer(frames, obsrecord.OptionTimeScale(*timeScale))
+	logger.Infof(ctx, "started replaying '%s' at '%s'", pflag.Arg(0), *listenAddr)
+	httpServer := &http.Server{
+		Addr:        *listenAddr,
+		Handler:     replayer,
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+	err = httpServer.ListenAndServe()
+	logger.Panicf(ctx, "unable to serve obs-websocket: %v", err)
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	xlogrus "github.com/facebookincubator/go-belt/tool/logger/implementation/logrus"
+	"github.com/spf13/pflag"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obswsbridge"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func main() {
+	logLevel := logger.LevelInfo
+	pflag.Var(&logLevel, "log-level", "Log level")
+	listenAddr := pflag.String("listen-addr", "localhost:4455", "the address to listen for obs-websocket connections on")
+	grpcProxyAddr := pflag.String("grpc-proxy-addr", "localhost:4456", "the address of the gRPC proxy to forward the requests to")
+	password := pflag.String("password", "", "the password the obs-websocket clients should authenticate with; empty value disables the authentication")
+	pflag.Parse()
+
+	ctx := logger.CtxWithLogger(context.Background(), xlogrus.Default().WithLevel(logLevel))
+
+	conn, err := grpc.NewClient(*grpcProxyAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("unable to initialize a gRPC client for '%s': %v", *grpcProxyAddr, err)
+	}
+	defer conn.Close()
+
+	bridge := obswsbridge.New(
+		obs_grpc.NewOBSClient(conn),
+		obswsbridge.OptionPassword(*password),
+	)
+	logger.Infof(ctx, "started the obs-websocket server at '%s'", *listenAddr)
+	httpServer := &http.Server{
+		Addr:        *listenAddr,
+		Handler:     bridge,
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+	err = httpServer.ListenAndServe()
+	logger.Panicf(ctx, "unable to serve obs-websocket: %v", err)
+}
@@ -0,0 +1,24 @@
+package obsclient
+
+// MonitorType is the audio monitoring type of an input.
+type MonitorType string
+
+const (
+	MonitorTypeNone             = MonitorType("OBS_MONITORING_TYPE_NONE")
+	MonitorTypeMonitorOnly      = MonitorType("OBS_MONITORING_TYPE_MONITOR_ONLY")
+	MonitorTypeMonitorAndOutput = MonitorType("OBS_MONITORING_TYPE_MONITOR_AND_OUTPUT")
+)
+
+// MediaState is the state of a media input.
+type MediaState string
+
+const (
+	MediaStateNone      = MediaState("OBS_MEDIA_STATE_NONE")
+	MediaStatePlaying   = MediaState("OBS_MEDIA_STATE_PLAYING")
+	MediaStateOpening   = MediaState("OBS_MEDIA_STATE_OPENING")
+	MediaStateBuffering = MediaState("OBS_MEDIA_STATE_BUFFERING")
+	MediaStatePaused    = MediaState("OBS_MEDIA_STATE_PAUSED")
+	MediaStateStopped   = MediaState("OBS_MEDIA_STATE_STOPPED")
+	MediaStateEnded     = MediaState("OBS_MEDIA_STATE_ENDED")
+	MediaStateError     = MediaState("OBS_MEDIA_STATE_ERROR")
+)
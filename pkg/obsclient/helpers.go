@@ -0,0 +1,29 @@
+package obsclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// SwitchScene makes the scene with the given name the current program scene.
+func (c *Client) SwitchScene(ctx context.Context, sceneName string) error {
+	return c.SetCurrentProgramScene(ctx, ByName(sceneName))
+}
+
+// SetMute mutes or unmutes the input with the given name.
+func (c *Client) SetMute(ctx context.Context, inputName string, muted bool) error {
+	return c.SetInputMute(ctx, ByName(inputName), muted)
+}
+
+// SetSourceVisible shows or hides the (first) item of the source in
+// the scene.
+func (c *Client) SetSourceVisible(ctx context.Context, scene Ref, sourceName string, visible bool) error {
+	item, err := c.GetSceneItemId(ctx, scene, sourceName)
+	if err != nil {
+		return err
+	}
+	if err := c.SetSceneItemEnabled(ctx, scene, item.SceneItemID, visible); err != nil {
+		return fmt.Errorf("unable to set the visibility of source '%s' in scene '%s': %w", sourceName, scene, err)
+	}
+	return nil
+}
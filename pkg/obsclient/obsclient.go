@@ -0,0 +1,132 @@
+// Package obsclient is a typed Go client of the OBS gRPC API: it wraps
+// obs_grpc.OBSClient with methods taking the required fields as arguments
+// and the optional fields as options, using strings instead of []byte and
+// typed enums.
+//
+// It works both with a remote proxy:
+//
+//	conn, err := grpc.NewClient("localhost:4456", grpc.WithTransportCredentials(insecure.NewCredentials()))
+//	client := obsclient.NewFromConn(conn)
+//
+// and with a proxy in the same process:
+//
+//	client := obsclient.New((*obsgrpcproxy.ProxyAsClient)(proxy))
+package obsclient
+
+import (
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+)
+
+// Client is a typed wrapper of obs_grpc.OBSClient. The methods not covered
+// by protocol.json (like SubscribeToEvents) are available via field
+// OBSClient.
+type Client struct {
+	OBSClient obs_grpc.OBSClient
+}
+
+// New returns a Client sending the requests via the given OBSClient.
+func New(client obs_grpc.OBSClient) *Client {
+	return &Client{OBSClient: client}
+}
+
+// NewFromConn returns a Client sending the requests via the given gRPC
+// connection to the proxy.
+func NewFromConn(conn grpc.ClientConnInterface) *Client {
+	return New(obs_grpc.NewOBSClient(conn))
+}
+
+// Ref refers to an object (like a scene or an input) either by name or by
+// UUID. If both are set, the UUID is used.
+type Ref struct {
+	Name string
+	UUID string
+}
+
+// ByName refers to an object by its name.
+func ByName(name string) Ref {
+	return Ref{Name: name}
+}
+
+// ByUUID refers to an object by its UUID.
+func ByUUID(uuid string) Ref {
+	return Ref{UUID: uuid}
+}
+
+func (ref Ref) String() string {
+	if ref.UUID != "" {
+		return ref.UUID
+	}
+	return ref.Name
+}
+
+// Seq is an iterator over the items of a list response, the error is
+// yielded (with the zero item) if the request failed. It has the same
+// underlying type as iter.Seq2[T, error], so with Go 1.23+ it could be
+// ranged over:
+//
+//	for scene, err := range client.GetSceneListSeq(ctx) {
+//		...
+//	}
+type Seq[T any] func(yield func(T, error) bool)
+
+// Collect returns all the items, or the error of the request.
+func (seq Seq[T]) Collect() ([]T, error) {
+	var (
+		result []T
+		err    error
+	)
+	seq(func(item T, itemErr error) bool {
+		if itemErr != nil {
+			err = itemErr
+			return false
+		}
+		result = append(result, item)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func seqOf[T any](items []T, err error) Seq[T] {
+	return func(yield func(T, error) bool) {
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+func ptr[T any](in T) *T {
+	return &in
+}
+
+func stringsToBytes(in []string) [][]byte {
+	if in == nil {
+		return nil
+	}
+	result := make([][]byte, 0, len(in))
+	for _, s := range in {
+		result = append(result, []byte(s))
+	}
+	return result
+}
+
+func bytesToStrings(in [][]byte) []string {
+	if in == nil {
+		return nil
+	}
+	result := make([]string, 0, len(in))
+	for _, b := range in {
+		result = append(result, string(b))
+	}
+	return result
+}
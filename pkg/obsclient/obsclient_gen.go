@@ -0,0 +1,3302 @@
+// This file was automatically generated by github.com/xaionaro-go/obs-grpc-proxy/scripts/generate
+
+package obsclient
+
+import (
+	"context"
+	"fmt"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+)
+
+// GetPersistentDataResult is the response of GetPersistentData.
+type GetPersistentDataResult struct {
+	SlotValue *obs_grpc.Any
+}
+
+// GetPersistentData sends request GetPersistentData.
+//
+// Gets the value of a "slot" from the selected persistent data realm.
+func (c *Client) GetPersistentData(ctx context.Context, realm string, slotName string) (*GetPersistentDataResult, error) {
+	req := &obs_grpc.GetPersistentDataRequest{
+		Realm:    []byte(realm),
+		SlotName: slotName,
+	}
+	resp, err := c.OBSClient.GetPersistentData(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetPersistentData: %w", err)
+	}
+	return &GetPersistentDataResult{SlotValue: resp.GetSlotValue()}, nil
+}
+
+// SetPersistentData sends request SetPersistentData.
+//
+// Sets the value of a "slot" from the selected persistent data realm.
+func (c *Client) SetPersistentData(ctx context.Context, realm string, slotName string, slotValue *obs_grpc.Any) error {
+	req := &obs_grpc.SetPersistentDataRequest{
+		Realm:     []byte(realm),
+		SlotName:  slotName,
+		SlotValue: slotValue,
+	}
+	if _, err := c.OBSClient.SetPersistentData(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetPersistentData: %w", err)
+	}
+	return nil
+}
+
+// GetSceneCollectionListResult is the response of GetSceneCollectionList.
+type GetSceneCollectionListResult struct {
+	CurrentSceneCollectionName string
+	SceneCollections           []string
+}
+
+// GetSceneCollectionList sends request GetSceneCollectionList.
+//
+// Gets an array of all scene collections
+func (c *Client) GetSceneCollectionList(ctx context.Context) (*GetSceneCollectionListResult, error) {
+	req := &obs_grpc.GetSceneCollectionListRequest{}
+	resp, err := c.OBSClient.GetSceneCollectionList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSceneCollectionList: %w", err)
+	}
+	return &GetSceneCollectionListResult{
+		CurrentSceneCollectionName: resp.GetCurrentSceneCollectionName(),
+		SceneCollections:           bytesToStrings(resp.GetSceneCollections()),
+	}, nil
+}
+
+// GetSceneCollectionListSeq is like GetSceneCollectionList, but iterates over field SceneCollections of the response.
+func (c *Client) GetSceneCollectionListSeq(ctx context.Context) Seq[string] {
+	result, err := c.GetSceneCollectionList(ctx)
+	if err != nil {
+		return seqOf[string](nil, err)
+	}
+	return seqOf(result.SceneCollections, nil)
+}
+
+// SetCurrentSceneCollection sends request SetCurrentSceneCollection.
+//
+// Switches to a scene collection.
+//
+// Note: This will block until the collection has finished changing.
+func (c *Client) SetCurrentSceneCollection(ctx context.Context, sceneCollectionName string) error {
+	req := &obs_grpc.SetCurrentSceneCollectionRequest{SceneCollectionName: sceneCollectionName}
+	if _, err := c.OBSClient.SetCurrentSceneCollection(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetCurrentSceneCollection: %w", err)
+	}
+	return nil
+}
+
+// CreateSceneCollection sends request CreateSceneCollection.
+//
+// Creates a new scene collection, switching to it in the process.
+//
+// Note: This will block until the collection has finished changing.
+func (c *Client) CreateSceneCollection(ctx context.Context, sceneCollectionName string) error {
+	req := &obs_grpc.CreateSceneCollectionRequest{SceneCollectionName: sceneCollectionName}
+	if _, err := c.OBSClient.CreateSceneCollection(ctx, req); err != nil {
+		return fmt.Errorf("unable to CreateSceneCollection: %w", err)
+	}
+	return nil
+}
+
+// GetProfileListResult is the response of GetProfileList.
+type GetProfileListResult struct {
+	CurrentProfileName string
+	Profiles           []string
+}
+
+// GetProfileList sends request GetProfileList.
+//
+// Gets an array of all profiles
+func (c *Client) GetProfileList(ctx context.Context) (*GetProfileListResult, error) {
+	req := &obs_grpc.GetProfileListRequest{}
+	resp, err := c.OBSClient.GetProfileList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetProfileList: %w", err)
+	}
+	return &GetProfileListResult{
+		CurrentProfileName: resp.GetCurrentProfileName(),
+		Profiles:           bytesToStrings(resp.GetProfiles()),
+	}, nil
+}
+
+// GetProfileListSeq is like GetProfileList, but iterates over field Profiles of the response.
+func (c *Client) GetProfileListSeq(ctx context.Context) Seq[string] {
+	result, err := c.GetProfileList(ctx)
+	if err != nil {
+		return seqOf[string](nil, err)
+	}
+	return seqOf(result.Profiles, nil)
+}
+
+// SetCurrentProfile sends request SetCurrentProfile.
+//
+// Switches to a profile.
+func (c *Client) SetCurrentProfile(ctx context.Context, profileName string) error {
+	req := &obs_grpc.SetCurrentProfileRequest{ProfileName: profileName}
+	if _, err := c.OBSClient.SetCurrentProfile(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetCurrentProfile: %w", err)
+	}
+	return nil
+}
+
+// CreateProfile sends request CreateProfile.
+//
+// Creates a new profile, switching to it in the process
+func (c *Client) CreateProfile(ctx context.Context, profileName string) error {
+	req := &obs_grpc.CreateProfileRequest{ProfileName: profileName}
+	if _, err := c.OBSClient.CreateProfile(ctx, req); err != nil {
+		return fmt.Errorf("unable to CreateProfile: %w", err)
+	}
+	return nil
+}
+
+// RemoveProfile sends request RemoveProfile.
+//
+// Removes a profile. If the current profile is chosen, it will change to a different profile first.
+func (c *Client) RemoveProfile(ctx context.Context, profileName string) error {
+	req := &obs_grpc.RemoveProfileRequest{ProfileName: profileName}
+	if _, err := c.OBSClient.RemoveProfile(ctx, req); err != nil {
+		return fmt.Errorf("unable to RemoveProfile: %w", err)
+	}
+	return nil
+}
+
+// GetProfileParameterResult is the response of GetProfileParameter.
+type GetProfileParameterResult struct {
+	ParameterValue        string
+	DefaultParameterValue string
+}
+
+// GetProfileParameter sends request GetProfileParameter.
+//
+// Gets a parameter from the current profile's configuration.
+func (c *Client) GetProfileParameter(ctx context.Context, parameterCategory string, parameterName string) (*GetProfileParameterResult, error) {
+	req := &obs_grpc.GetProfileParameterRequest{
+		ParameterCategory: []byte(parameterCategory),
+		ParameterName:     parameterName,
+	}
+	resp, err := c.OBSClient.GetProfileParameter(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetProfileParameter: %w", err)
+	}
+	return &GetProfileParameterResult{
+		DefaultParameterValue: string(resp.GetDefaultParameterValue()),
+		ParameterValue:        string(resp.GetParameterValue()),
+	}, nil
+}
+
+// SetProfileParameter sends request SetProfileParameter.
+//
+// Sets the value of a parameter in the current profile's configuration.
+func (c *Client) SetProfileParameter(ctx context.Context, parameterCategory string, parameterName string, parameterValue string) error {
+	req := &obs_grpc.SetProfileParameterRequest{
+		ParameterCategory: []byte(parameterCategory),
+		ParameterName:     parameterName,
+		ParameterValue:    []byte(parameterValue),
+	}
+	if _, err := c.OBSClient.SetProfileParameter(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetProfileParameter: %w", err)
+	}
+	return nil
+}
+
+// GetVideoSettingsResult is the response of GetVideoSettings.
+type GetVideoSettingsResult struct {
+	FpsNumerator   int64
+	FpsDenominator int64
+	BaseWidth      int64
+	BaseHeight     int64
+	OutputWidth    int64
+	OutputHeight   int64
+}
+
+// GetVideoSettings sends request GetVideoSettings.
+//
+// Gets the current video settings.
+//
+// Note: To get the true FPS value, divide the FPS numerator by the FPS denominator. Example: `60000/1001`
+func (c *Client) GetVideoSettings(ctx context.Context) (*GetVideoSettingsResult, error) {
+	req := &obs_grpc.GetVideoSettingsRequest{}
+	resp, err := c.OBSClient.GetVideoSettings(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetVideoSettings: %w", err)
+	}
+	return &GetVideoSettingsResult{
+		BaseHeight:     resp.GetBaseHeight(),
+		BaseWidth:      resp.GetBaseWidth(),
+		FpsDenominator: resp.GetFpsDenominator(),
+		FpsNumerator:   resp.GetFpsNumerator(),
+		OutputHeight:   resp.GetOutputHeight(),
+		OutputWidth:    resp.GetOutputWidth(),
+	}, nil
+}
+
+// SetVideoSettingsOption is an optional argument of SetVideoSettings.
+type SetVideoSettingsOption interface {
+	applySetVideoSettings(req *obs_grpc.SetVideoSettingsRequest)
+}
+
+// SetVideoSettings sends request SetVideoSettings.
+//
+// Sets the current video settings.
+//
+// Note: Fields must be specified in pairs. For example, you cannot set only `baseWidth` without needing to specify `baseHeight`.
+func (c *Client) SetVideoSettings(ctx context.Context, opts ...SetVideoSettingsOption) error {
+	req := &obs_grpc.SetVideoSettingsRequest{}
+	for _, opt := range opts {
+		opt.applySetVideoSettings(req)
+	}
+	if _, err := c.OBSClient.SetVideoSettings(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetVideoSettings: %w", err)
+	}
+	return nil
+}
+
+// GetStreamServiceSettingsResult is the response of GetStreamServiceSettings.
+type GetStreamServiceSettingsResult struct {
+	StreamServiceType     string
+	StreamServiceSettings *obs_grpc.StreamServiceSettings
+}
+
+// GetStreamServiceSettings sends request GetStreamServiceSettings.
+//
+// Gets the current stream service settings (stream destination).
+func (c *Client) GetStreamServiceSettings(ctx context.Context) (*GetStreamServiceSettingsResult, error) {
+	req := &obs_grpc.GetStreamServiceSettingsRequest{}
+	resp, err := c.OBSClient.GetStreamServiceSettings(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetStreamServiceSettings: %w", err)
+	}
+	return &GetStreamServiceSettingsResult{
+		StreamServiceSettings: resp.GetStreamServiceSettings(),
+		StreamServiceType:     string(resp.GetStreamServiceType()),
+	}, nil
+}
+
+// SetStreamServiceSettings sends request SetStreamServiceSettings.
+//
+// Sets the current stream service settings (stream destination).
+//
+// Note: Simple RTMP settings can be set with type `rtmp_custom` and the settings fields `server` and `key`.
+func (c *Client) SetStreamServiceSettings(ctx context.Context, streamServiceType string, streamServiceSettings *obs_grpc.StreamServiceSettings) error {
+	req := &obs_grpc.SetStreamServiceSettingsRequest{
+		StreamServiceSettings: streamServiceSettings,
+		StreamServiceType:     []byte(streamServiceType),
+	}
+	if _, err := c.OBSClient.SetStreamServiceSettings(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetStreamServiceSettings: %w", err)
+	}
+	return nil
+}
+
+// GetRecordDirectoryResult is the response of GetRecordDirectory.
+type GetRecordDirectoryResult struct {
+	RecordDirectory string
+}
+
+// GetRecordDirectory sends request GetRecordDirectory.
+//
+// Gets the current directory that the record output is set to.
+func (c *Client) GetRecordDirectory(ctx context.Context) (*GetRecordDirectoryResult, error) {
+	req := &obs_grpc.GetRecordDirectoryRequest{}
+	resp, err := c.OBSClient.GetRecordDirectory(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetRecordDirectory: %w", err)
+	}
+	return &GetRecordDirectoryResult{RecordDirectory: string(resp.GetRecordDirectory())}, nil
+}
+
+// SetRecordDirectory sends request SetRecordDirectory.
+//
+// Sets the current directory that the record output writes files to.
+func (c *Client) SetRecordDirectory(ctx context.Context, recordDirectory string) error {
+	req := &obs_grpc.SetRecordDirectoryRequest{RecordDirectory: []byte(recordDirectory)}
+	if _, err := c.OBSClient.SetRecordDirectory(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetRecordDirectory: %w", err)
+	}
+	return nil
+}
+
+// GetSourceFilterKindListResult is the response of GetSourceFilterKindList.
+type GetSourceFilterKindListResult struct {
+	SourceFilterKinds []string
+}
+
+// GetSourceFilterKindList sends request GetSourceFilterKindList.
+//
+// Gets an array of all available source filter kinds.
+//
+// Similar to `GetInputKindList`
+func (c *Client) GetSourceFilterKindList(ctx context.Context) (*GetSourceFilterKindListResult, error) {
+	req := &obs_grpc.GetSourceFilterKindListRequest{}
+	resp, err := c.OBSClient.GetSourceFilterKindList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSourceFilterKindList: %w", err)
+	}
+	return &GetSourceFilterKindListResult{SourceFilterKinds: resp.GetSourceFilterKinds()}, nil
+}
+
+// GetSourceFilterKindListSeq is like GetSourceFilterKindList, but iterates over field SourceFilterKinds of the response.
+func (c *Client) GetSourceFilterKindListSeq(ctx context.Context) Seq[string] {
+	result, err := c.GetSourceFilterKindList(ctx)
+	if err != nil {
+		return seqOf[string](nil, err)
+	}
+	return seqOf(result.SourceFilterKinds, nil)
+}
+
+// GetSourceFilterListResult is the response of GetSourceFilterList.
+type GetSourceFilterListResult struct {
+	Filters []*obs_grpc.Filter
+}
+
+// GetSourceFilterList sends request GetSourceFilterList.
+//
+// Gets an array of all of a source's filters.
+func (c *Client) GetSourceFilterList(ctx context.Context, source Ref) (*GetSourceFilterListResult, error) {
+	req := &obs_grpc.GetSourceFilterListRequest{}
+	if source.UUID != "" {
+		req.Source = &obs_grpc.GetSourceFilterListRequest_SourceUUID{SourceUUID: source.UUID}
+	} else {
+		req.Source = &obs_grpc.GetSourceFilterListRequest_SourceName{SourceName: source.Name}
+	}
+	resp, err := c.OBSClient.GetSourceFilterList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSourceFilterList: %w", err)
+	}
+	return &GetSourceFilterListResult{Filters: resp.GetFilters()}, nil
+}
+
+// GetSourceFilterListSeq is like GetSourceFilterList, but iterates over field Filters of the response.
+func (c *Client) GetSourceFilterListSeq(ctx context.Context, source Ref) Seq[*obs_grpc.Filter] {
+	result, err := c.GetSourceFilterList(ctx, source)
+	if err != nil {
+		return seqOf[*obs_grpc.Filter](nil, err)
+	}
+	return seqOf(result.Filters, nil)
+}
+
+// GetSourceFilterDefaultSettingsResult is the response of GetSourceFilterDefaultSettings.
+type GetSourceFilterDefaultSettingsResult struct {
+	DefaultFilterSettings *obs_grpc.AbstractObject
+}
+
+// GetSourceFilterDefaultSettings sends request GetSourceFilterDefaultSettings.
+//
+// Gets the default settings for a filter kind.
+func (c *Client) GetSourceFilterDefaultSettings(ctx context.Context, filterKind string) (*GetSourceFilterDefaultSettingsResult, error) {
+	req := &obs_grpc.GetSourceFilterDefaultSettingsRequest{FilterKind: filterKind}
+	resp, err := c.OBSClient.GetSourceFilterDefaultSettings(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSourceFilterDefaultSettings: %w", err)
+	}
+	return &GetSourceFilterDefaultSettingsResult{DefaultFilterSettings: resp.GetDefaultFilterSettings()}, nil
+}
+
+// CreateSourceFilterOption is an optional argument of CreateSourceFilter.
+type CreateSourceFilterOption interface {
+	applyCreateSourceFilter(req *obs_grpc.CreateSourceFilterRequest)
+}
+
+// CreateSourceFilter sends request CreateSourceFilter.
+//
+// Creates a new filter, adding it to the specified source.
+func (c *Client) CreateSourceFilter(ctx context.Context, source Ref, filterName string, filterKind string, opts ...CreateSourceFilterOption) error {
+	req := &obs_grpc.CreateSourceFilterRequest{
+		FilterKind: filterKind,
+		FilterName: filterName,
+	}
+	if source.UUID != "" {
+		req.Source = &obs_grpc.CreateSourceFilterRequest_SourceUUID{SourceUUID: source.UUID}
+	} else {
+		req.Source = &obs_grpc.CreateSourceFilterRequest_SourceName{SourceName: source.Name}
+	}
+	for _, opt := range opts {
+		opt.applyCreateSourceFilter(req)
+	}
+	if _, err := c.OBSClient.CreateSourceFilter(ctx, req); err != nil {
+		return fmt.Errorf("unable to CreateSourceFilter: %w", err)
+	}
+	return nil
+}
+
+// RemoveSourceFilter sends request RemoveSourceFilter.
+//
+// Removes a filter from a source.
+func (c *Client) RemoveSourceFilter(ctx context.Context, source Ref, filterName string) error {
+	req := &obs_grpc.RemoveSourceFilterRequest{FilterName: filterName}
+	if source.UUID != "" {
+		req.Source = &obs_grpc.RemoveSourceFilterRequest_SourceUUID{SourceUUID: source.UUID}
+	} else {
+		req.Source = &obs_grpc.RemoveSourceFilterRequest_SourceName{SourceName: source.Name}
+	}
+	if _, err := c.OBSClient.RemoveSourceFilter(ctx, req); err != nil {
+		return fmt.Errorf("unable to RemoveSourceFilter: %w", err)
+	}
+	return nil
+}
+
+// SetSourceFilterName sends request SetSourceFilterName.
+//
+// Sets the name of a source filter (rename).
+func (c *Client) SetSourceFilterName(ctx context.Context, source Ref, filterName string, newFilterName string) error {
+	req := &obs_grpc.SetSourceFilterNameRequest{
+		FilterName:    filterName,
+		NewFilterName: newFilterName,
+	}
+	if source.UUID != "" {
+		req.Source = &obs_grpc.SetSourceFilterNameRequest_SourceUUID{SourceUUID: source.UUID}
+	} else {
+		req.Source = &obs_grpc.SetSourceFilterNameRequest_SourceName{SourceName: source.Name}
+	}
+	if _, err := c.OBSClient.SetSourceFilterName(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetSourceFilterName: %w", err)
+	}
+	return nil
+}
+
+// GetSourceFilterResult is the response of GetSourceFilter.
+type GetSourceFilterResult struct {
+	FilterEnabled  bool
+	FilterIndex    int64
+	FilterKind     string
+	FilterSettings *obs_grpc.AbstractObject
+}
+
+// GetSourceFilter sends request GetSourceFilter.
+//
+// Gets the info for a specific source filter.
+func (c *Client) GetSourceFilter(ctx context.Context, source Ref, filterName string) (*GetSourceFilterResult, error) {
+	req := &obs_grpc.GetSourceFilterRequest{FilterName: filterName}
+	if source.UUID != "" {
+		req.Source = &obs_grpc.GetSourceFilterRequest_SourceUUID{SourceUUID: source.UUID}
+	} else {
+		req.Source = &obs_grpc.GetSourceFilterRequest_SourceName{SourceName: source.Name}
+	}
+	resp, err := c.OBSClient.GetSourceFilter(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSourceFilter: %w", err)
+	}
+	return &GetSourceFilterResult{
+		FilterEnabled:  resp.GetFilterEnabled(),
+		FilterIndex:    resp.GetFilterIndex(),
+		FilterKind:     resp.GetFilterKind(),
+		FilterSettings: resp.GetFilterSettings(),
+	}, nil
+}
+
+// SetSourceFilterIndex sends request SetSourceFilterIndex.
+//
+// Sets the index position of a filter on a source.
+func (c *Client) SetSourceFilterIndex(ctx context.Context, source Ref, filterName string, filterIndex int64) error {
+	req := &obs_grpc.SetSourceFilterIndexRequest{
+		FilterIndex: filterIndex,
+		FilterName:  filterName,
+	}
+	if source.UUID != "" {
+		req.Source = &obs_grpc.SetSourceFilterIndexRequest_SourceUUID{SourceUUID: source.UUID}
+	} else {
+		req.Source = &obs_grpc.SetSourceFilterIndexRequest_SourceName{SourceName: source.Name}
+	}
+	if _, err := c.OBSClient.SetSourceFilterIndex(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetSourceFilterIndex: %w", err)
+	}
+	return nil
+}
+
+// SetSourceFilterSettingsOption is an optional argument of SetSourceFilterSettings.
+type SetSourceFilterSettingsOption interface {
+	applySetSourceFilterSettings(req *obs_grpc.SetSourceFilterSettingsRequest)
+}
+
+// SetSourceFilterSettings sends request SetSourceFilterSettings.
+//
+// Sets the settings of a source filter.
+func (c *Client) SetSourceFilterSettings(ctx context.Context, source Ref, filterName string, filterSettings *obs_grpc.AbstractObject, opts ...SetSourceFilterSettingsOption) error {
+	req := &obs_grpc.SetSourceFilterSettingsRequest{
+		FilterName:     filterName,
+		FilterSettings: filterSettings,
+	}
+	if source.UUID != "" {
+		req.Source = &obs_grpc.SetSourceFilterSettingsRequest_SourceUUID{SourceUUID: source.UUID}
+	} else {
+		req.Source = &obs_grpc.SetSourceFilterSettingsRequest_SourceName{SourceName: source.Name}
+	}
+	for _, opt := range opts {
+		opt.applySetSourceFilterSettings(req)
+	}
+	if _, err := c.OBSClient.SetSourceFilterSettings(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetSourceFilterSettings: %w", err)
+	}
+	return nil
+}
+
+// SetSourceFilterEnabled sends request SetSourceFilterEnabled.
+//
+// Sets the enable state of a source filter.
+func (c *Client) SetSourceFilterEnabled(ctx context.Context, source Ref, filterName string, filterEnabled bool) error {
+	req := &obs_grpc.SetSourceFilterEnabledRequest{
+		FilterEnabled: filterEnabled,
+		FilterName:    filterName,
+	}
+	if source.UUID != "" {
+		req.Source = &obs_grpc.SetSourceFilterEnabledRequest_SourceUUID{SourceUUID: source.UUID}
+	} else {
+		req.Source = &obs_grpc.SetSourceFilterEnabledRequest_SourceName{SourceName: source.Name}
+	}
+	if _, err := c.OBSClient.SetSourceFilterEnabled(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetSourceFilterEnabled: %w", err)
+	}
+	return nil
+}
+
+// GetVersionResult is the response of GetVersion.
+type GetVersionResult struct {
+	ObsVersion            string
+	ObsWebSocketVersion   string
+	RpcVersion            int64
+	AvailableRequests     []string
+	SupportedImageFormats []string
+	Platform              string
+	PlatformDescription   string
+}
+
+// GetVersion sends request GetVersion.
+//
+// Gets data about the current plugin and RPC version.
+func (c *Client) GetVersion(ctx context.Context) (*GetVersionResult, error) {
+	req := &obs_grpc.GetVersionRequest{}
+	resp, err := c.OBSClient.GetVersion(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetVersion: %w", err)
+	}
+	return &GetVersionResult{
+		AvailableRequests:     bytesToStrings(resp.GetAvailableRequests()),
+		ObsVersion:            string(resp.GetObsVersion()),
+		ObsWebSocketVersion:   string(resp.GetObsWebSocketVersion()),
+		Platform:              string(resp.GetPlatform()),
+		PlatformDescription:   string(resp.GetPlatformDescription()),
+		RpcVersion:            resp.GetRpcVersion(),
+		SupportedImageFormats: bytesToStrings(resp.GetSupportedImageFormats()),
+	}, nil
+}
+
+// GetStatsResult is the response of GetStats.
+type GetStatsResult struct {
+	CpuUsage                         int64
+	MemoryUsage                      int64
+	AvailableDiskSpace               int64
+	ActiveFps                        int64
+	AverageFrameRenderTime           int64
+	RenderSkippedFrames              int64
+	RenderTotalFrames                int64
+	OutputSkippedFrames              int64
+	OutputTotalFrames                int64
+	WebSocketSessionIncomingMessages int64
+	WebSocketSessionOutgoingMessages int64
+}
+
+// GetStats sends request GetStats.
+//
+// Gets statistics about OBS, obs-websocket, and the current session.
+func (c *Client) GetStats(ctx context.Context) (*GetStatsResult, error) {
+	req := &obs_grpc.GetStatsRequest{}
+	resp, err := c.OBSClient.GetStats(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetStats: %w", err)
+	}
+	return &GetStatsResult{
+		ActiveFps:                        resp.GetActiveFps(),
+		AvailableDiskSpace:               resp.GetAvailableDiskSpace(),
+		AverageFrameRenderTime:           resp.GetAverageFrameRenderTime(),
+		CpuUsage:                         resp.GetCpuUsage(),
+		MemoryUsage:                      resp.GetMemoryUsage(),
+		OutputSkippedFrames:              resp.GetOutputSkippedFrames(),
+		OutputTotalFrames:                resp.GetOutputTotalFrames(),
+		RenderSkippedFrames:              resp.GetRenderSkippedFrames(),
+		RenderTotalFrames:                resp.GetRenderTotalFrames(),
+		WebSocketSessionIncomingMessages: resp.GetWebSocketSessionIncomingMessages(),
+		WebSocketSessionOutgoingMessages: resp.GetWebSocketSessionOutgoingMessages(),
+	}, nil
+}
+
+// BroadcastCustomEvent sends request BroadcastCustomEvent.
+//
+// Broadcasts a `CustomEvent` to all WebSocket clients. Receivers are clients which are identified and subscribed.
+func (c *Client) BroadcastCustomEvent(ctx context.Context, eventData *obs_grpc.AbstractObject) error {
+	req := &obs_grpc.BroadcastCustomEventRequest{EventData: eventData}
+	if _, err := c.OBSClient.BroadcastCustomEvent(ctx, req); err != nil {
+		return fmt.Errorf("unable to BroadcastCustomEvent: %w", err)
+	}
+	return nil
+}
+
+// CallVendorRequestOption is an optional argument of CallVendorRequest.
+type CallVendorRequestOption interface {
+	applyCallVendorRequest(req *obs_grpc.CallVendorRequestRequest)
+}
+
+// CallVendorRequestResult is the response of CallVendorRequest.
+type CallVendorRequestResult struct {
+	VendorName   string
+	RequestType  string
+	ResponseData *obs_grpc.AbstractObject
+}
+
+// CallVendorRequest sends request CallVendorRequest.
+//
+// Call a request registered to a vendor.
+//
+// A vendor is a unique name registered by a third-party plugin or script, which allows for custom requests and events to be added to obs-websocket.
+// If a plugin or script implements vendor requests or events, documentation is expected to be provided with them.
+func (c *Client) CallVendorRequest(ctx context.Context, vendorName string, requestType string, opts ...CallVendorRequestOption) (*CallVendorRequestResult, error) {
+	req := &obs_grpc.CallVendorRequestRequest{
+		RequestType: []byte(requestType),
+		VendorName:  vendorName,
+	}
+	for _, opt := range opts {
+		opt.applyCallVendorRequest(req)
+	}
+	resp, err := c.OBSClient.CallVendorRequest(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to CallVendorRequest: %w", err)
+	}
+	return &CallVendorRequestResult{
+		RequestType:  string(resp.GetRequestType()),
+		ResponseData: resp.GetResponseData(),
+		VendorName:   resp.GetVendorName(),
+	}, nil
+}
+
+// GetHotkeyListResult is the response of GetHotkeyList.
+type GetHotkeyListResult struct {
+	Hotkeys []string
+}
+
+// GetHotkeyList sends request GetHotkeyList.
+//
+// Gets an array of all hotkey names in OBS.
+//
+// Note: Hotkey functionality in obs-websocket comes as-is, and we do not guarantee support if things are broken. In 9/10 usages of hotkey requests, there exists a better, more reliable method via other requests.
+func (c *Client) GetHotkeyList(ctx context.Context) (*GetHotkeyListResult, error) {
+	req := &obs_grpc.GetHotkeyListRequest{}
+	resp, err := c.OBSClient.GetHotkeyList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetHotkeyList: %w", err)
+	}
+	return &GetHotkeyListResult{Hotkeys: bytesToStrings(resp.GetHotkeys())}, nil
+}
+
+// GetHotkeyListSeq is like GetHotkeyList, but iterates over field Hotkeys of the response.
+func (c *Client) GetHotkeyListSeq(ctx context.Context) Seq[string] {
+	result, err := c.GetHotkeyList(ctx)
+	if err != nil {
+		return seqOf[string](nil, err)
+	}
+	return seqOf(result.Hotkeys, nil)
+}
+
+// TriggerHotkeyByNameOption is an optional argument of TriggerHotkeyByName.
+type TriggerHotkeyByNameOption interface {
+	applyTriggerHotkeyByName(req *obs_grpc.TriggerHotkeyByNameRequest)
+}
+
+// TriggerHotkeyByName sends request TriggerHotkeyByName.
+//
+// Triggers a hotkey using its name. See `GetHotkeyList`.
+//
+// Note: Hotkey functionality in obs-websocket comes as-is, and we do not guarantee support if things are broken. In 9/10 usages of hotkey requests, there exists a better, more reliable method via other requests.
+func (c *Client) TriggerHotkeyByName(ctx context.Context, hotkeyName string, opts ...TriggerHotkeyByNameOption) error {
+	req := &obs_grpc.TriggerHotkeyByNameRequest{HotkeyName: hotkeyName}
+	for _, opt := range opts {
+		opt.applyTriggerHotkeyByName(req)
+	}
+	if _, err := c.OBSClient.TriggerHotkeyByName(ctx, req); err != nil {
+		return fmt.Errorf("unable to TriggerHotkeyByName: %w", err)
+	}
+	return nil
+}
+
+// TriggerHotkeyByKeySequenceOption is an optional argument of TriggerHotkeyByKeySequence.
+type TriggerHotkeyByKeySequenceOption interface {
+	applyTriggerHotkeyByKeySequence(req *obs_grpc.TriggerHotkeyByKeySequenceRequest)
+}
+
+// TriggerHotkeyByKeySequence sends request TriggerHotkeyByKeySequence.
+//
+// Triggers a hotkey using a sequence of keys.
+//
+// Note: Hotkey functionality in obs-websocket comes as-is, and we do not guarantee support if things are broken. In 9/10 usages of hotkey requests, there exists a better, more reliable method via other requests.
+func (c *Client) TriggerHotkeyByKeySequence(ctx context.Context, opts ...TriggerHotkeyByKeySequenceOption) error {
+	req := &obs_grpc.TriggerHotkeyByKeySequenceRequest{}
+	for _, opt := range opts {
+		opt.applyTriggerHotkeyByKeySequence(req)
+	}
+	if _, err := c.OBSClient.TriggerHotkeyByKeySequence(ctx, req); err != nil {
+		return fmt.Errorf("unable to TriggerHotkeyByKeySequence: %w", err)
+	}
+	return nil
+}
+
+// SleepOption is an optional argument of Sleep.
+type SleepOption interface {
+	applySleep(req *obs_grpc.SleepRequest)
+}
+
+// Sleep sends request Sleep.
+//
+// Sleeps for a time duration or number of frames. Only available in request batches with types `SERIAL_REALTIME` or `SERIAL_FRAME`.
+func (c *Client) Sleep(ctx context.Context, opts ...SleepOption) error {
+	req := &obs_grpc.SleepRequest{}
+	for _, opt := range opts {
+		opt.applySleep(req)
+	}
+	if _, err := c.OBSClient.Sleep(ctx, req); err != nil {
+		return fmt.Errorf("unable to Sleep: %w", err)
+	}
+	return nil
+}
+
+// GetInputListOption is an optional argument of GetInputList.
+type GetInputListOption interface {
+	applyGetInputList(req *obs_grpc.GetInputListRequest)
+}
+
+// GetInputListResult is the response of GetInputList.
+type GetInputListResult struct {
+	Inputs []*obs_grpc.Input
+}
+
+// GetInputList sends request GetInputList.
+//
+// Gets an array of all inputs in OBS.
+func (c *Client) GetInputList(ctx context.Context, opts ...GetInputListOption) (*GetInputListResult, error) {
+	req := &obs_grpc.GetInputListRequest{}
+	for _, opt := range opts {
+		opt.applyGetInputList(req)
+	}
+	resp, err := c.OBSClient.GetInputList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetInputList: %w", err)
+	}
+	return &GetInputListResult{Inputs: resp.GetInputs()}, nil
+}
+
+// GetInputListSeq is like GetInputList, but iterates over field Inputs of the response.
+func (c *Client) GetInputListSeq(ctx context.Context, opts ...GetInputListOption) Seq[*obs_grpc.Input] {
+	result, err := c.GetInputList(ctx, opts...)
+	if err != nil {
+		return seqOf[*obs_grpc.Input](nil, err)
+	}
+	return seqOf(result.Inputs, nil)
+}
+
+// GetInputKindListOption is an optional argument of GetInputKindList.
+type GetInputKindListOption interface {
+	applyGetInputKindList(req *obs_grpc.GetInputKindListRequest)
+}
+
+// GetInputKindListResult is the response of GetInputKindList.
+type GetInputKindListResult struct {
+	InputKinds []string
+}
+
+// GetInputKindList sends request GetInputKindList.
+//
+// Gets an array of all available input kinds in OBS.
+func (c *Client) GetInputKindList(ctx context.Context, opts ...GetInputKindListOption) (*GetInputKindListResult, error) {
+	req := &obs_grpc.GetInputKindListRequest{}
+	for _, opt := range opts {
+		opt.applyGetInputKindList(req)
+	}
+	resp, err := c.OBSClient.GetInputKindList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetInputKindList: %w", err)
+	}
+	return &GetInputKindListResult{InputKinds: resp.GetInputKinds()}, nil
+}
+
+// GetInputKindListSeq is like GetInputKindList, but iterates over field InputKinds of the response.
+func (c *Client) GetInputKindListSeq(ctx context.Context, opts ...GetInputKindListOption) Seq[string] {
+	result, err := c.GetInputKindList(ctx, opts...)
+	if err != nil {
+		return seqOf[string](nil, err)
+	}
+	return seqOf(result.InputKinds, nil)
+}
+
+// GetSpecialInputsResult is the response of GetSpecialInputs.
+type GetSpecialInputsResult struct {
+	Desktop1 string
+	Desktop2 string
+	Mic1     string
+	Mic2     string
+	Mic3     string
+	Mic4     string
+}
+
+// GetSpecialInputs sends request GetSpecialInputs.
+//
+// Gets the names of all special inputs.
+func (c *Client) GetSpecialInputs(ctx context.Context) (*GetSpecialInputsResult, error) {
+	req := &obs_grpc.GetSpecialInputsRequest{}
+	resp, err := c.OBSClient.GetSpecialInputs(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSpecialInputs: %w", err)
+	}
+	return &GetSpecialInputsResult{
+		Desktop1: string(resp.GetDesktop1()),
+		Desktop2: string(resp.GetDesktop2()),
+		Mic1:     string(resp.GetMic1()),
+		Mic2:     string(resp.GetMic2()),
+		Mic3:     string(resp.GetMic3()),
+		Mic4:     string(resp.GetMic4()),
+	}, nil
+}
+
+// CreateInputOption is an optional argument of CreateInput.
+type CreateInputOption interface {
+	applyCreateInput(req *obs_grpc.CreateInputRequest)
+}
+
+// CreateInputResult is the response of CreateInput.
+type CreateInputResult struct {
+	InputUUID   string
+	SceneItemID int64
+}
+
+// CreateInput sends request CreateInput.
+//
+// Creates a new input, adding it as a scene item to the specified scene.
+func (c *Client) CreateInput(ctx context.Context, scene Ref, inputName string, inputKind string, opts ...CreateInputOption) (*CreateInputResult, error) {
+	req := &obs_grpc.CreateInputRequest{
+		InputKind: inputKind,
+		InputName: inputName,
+	}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.CreateInputRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.CreateInputRequest_SceneName{SceneName: scene.Name}
+	}
+	for _, opt := range opts {
+		opt.applyCreateInput(req)
+	}
+	resp, err := c.OBSClient.CreateInput(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to CreateInput: %w", err)
+	}
+	return &CreateInputResult{
+		InputUUID:   resp.GetInputUUID(),
+		SceneItemID: resp.GetSceneItemID(),
+	}, nil
+}
+
+// RemoveInput sends request RemoveInput.
+//
+// Removes an existing input.
+//
+// Note: Will immediately remove all associated scene items.
+func (c *Client) RemoveInput(ctx context.Context, input Ref) error {
+	req := &obs_grpc.RemoveInputRequest{}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.RemoveInputRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.RemoveInputRequest_InputName{InputName: input.Name}
+	}
+	if _, err := c.OBSClient.RemoveInput(ctx, req); err != nil {
+		return fmt.Errorf("unable to RemoveInput: %w", err)
+	}
+	return nil
+}
+
+// SetInputName sends request SetInputName.
+//
+// Sets the name of an input (rename).
+func (c *Client) SetInputName(ctx context.Context, input Ref, newInputName string) error {
+	req := &obs_grpc.SetInputNameRequest{NewInputName: newInputName}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.SetInputNameRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.SetInputNameRequest_InputName{InputName: input.Name}
+	}
+	if _, err := c.OBSClient.SetInputName(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetInputName: %w", err)
+	}
+	return nil
+}
+
+// GetInputDefaultSettingsResult is the response of GetInputDefaultSettings.
+type GetInputDefaultSettingsResult struct {
+	DefaultInputSettings *obs_grpc.AbstractObject
+}
+
+// GetInputDefaultSettings sends request GetInputDefaultSettings.
+//
+// Gets the default settings for an input kind.
+func (c *Client) GetInputDefaultSettings(ctx context.Context, inputKind string) (*GetInputDefaultSettingsResult, error) {
+	req := &obs_grpc.GetInputDefaultSettingsRequest{InputKind: inputKind}
+	resp, err := c.OBSClient.GetInputDefaultSettings(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetInputDefaultSettings: %w", err)
+	}
+	return &GetInputDefaultSettingsResult{DefaultInputSettings: resp.GetDefaultInputSettings()}, nil
+}
+
+// GetInputSettingsResult is the response of GetInputSettings.
+type GetInputSettingsResult struct {
+	InputSettings *obs_grpc.AbstractObject
+	InputKind     string
+}
+
+// GetInputSettings sends request GetInputSettings.
+//
+// Gets the settings of an input.
+//
+// Note: Does not include defaults. To create the entire settings object, overlay `inputSettings` over the `defaultInputSettings` provided by `GetInputDefaultSettings`.
+func (c *Client) GetInputSettings(ctx context.Context, input Ref) (*GetInputSettingsResult, error) {
+	req := &obs_grpc.GetInputSettingsRequest{}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.GetInputSettingsRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.GetInputSettingsRequest_InputName{InputName: input.Name}
+	}
+	resp, err := c.OBSClient.GetInputSettings(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetInputSettings: %w", err)
+	}
+	return &GetInputSettingsResult{
+		InputKind:     resp.GetInputKind(),
+		InputSettings: resp.GetInputSettings(),
+	}, nil
+}
+
+// SetInputSettingsOption is an optional argument of SetInputSettings.
+type SetInputSettingsOption interface {
+	applySetInputSettings(req *obs_grpc.SetInputSettingsRequest)
+}
+
+// SetInputSettings sends request SetInputSettings.
+//
+// Sets the settings of an input.
+func (c *Client) SetInputSettings(ctx context.Context, input Ref, inputSettings *obs_grpc.AbstractObject, opts ...SetInputSettingsOption) error {
+	req := &obs_grpc.SetInputSettingsRequest{InputSettings: inputSettings}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.SetInputSettingsRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.SetInputSettingsRequest_InputName{InputName: input.Name}
+	}
+	for _, opt := range opts {
+		opt.applySetInputSettings(req)
+	}
+	if _, err := c.OBSClient.SetInputSettings(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetInputSettings: %w", err)
+	}
+	return nil
+}
+
+// GetInputMuteResult is the response of GetInputMute.
+type GetInputMuteResult struct {
+	InputMuted bool
+}
+
+// GetInputMute sends request GetInputMute.
+//
+// Gets the audio mute state of an input.
+func (c *Client) GetInputMute(ctx context.Context, input Ref) (*GetInputMuteResult, error) {
+	req := &obs_grpc.GetInputMuteRequest{}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.GetInputMuteRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.GetInputMuteRequest_InputName{InputName: input.Name}
+	}
+	resp, err := c.OBSClient.GetInputMute(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetInputMute: %w", err)
+	}
+	return &GetInputMuteResult{InputMuted: resp.GetInputMuted()}, nil
+}
+
+// SetInputMute sends request SetInputMute.
+//
+// Sets the audio mute state of an input.
+func (c *Client) SetInputMute(ctx context.Context, input Ref, inputMuted bool) error {
+	req := &obs_grpc.SetInputMuteRequest{InputMuted: inputMuted}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.SetInputMuteRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.SetInputMuteRequest_InputName{InputName: input.Name}
+	}
+	if _, err := c.OBSClient.SetInputMute(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetInputMute: %w", err)
+	}
+	return nil
+}
+
+// ToggleInputMuteResult is the response of ToggleInputMute.
+type ToggleInputMuteResult struct {
+	InputMuted bool
+}
+
+// ToggleInputMute sends request ToggleInputMute.
+//
+// Toggles the audio mute state of an input.
+func (c *Client) ToggleInputMute(ctx context.Context, input Ref) (*ToggleInputMuteResult, error) {
+	req := &obs_grpc.ToggleInputMuteRequest{}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.ToggleInputMuteRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.ToggleInputMuteRequest_InputName{InputName: input.Name}
+	}
+	resp, err := c.OBSClient.ToggleInputMute(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to ToggleInputMute: %w", err)
+	}
+	return &ToggleInputMuteResult{InputMuted: resp.GetInputMuted()}, nil
+}
+
+// GetInputVolumeResult is the response of GetInputVolume.
+type GetInputVolumeResult struct {
+	InputVolumeMul float64
+	InputVolumeDb  float64
+}
+
+// GetInputVolume sends request GetInputVolume.
+//
+// Gets the current volume setting of an input.
+func (c *Client) GetInputVolume(ctx context.Context, input Ref) (*GetInputVolumeResult, error) {
+	req := &obs_grpc.GetInputVolumeRequest{}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.GetInputVolumeRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.GetInputVolumeRequest_InputName{InputName: input.Name}
+	}
+	resp, err := c.OBSClient.GetInputVolume(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetInputVolume: %w", err)
+	}
+	return &GetInputVolumeResult{
+		InputVolumeDb:  resp.GetInputVolumeDb(),
+		InputVolumeMul: resp.GetInputVolumeMul(),
+	}, nil
+}
+
+// SetInputVolumeOption is an optional argument of SetInputVolume.
+type SetInputVolumeOption interface {
+	applySetInputVolume(req *obs_grpc.SetInputVolumeRequest)
+}
+
+// SetInputVolume sends request SetInputVolume.
+//
+// Sets the volume setting of an input.
+func (c *Client) SetInputVolume(ctx context.Context, input Ref, opts ...SetInputVolumeOption) error {
+	req := &obs_grpc.SetInputVolumeRequest{}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.SetInputVolumeRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.SetInputVolumeRequest_InputName{InputName: input.Name}
+	}
+	for _, opt := range opts {
+		opt.applySetInputVolume(req)
+	}
+	if _, err := c.OBSClient.SetInputVolume(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetInputVolume: %w", err)
+	}
+	return nil
+}
+
+// GetInputAudioBalanceResult is the response of GetInputAudioBalance.
+type GetInputAudioBalanceResult struct {
+	InputAudioBalance float64
+}
+
+// GetInputAudioBalance sends request GetInputAudioBalance.
+//
+// Gets the audio balance of an input.
+func (c *Client) GetInputAudioBalance(ctx context.Context, input Ref) (*GetInputAudioBalanceResult, error) {
+	req := &obs_grpc.GetInputAudioBalanceRequest{}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.GetInputAudioBalanceRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.GetInputAudioBalanceRequest_InputName{InputName: input.Name}
+	}
+	resp, err := c.OBSClient.GetInputAudioBalance(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetInputAudioBalance: %w", err)
+	}
+	return &GetInputAudioBalanceResult{InputAudioBalance: resp.GetInputAudioBalance()}, nil
+}
+
+// SetInputAudioBalance sends request SetInputAudioBalance.
+//
+// Sets the audio balance of an input.
+func (c *Client) SetInputAudioBalance(ctx context.Context, input Ref, inputAudioBalance float64) error {
+	req := &obs_grpc.SetInputAudioBalanceRequest{InputAudioBalance: inputAudioBalance}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.SetInputAudioBalanceRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.SetInputAudioBalanceRequest_InputName{InputName: input.Name}
+	}
+	if _, err := c.OBSClient.SetInputAudioBalance(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetInputAudioBalance: %w", err)
+	}
+	return nil
+}
+
+// GetInputAudioSyncOffsetResult is the response of GetInputAudioSyncOffset.
+type GetInputAudioSyncOffsetResult struct {
+	InputAudioSyncOffset int64
+}
+
+// GetInputAudioSyncOffset sends request GetInputAudioSyncOffset.
+//
+// Gets the audio sync offset of an input.
+//
+// Note: The audio sync offset can be negative too!
+func (c *Client) GetInputAudioSyncOffset(ctx context.Context, input Ref) (*GetInputAudioSyncOffsetResult, error) {
+	req := &obs_grpc.GetInputAudioSyncOffsetRequest{}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.GetInputAudioSyncOffsetRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.GetInputAudioSyncOffsetRequest_InputName{InputName: input.Name}
+	}
+	resp, err := c.OBSClient.GetInputAudioSyncOffset(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetInputAudioSyncOffset: %w", err)
+	}
+	return &GetInputAudioSyncOffsetResult{InputAudioSyncOffset: resp.GetInputAudioSyncOffset()}, nil
+}
+
+// SetInputAudioSyncOffset sends request SetInputAudioSyncOffset.
+//
+// Sets the audio sync offset of an input.
+func (c *Client) SetInputAudioSyncOffset(ctx context.Context, input Ref, inputAudioSyncOffset int64) error {
+	req := &obs_grpc.SetInputAudioSyncOffsetRequest{InputAudioSyncOffset: inputAudioSyncOffset}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.SetInputAudioSyncOffsetRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.SetInputAudioSyncOffsetRequest_InputName{InputName: input.Name}
+	}
+	if _, err := c.OBSClient.SetInputAudioSyncOffset(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetInputAudioSyncOffset: %w", err)
+	}
+	return nil
+}
+
+// GetInputAudioMonitorTypeResult is the response of GetInputAudioMonitorType.
+type GetInputAudioMonitorTypeResult struct {
+	MonitorType MonitorType
+}
+
+// GetInputAudioMonitorType sends request GetInputAudioMonitorType.
+//
+// Gets the audio monitor type of an input.
+//
+// The available audio monitor types are:
+//
+// - `OBS_MONITORING_TYPE_NONE`
+// - `OBS_MONITORING_TYPE_MONITOR_ONLY`
+// - `OBS_MONITORING_TYPE_MONITOR_AND_OUTPUT`
+func (c *Client) GetInputAudioMonitorType(ctx context.Context, input Ref) (*GetInputAudioMonitorTypeResult, error) {
+	req := &obs_grpc.GetInputAudioMonitorTypeRequest{}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.GetInputAudioMonitorTypeRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.GetInputAudioMonitorTypeRequest_InputName{InputName: input.Name}
+	}
+	resp, err := c.OBSClient.GetInputAudioMonitorType(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetInputAudioMonitorType: %w", err)
+	}
+	return &GetInputAudioMonitorTypeResult{MonitorType: MonitorType(string(resp.GetMonitorType()))}, nil
+}
+
+// SetInputAudioMonitorType sends request SetInputAudioMonitorType.
+//
+// Sets the audio monitor type of an input.
+func (c *Client) SetInputAudioMonitorType(ctx context.Context, input Ref, monitorType MonitorType) error {
+	req := &obs_grpc.SetInputAudioMonitorTypeRequest{MonitorType: []byte(string(monitorType))}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.SetInputAudioMonitorTypeRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.SetInputAudioMonitorTypeRequest_InputName{InputName: input.Name}
+	}
+	if _, err := c.OBSClient.SetInputAudioMonitorType(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetInputAudioMonitorType: %w", err)
+	}
+	return nil
+}
+
+// GetInputAudioTracksResult is the response of GetInputAudioTracks.
+type GetInputAudioTracksResult struct {
+	InputAudioTracks *obs_grpc.InputAudioTracks
+}
+
+// GetInputAudioTracks sends request GetInputAudioTracks.
+//
+// Gets the enable state of all audio tracks of an input.
+func (c *Client) GetInputAudioTracks(ctx context.Context, input Ref) (*GetInputAudioTracksResult, error) {
+	req := &obs_grpc.GetInputAudioTracksRequest{}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.GetInputAudioTracksRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.GetInputAudioTracksRequest_InputName{InputName: input.Name}
+	}
+	resp, err := c.OBSClient.GetInputAudioTracks(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetInputAudioTracks: %w", err)
+	}
+	return &GetInputAudioTracksResult{InputAudioTracks: resp.GetInputAudioTracks()}, nil
+}
+
+// SetInputAudioTracks sends request SetInputAudioTracks.
+//
+// Sets the enable state of audio tracks of an input.
+func (c *Client) SetInputAudioTracks(ctx context.Context, input Ref, inputAudioTracks *obs_grpc.InputAudioTracks) error {
+	req := &obs_grpc.SetInputAudioTracksRequest{InputAudioTracks: inputAudioTracks}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.SetInputAudioTracksRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.SetInputAudioTracksRequest_InputName{InputName: input.Name}
+	}
+	if _, err := c.OBSClient.SetInputAudioTracks(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetInputAudioTracks: %w", err)
+	}
+	return nil
+}
+
+// GetInputPropertiesListPropertyItemsResult is the response of GetInputPropertiesListPropertyItems.
+type GetInputPropertiesListPropertyItemsResult struct {
+	PropertyItems []*obs_grpc.PropertyItem
+}
+
+// GetInputPropertiesListPropertyItems sends request GetInputPropertiesListPropertyItems.
+//
+// Gets the items of a list property from an input's properties.
+//
+// Note: Use this in cases where an input provides a dynamic, selectable list of items. For example, display capture, where it provides a list of available displays.
+func (c *Client) GetInputPropertiesListPropertyItems(ctx context.Context, input Ref, propertyName string) (*GetInputPropertiesListPropertyItemsResult, error) {
+	req := &obs_grpc.GetInputPropertiesListPropertyItemsRequest{PropertyName: propertyName}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.GetInputPropertiesListPropertyItemsRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.GetInputPropertiesListPropertyItemsRequest_InputName{InputName: input.Name}
+	}
+	resp, err := c.OBSClient.GetInputPropertiesListPropertyItems(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetInputPropertiesListPropertyItems: %w", err)
+	}
+	return &GetInputPropertiesListPropertyItemsResult{PropertyItems: resp.GetPropertyItems()}, nil
+}
+
+// GetInputPropertiesListPropertyItemsSeq is like GetInputPropertiesListPropertyItems, but iterates over field PropertyItems of the response.
+func (c *Client) GetInputPropertiesListPropertyItemsSeq(ctx context.Context, input Ref, propertyName string) Seq[*obs_grpc.PropertyItem] {
+	result, err := c.GetInputPropertiesListPropertyItems(ctx, input, propertyName)
+	if err != nil {
+		return seqOf[*obs_grpc.PropertyItem](nil, err)
+	}
+	return seqOf(result.PropertyItems, nil)
+}
+
+// PressInputPropertiesButton sends request PressInputPropertiesButton.
+//
+// Presses a button in the properties of an input.
+//
+// Some known `propertyName` values are:
+//
+// - `refreshnocache` - Browser source reload button
+//
+// Note: Use this in cases where there is a button in the properties of an input that cannot be accessed in any other way. For example, browser sources, where there is a refresh button.
+func (c *Client) PressInputPropertiesButton(ctx context.Context, input Ref, propertyName string) error {
+	req := &obs_grpc.PressInputPropertiesButtonRequest{PropertyName: propertyName}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.PressInputPropertiesButtonRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.PressInputPropertiesButtonRequest_InputName{InputName: input.Name}
+	}
+	if _, err := c.OBSClient.PressInputPropertiesButton(ctx, req); err != nil {
+		return fmt.Errorf("unable to PressInputPropertiesButton: %w", err)
+	}
+	return nil
+}
+
+// GetMediaInputStatusResult is the response of GetMediaInputStatus.
+type GetMediaInputStatusResult struct {
+	MediaState    MediaState
+	MediaDuration int64
+	MediaCursor   int64
+}
+
+// GetMediaInputStatus sends request GetMediaInputStatus.
+//
+// Gets the status of a media input.
+//
+// Media States:
+//
+// - `OBS_MEDIA_STATE_NONE`
+// - `OBS_MEDIA_STATE_PLAYING`
+// - `OBS_MEDIA_STATE_OPENING`
+// - `OBS_MEDIA_STATE_BUFFERING`
+// - `OBS_MEDIA_STATE_PAUSED`
+// - `OBS_MEDIA_STATE_STOPPED`
+// - `OBS_MEDIA_STATE_ENDED`
+// - `OBS_MEDIA_STATE_ERROR`
+func (c *Client) GetMediaInputStatus(ctx context.Context, input Ref) (*GetMediaInputStatusResult, error) {
+	req := &obs_grpc.GetMediaInputStatusRequest{}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.GetMediaInputStatusRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.GetMediaInputStatusRequest_InputName{InputName: input.Name}
+	}
+	resp, err := c.OBSClient.GetMediaInputStatus(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetMediaInputStatus: %w", err)
+	}
+	return &GetMediaInputStatusResult{
+		MediaCursor:   resp.GetMediaCursor(),
+		MediaDuration: resp.GetMediaDuration(),
+		MediaState:    MediaState(string(resp.GetMediaState())),
+	}, nil
+}
+
+// SetMediaInputCursor sends request SetMediaInputCursor.
+//
+// Sets the cursor position of a media input.
+//
+// This request does not perform bounds checking of the cursor position.
+func (c *Client) SetMediaInputCursor(ctx context.Context, input Ref, mediaCursor int64) error {
+	req := &obs_grpc.SetMediaInputCursorRequest{MediaCursor: mediaCursor}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.SetMediaInputCursorRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.SetMediaInputCursorRequest_InputName{InputName: input.Name}
+	}
+	if _, err := c.OBSClient.SetMediaInputCursor(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetMediaInputCursor: %w", err)
+	}
+	return nil
+}
+
+// OffsetMediaInputCursor sends request OffsetMediaInputCursor.
+//
+// Offsets the current cursor position of a media input by the specified value.
+//
+// This request does not perform bounds checking of the cursor position.
+func (c *Client) OffsetMediaInputCursor(ctx context.Context, input Ref, mediaCursorOffset int64) error {
+	req := &obs_grpc.OffsetMediaInputCursorRequest{MediaCursorOffset: mediaCursorOffset}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.OffsetMediaInputCursorRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.OffsetMediaInputCursorRequest_InputName{InputName: input.Name}
+	}
+	if _, err := c.OBSClient.OffsetMediaInputCursor(ctx, req); err != nil {
+		return fmt.Errorf("unable to OffsetMediaInputCursor: %w", err)
+	}
+	return nil
+}
+
+// TriggerMediaInputAction sends request TriggerMediaInputAction.
+//
+// Triggers an action on a media input.
+func (c *Client) TriggerMediaInputAction(ctx context.Context, input Ref, mediaAction obs_grpc.ObsMediaInputAction) error {
+	req := &obs_grpc.TriggerMediaInputActionRequest{MediaAction: mediaAction.String()}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.TriggerMediaInputActionRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.TriggerMediaInputActionRequest_InputName{InputName: input.Name}
+	}
+	if _, err := c.OBSClient.TriggerMediaInputAction(ctx, req); err != nil {
+		return fmt.Errorf("unable to TriggerMediaInputAction: %w", err)
+	}
+	return nil
+}
+
+// GetVirtualCamStatusResult is the response of GetVirtualCamStatus.
+type GetVirtualCamStatusResult struct {
+	OutputActive bool
+}
+
+// GetVirtualCamStatus sends request GetVirtualCamStatus.
+//
+// Gets the status of the virtualcam output.
+func (c *Client) GetVirtualCamStatus(ctx context.Context) (*GetVirtualCamStatusResult, error) {
+	req := &obs_grpc.GetVirtualCamStatusRequest{}
+	resp, err := c.OBSClient.GetVirtualCamStatus(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetVirtualCamStatus: %w", err)
+	}
+	return &GetVirtualCamStatusResult{OutputActive: resp.GetOutputActive()}, nil
+}
+
+// ToggleVirtualCamResult is the response of ToggleVirtualCam.
+type ToggleVirtualCamResult struct {
+	OutputActive bool
+}
+
+// ToggleVirtualCam sends request ToggleVirtualCam.
+//
+// Toggles the state of the virtualcam output.
+func (c *Client) ToggleVirtualCam(ctx context.Context) (*ToggleVirtualCamResult, error) {
+	req := &obs_grpc.ToggleVirtualCamRequest{}
+	resp, err := c.OBSClient.ToggleVirtualCam(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to ToggleVirtualCam: %w", err)
+	}
+	return &ToggleVirtualCamResult{OutputActive: resp.GetOutputActive()}, nil
+}
+
+// StartVirtualCam sends request StartVirtualCam.
+//
+// Starts the virtualcam output.
+func (c *Client) StartVirtualCam(ctx context.Context) error {
+	req := &obs_grpc.StartVirtualCamRequest{}
+	if _, err := c.OBSClient.StartVirtualCam(ctx, req); err != nil {
+		return fmt.Errorf("unable to StartVirtualCam: %w", err)
+	}
+	return nil
+}
+
+// StopVirtualCam sends request StopVirtualCam.
+//
+// Stops the virtualcam output.
+func (c *Client) StopVirtualCam(ctx context.Context) error {
+	req := &obs_grpc.StopVirtualCamRequest{}
+	if _, err := c.OBSClient.StopVirtualCam(ctx, req); err != nil {
+		return fmt.Errorf("unable to StopVirtualCam: %w", err)
+	}
+	return nil
+}
+
+// GetReplayBufferStatusResult is the response of GetReplayBufferStatus.
+type GetReplayBufferStatusResult struct {
+	OutputActive bool
+}
+
+// GetReplayBufferStatus sends request GetReplayBufferStatus.
+//
+// Gets the status of the replay buffer output.
+func (c *Client) GetReplayBufferStatus(ctx context.Context) (*GetReplayBufferStatusResult, error) {
+	req := &obs_grpc.GetReplayBufferStatusRequest{}
+	resp, err := c.OBSClient.GetReplayBufferStatus(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetReplayBufferStatus: %w", err)
+	}
+	return &GetReplayBufferStatusResult{OutputActive: resp.GetOutputActive()}, nil
+}
+
+// ToggleReplayBufferResult is the response of ToggleReplayBuffer.
+type ToggleReplayBufferResult struct {
+	OutputActive bool
+}
+
+// ToggleReplayBuffer sends request ToggleReplayBuffer.
+//
+// Toggles the state of the replay buffer output.
+func (c *Client) ToggleReplayBuffer(ctx context.Context) (*ToggleReplayBufferResult, error) {
+	req := &obs_grpc.ToggleReplayBufferRequest{}
+	resp, err := c.OBSClient.ToggleReplayBuffer(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to ToggleReplayBuffer: %w", err)
+	}
+	return &ToggleReplayBufferResult{OutputActive: resp.GetOutputActive()}, nil
+}
+
+// StartReplayBuffer sends request StartReplayBuffer.
+//
+// Starts the replay buffer output.
+func (c *Client) StartReplayBuffer(ctx context.Context) error {
+	req := &obs_grpc.StartReplayBufferRequest{}
+	if _, err := c.OBSClient.StartReplayBuffer(ctx, req); err != nil {
+		return fmt.Errorf("unable to StartReplayBuffer: %w", err)
+	}
+	return nil
+}
+
+// StopReplayBuffer sends request StopReplayBuffer.
+//
+// Stops the replay buffer output.
+func (c *Client) StopReplayBuffer(ctx context.Context) error {
+	req := &obs_grpc.StopReplayBufferRequest{}
+	if _, err := c.OBSClient.StopReplayBuffer(ctx, req); err != nil {
+		return fmt.Errorf("unable to StopReplayBuffer: %w", err)
+	}
+	return nil
+}
+
+// SaveReplayBuffer sends request SaveReplayBuffer.
+//
+// Saves the contents of the replay buffer output.
+func (c *Client) SaveReplayBuffer(ctx context.Context) error {
+	req := &obs_grpc.SaveReplayBufferRequest{}
+	if _, err := c.OBSClient.SaveReplayBuffer(ctx, req); err != nil {
+		return fmt.Errorf("unable to SaveReplayBuffer: %w", err)
+	}
+	return nil
+}
+
+// GetLastReplayBufferReplayResult is the response of GetLastReplayBufferReplay.
+type GetLastReplayBufferReplayResult struct {
+	SavedReplayPath string
+}
+
+// GetLastReplayBufferReplay sends request GetLastReplayBufferReplay.
+//
+// Gets the filename of the last replay buffer save file.
+func (c *Client) GetLastReplayBufferReplay(ctx context.Context) (*GetLastReplayBufferReplayResult, error) {
+	req := &obs_grpc.GetLastReplayBufferReplayRequest{}
+	resp, err := c.OBSClient.GetLastReplayBufferReplay(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetLastReplayBufferReplay: %w", err)
+	}
+	return &GetLastReplayBufferReplayResult{SavedReplayPath: resp.GetSavedReplayPath()}, nil
+}
+
+// GetOutputListResult is the response of GetOutputList.
+type GetOutputListResult struct {
+	Outputs []*obs_grpc.Output
+}
+
+// GetOutputList sends request GetOutputList.
+//
+// Gets the list of available outputs.
+func (c *Client) GetOutputList(ctx context.Context) (*GetOutputListResult, error) {
+	req := &obs_grpc.GetOutputListRequest{}
+	resp, err := c.OBSClient.GetOutputList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetOutputList: %w", err)
+	}
+	return &GetOutputListResult{Outputs: resp.GetOutputs()}, nil
+}
+
+// GetOutputListSeq is like GetOutputList, but iterates over field Outputs of the response.
+func (c *Client) GetOutputListSeq(ctx context.Context) Seq[*obs_grpc.Output] {
+	result, err := c.GetOutputList(ctx)
+	if err != nil {
+		return seqOf[*obs_grpc.Output](nil, err)
+	}
+	return seqOf(result.Outputs, nil)
+}
+
+// GetOutputStatusResult is the response of GetOutputStatus.
+type GetOutputStatusResult struct {
+	OutputActive        bool
+	OutputReconnecting  bool
+	OutputTimecode      string
+	OutputDuration      int64
+	OutputCongestion    int64
+	OutputBytes         int64
+	OutputSkippedFrames int64
+	OutputTotalFrames   int64
+}
+
+// GetOutputStatus sends request GetOutputStatus.
+//
+// Gets the status of an output.
+func (c *Client) GetOutputStatus(ctx context.Context, outputName string) (*GetOutputStatusResult, error) {
+	req := &obs_grpc.GetOutputStatusRequest{OutputName: outputName}
+	resp, err := c.OBSClient.GetOutputStatus(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetOutputStatus: %w", err)
+	}
+	return &GetOutputStatusResult{
+		OutputActive:        resp.GetOutputActive(),
+		OutputBytes:         resp.GetOutputBytes(),
+		OutputCongestion:    resp.GetOutputCongestion(),
+		OutputDuration:      resp.GetOutputDuration(),
+		OutputReconnecting:  resp.GetOutputReconnecting(),
+		OutputSkippedFrames: resp.GetOutputSkippedFrames(),
+		OutputTimecode:      string(resp.GetOutputTimecode()),
+		OutputTotalFrames:   resp.GetOutputTotalFrames(),
+	}, nil
+}
+
+// ToggleOutputResult is the response of ToggleOutput.
+type ToggleOutputResult struct {
+	OutputActive bool
+}
+
+// ToggleOutput sends request ToggleOutput.
+//
+// Toggles the status of an output.
+func (c *Client) ToggleOutput(ctx context.Context, outputName string) (*ToggleOutputResult, error) {
+	req := &obs_grpc.ToggleOutputRequest{OutputName: outputName}
+	resp, err := c.OBSClient.ToggleOutput(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to ToggleOutput: %w", err)
+	}
+	return &ToggleOutputResult{OutputActive: resp.GetOutputActive()}, nil
+}
+
+// StartOutput sends request StartOutput.
+//
+// Starts an output.
+func (c *Client) StartOutput(ctx context.Context, outputName string) error {
+	req := &obs_grpc.StartOutputRequest{OutputName: outputName}
+	if _, err := c.OBSClient.StartOutput(ctx, req); err != nil {
+		return fmt.Errorf("unable to StartOutput: %w", err)
+	}
+	return nil
+}
+
+// StopOutput sends request StopOutput.
+//
+// Stops an output.
+func (c *Client) StopOutput(ctx context.Context, outputName string) error {
+	req := &obs_grpc.StopOutputRequest{OutputName: outputName}
+	if _, err := c.OBSClient.StopOutput(ctx, req); err != nil {
+		return fmt.Errorf("unable to StopOutput: %w", err)
+	}
+	return nil
+}
+
+// GetOutputSettingsResult is the response of GetOutputSettings.
+type GetOutputSettingsResult struct {
+	OutputSettings *obs_grpc.AbstractObject
+}
+
+// GetOutputSettings sends request GetOutputSettings.
+//
+// Gets the settings of an output.
+func (c *Client) GetOutputSettings(ctx context.Context, outputName string) (*GetOutputSettingsResult, error) {
+	req := &obs_grpc.GetOutputSettingsRequest{OutputName: outputName}
+	resp, err := c.OBSClient.GetOutputSettings(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetOutputSettings: %w", err)
+	}
+	return &GetOutputSettingsResult{OutputSettings: resp.GetOutputSettings()}, nil
+}
+
+// SetOutputSettings sends request SetOutputSettings.
+//
+// Sets the settings of an output.
+func (c *Client) SetOutputSettings(ctx context.Context, outputName string, outputSettings *obs_grpc.AbstractObject) error {
+	req := &obs_grpc.SetOutputSettingsRequest{
+		OutputName:     outputName,
+		OutputSettings: outputSettings,
+	}
+	if _, err := c.OBSClient.SetOutputSettings(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetOutputSettings: %w", err)
+	}
+	return nil
+}
+
+// GetRecordStatusResult is the response of GetRecordStatus.
+type GetRecordStatusResult struct {
+	OutputActive   bool
+	OutputPaused   bool
+	OutputTimecode string
+	OutputDuration int64
+	OutputBytes    int64
+}
+
+// GetRecordStatus sends request GetRecordStatus.
+//
+// Gets the status of the record output.
+func (c *Client) GetRecordStatus(ctx context.Context) (*GetRecordStatusResult, error) {
+	req := &obs_grpc.GetRecordStatusRequest{}
+	resp, err := c.OBSClient.GetRecordStatus(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetRecordStatus: %w", err)
+	}
+	return &GetRecordStatusResult{
+		OutputActive:   resp.GetOutputActive(),
+		OutputBytes:    resp.GetOutputBytes(),
+		OutputDuration: resp.GetOutputDuration(),
+		OutputPaused:   resp.GetOutputPaused(),
+		OutputTimecode: string(resp.GetOutputTimecode()),
+	}, nil
+}
+
+// ToggleRecordResult is the response of ToggleRecord.
+type ToggleRecordResult struct {
+	OutputActive bool
+}
+
+// ToggleRecord sends request ToggleRecord.
+//
+// Toggles the status of the record output.
+func (c *Client) ToggleRecord(ctx context.Context) (*ToggleRecordResult, error) {
+	req := &obs_grpc.ToggleRecordRequest{}
+	resp, err := c.OBSClient.ToggleRecord(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to ToggleRecord: %w", err)
+	}
+	return &ToggleRecordResult{OutputActive: resp.GetOutputActive()}, nil
+}
+
+// StartRecord sends request StartRecord.
+//
+// Starts the record output.
+func (c *Client) StartRecord(ctx context.Context) error {
+	req := &obs_grpc.StartRecordRequest{}
+	if _, err := c.OBSClient.StartRecord(ctx, req); err != nil {
+		return fmt.Errorf("unable to StartRecord: %w", err)
+	}
+	return nil
+}
+
+// StopRecordResult is the response of StopRecord.
+type StopRecordResult struct {
+	OutputPath string
+}
+
+// StopRecord sends request StopRecord.
+//
+// Stops the record output.
+func (c *Client) StopRecord(ctx context.Context) (*StopRecordResult, error) {
+	req := &obs_grpc.StopRecordRequest{}
+	resp, err := c.OBSClient.StopRecord(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to StopRecord: %w", err)
+	}
+	return &StopRecordResult{OutputPath: resp.GetOutputPath()}, nil
+}
+
+// ToggleRecordPause sends request ToggleRecordPause.
+//
+// Toggles pause on the record output.
+func (c *Client) ToggleRecordPause(ctx context.Context) error {
+	req := &obs_grpc.ToggleRecordPauseRequest{}
+	if _, err := c.OBSClient.ToggleRecordPause(ctx, req); err != nil {
+		return fmt.Errorf("unable to ToggleRecordPause: %w", err)
+	}
+	return nil
+}
+
+// PauseRecord sends request PauseRecord.
+//
+// Pauses the record output.
+func (c *Client) PauseRecord(ctx context.Context) error {
+	req := &obs_grpc.PauseRecordRequest{}
+	if _, err := c.OBSClient.PauseRecord(ctx, req); err != nil {
+		return fmt.Errorf("unable to PauseRecord: %w", err)
+	}
+	return nil
+}
+
+// ResumeRecord sends request ResumeRecord.
+//
+// Resumes the record output.
+func (c *Client) ResumeRecord(ctx context.Context) error {
+	req := &obs_grpc.ResumeRecordRequest{}
+	if _, err := c.OBSClient.ResumeRecord(ctx, req); err != nil {
+		return fmt.Errorf("unable to ResumeRecord: %w", err)
+	}
+	return nil
+}
+
+// SplitRecordFile sends request SplitRecordFile.
+//
+// Splits the current file being recorded into a new file.
+func (c *Client) SplitRecordFile(ctx context.Context) error {
+	req := &obs_grpc.SplitRecordFileRequest{}
+	if _, err := c.OBSClient.SplitRecordFile(ctx, req); err != nil {
+		return fmt.Errorf("unable to SplitRecordFile: %w", err)
+	}
+	return nil
+}
+
+// CreateRecordChapterOption is an optional argument of CreateRecordChapter.
+type CreateRecordChapterOption interface {
+	applyCreateRecordChapter(req *obs_grpc.CreateRecordChapterRequest)
+}
+
+// CreateRecordChapter sends request CreateRecordChapter.
+//
+// Adds a new chapter marker to the file currently being recorded.
+//
+// Note: As of OBS 30.2.0, the only file format supporting this feature is Hybrid MP4.
+func (c *Client) CreateRecordChapter(ctx context.Context, opts ...CreateRecordChapterOption) error {
+	req := &obs_grpc.CreateRecordChapterRequest{}
+	for _, opt := range opts {
+		opt.applyCreateRecordChapter(req)
+	}
+	if _, err := c.OBSClient.CreateRecordChapter(ctx, req); err != nil {
+		return fmt.Errorf("unable to CreateRecordChapter: %w", err)
+	}
+	return nil
+}
+
+// GetSceneItemListResult is the response of GetSceneItemList.
+type GetSceneItemListResult struct {
+	SceneItems []*obs_grpc.SceneItem
+}
+
+// GetSceneItemList sends request GetSceneItemList.
+//
+// Gets a list of all scene items in a scene.
+//
+// Scenes only
+func (c *Client) GetSceneItemList(ctx context.Context, scene Ref) (*GetSceneItemListResult, error) {
+	req := &obs_grpc.GetSceneItemListRequest{}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.GetSceneItemListRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.GetSceneItemListRequest_SceneName{SceneName: scene.Name}
+	}
+	resp, err := c.OBSClient.GetSceneItemList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSceneItemList: %w", err)
+	}
+	return &GetSceneItemListResult{SceneItems: resp.GetSceneItems()}, nil
+}
+
+// GetSceneItemListSeq is like GetSceneItemList, but iterates over field SceneItems of the response.
+func (c *Client) GetSceneItemListSeq(ctx context.Context, scene Ref) Seq[*obs_grpc.SceneItem] {
+	result, err := c.GetSceneItemList(ctx, scene)
+	if err != nil {
+		return seqOf[*obs_grpc.SceneItem](nil, err)
+	}
+	return seqOf(result.SceneItems, nil)
+}
+
+// GetGroupSceneItemListResult is the response of GetGroupSceneItemList.
+type GetGroupSceneItemListResult struct {
+	SceneItems []*obs_grpc.SceneItem
+}
+
+// GetGroupSceneItemList sends request GetGroupSceneItemList.
+//
+// Basically GetSceneItemList, but for groups.
+//
+// Using groups at all in OBS is discouraged, as they are very broken under the hood. Please use nested scenes instead.
+//
+// Groups only
+func (c *Client) GetGroupSceneItemList(ctx context.Context, scene Ref) (*GetGroupSceneItemListResult, error) {
+	req := &obs_grpc.GetGroupSceneItemListRequest{}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.GetGroupSceneItemListRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.GetGroupSceneItemListRequest_SceneName{SceneName: scene.Name}
+	}
+	resp, err := c.OBSClient.GetGroupSceneItemList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetGroupSceneItemList: %w", err)
+	}
+	return &GetGroupSceneItemListResult{SceneItems: resp.GetSceneItems()}, nil
+}
+
+// GetGroupSceneItemListSeq is like GetGroupSceneItemList, but iterates over field SceneItems of the response.
+func (c *Client) GetGroupSceneItemListSeq(ctx context.Context, scene Ref) Seq[*obs_grpc.SceneItem] {
+	result, err := c.GetGroupSceneItemList(ctx, scene)
+	if err != nil {
+		return seqOf[*obs_grpc.SceneItem](nil, err)
+	}
+	return seqOf(result.SceneItems, nil)
+}
+
+// GetSceneItemIdOption is an optional argument of GetSceneItemId.
+type GetSceneItemIdOption interface {
+	applyGetSceneItemId(req *obs_grpc.GetSceneItemIdRequest)
+}
+
+// GetSceneItemIdResult is the response of GetSceneItemId.
+type GetSceneItemIdResult struct {
+	SceneItemID int64
+}
+
+// GetSceneItemId sends request GetSceneItemId.
+//
+// Searches a scene for a source, and returns its id.
+//
+// Scenes and Groups
+func (c *Client) GetSceneItemId(ctx context.Context, scene Ref, sourceName string, opts ...GetSceneItemIdOption) (*GetSceneItemIdResult, error) {
+	req := &obs_grpc.GetSceneItemIdRequest{SourceName: sourceName}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.GetSceneItemIdRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.GetSceneItemIdRequest_SceneName{SceneName: scene.Name}
+	}
+	for _, opt := range opts {
+		opt.applyGetSceneItemId(req)
+	}
+	resp, err := c.OBSClient.GetSceneItemId(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSceneItemId: %w", err)
+	}
+	return &GetSceneItemIdResult{SceneItemID: resp.GetSceneItemID()}, nil
+}
+
+// GetSceneItemSourceResult is the response of GetSceneItemSource.
+type GetSceneItemSourceResult struct {
+	SourceName string
+	SourceUUID string
+}
+
+// GetSceneItemSource sends request GetSceneItemSource.
+//
+// Gets the source associated with a scene item.
+func (c *Client) GetSceneItemSource(ctx context.Context, scene Ref, sceneItemID int64) (*GetSceneItemSourceResult, error) {
+	req := &obs_grpc.GetSceneItemSourceRequest{SceneItemID: sceneItemID}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.GetSceneItemSourceRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.GetSceneItemSourceRequest_SceneName{SceneName: scene.Name}
+	}
+	resp, err := c.OBSClient.GetSceneItemSource(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSceneItemSource: %w", err)
+	}
+	return &GetSceneItemSourceResult{
+		SourceName: resp.GetSourceName(),
+		SourceUUID: resp.GetSourceUUID(),
+	}, nil
+}
+
+// CreateSceneItemOption is an optional argument of CreateSceneItem.
+type CreateSceneItemOption interface {
+	applyCreateSceneItem(req *obs_grpc.CreateSceneItemRequest)
+}
+
+// CreateSceneItemResult is the response of CreateSceneItem.
+type CreateSceneItemResult struct {
+	SceneItemID int64
+}
+
+// CreateSceneItem sends request CreateSceneItem.
+//
+// Creates a new scene item using a source.
+//
+// Scenes only
+func (c *Client) CreateSceneItem(ctx context.Context, scene Ref, source Ref, opts ...CreateSceneItemOption) (*CreateSceneItemResult, error) {
+	req := &obs_grpc.CreateSceneItemRequest{}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.CreateSceneItemRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.CreateSceneItemRequest_SceneName{SceneName: scene.Name}
+	}
+	if source.UUID != "" {
+		req.Source = &obs_grpc.CreateSceneItemRequest_SourceUUID{SourceUUID: source.UUID}
+	} else {
+		req.Source = &obs_grpc.CreateSceneItemRequest_SourceName{SourceName: source.Name}
+	}
+	for _, opt := range opts {
+		opt.applyCreateSceneItem(req)
+	}
+	resp, err := c.OBSClient.CreateSceneItem(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to CreateSceneItem: %w", err)
+	}
+	return &CreateSceneItemResult{SceneItemID: resp.GetSceneItemID()}, nil
+}
+
+// RemoveSceneItem sends request RemoveSceneItem.
+//
+// Removes a scene item from a scene.
+//
+// Scenes only
+func (c *Client) RemoveSceneItem(ctx context.Context, scene Ref, sceneItemID int64) error {
+	req := &obs_grpc.RemoveSceneItemRequest{SceneItemID: sceneItemID}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.RemoveSceneItemRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.RemoveSceneItemRequest_SceneName{SceneName: scene.Name}
+	}
+	if _, err := c.OBSClient.RemoveSceneItem(ctx, req); err != nil {
+		return fmt.Errorf("unable to RemoveSceneItem: %w", err)
+	}
+	return nil
+}
+
+// DuplicateSceneItemOption is an optional argument of DuplicateSceneItem.
+type DuplicateSceneItemOption interface {
+	applyDuplicateSceneItem(req *obs_grpc.DuplicateSceneItemRequest)
+}
+
+// DuplicateSceneItemResult is the response of DuplicateSceneItem.
+type DuplicateSceneItemResult struct {
+	SceneItemID int64
+}
+
+// DuplicateSceneItem sends request DuplicateSceneItem.
+//
+// Duplicates a scene item, copying all transform and crop info.
+//
+// Scenes only
+func (c *Client) DuplicateSceneItem(ctx context.Context, scene Ref, sceneItemID int64, opts ...DuplicateSceneItemOption) (*DuplicateSceneItemResult, error) {
+	req := &obs_grpc.DuplicateSceneItemRequest{SceneItemID: sceneItemID}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.DuplicateSceneItemRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.DuplicateSceneItemRequest_SceneName{SceneName: scene.Name}
+	}
+	for _, opt := range opts {
+		opt.applyDuplicateSceneItem(req)
+	}
+	resp, err := c.OBSClient.DuplicateSceneItem(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to DuplicateSceneItem: %w", err)
+	}
+	return &DuplicateSceneItemResult{SceneItemID: resp.GetSceneItemID()}, nil
+}
+
+// GetSceneItemTransformResult is the response of GetSceneItemTransform.
+type GetSceneItemTransformResult struct {
+	SceneItemTransform *obs_grpc.SceneItemTransform
+}
+
+// GetSceneItemTransform sends request GetSceneItemTransform.
+//
+// Gets the transform and crop info of a scene item.
+//
+// Scenes and Groups
+func (c *Client) GetSceneItemTransform(ctx context.Context, scene Ref, sceneItemID int64) (*GetSceneItemTransformResult, error) {
+	req := &obs_grpc.GetSceneItemTransformRequest{SceneItemID: sceneItemID}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.GetSceneItemTransformRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.GetSceneItemTransformRequest_SceneName{SceneName: scene.Name}
+	}
+	resp, err := c.OBSClient.GetSceneItemTransform(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSceneItemTransform: %w", err)
+	}
+	return &GetSceneItemTransformResult{SceneItemTransform: resp.GetSceneItemTransform()}, nil
+}
+
+// SetSceneItemTransform sends request SetSceneItemTransform.
+//
+// Sets the transform and crop info of a scene item.
+func (c *Client) SetSceneItemTransform(ctx context.Context, scene Ref, sceneItemID int64, sceneItemTransform *obs_grpc.SceneItemTransform) error {
+	req := &obs_grpc.SetSceneItemTransformRequest{
+		SceneItemID:        sceneItemID,
+		SceneItemTransform: sceneItemTransform,
+	}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.SetSceneItemTransformRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.SetSceneItemTransformRequest_SceneName{SceneName: scene.Name}
+	}
+	if _, err := c.OBSClient.SetSceneItemTransform(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetSceneItemTransform: %w", err)
+	}
+	return nil
+}
+
+// GetSceneItemEnabledResult is the response of GetSceneItemEnabled.
+type GetSceneItemEnabledResult struct {
+	SceneItemEnabled bool
+}
+
+// GetSceneItemEnabled sends request GetSceneItemEnabled.
+//
+// Gets the enable state of a scene item.
+//
+// Scenes and Groups
+func (c *Client) GetSceneItemEnabled(ctx context.Context, scene Ref, sceneItemID int64) (*GetSceneItemEnabledResult, error) {
+	req := &obs_grpc.GetSceneItemEnabledRequest{SceneItemID: sceneItemID}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.GetSceneItemEnabledRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.GetSceneItemEnabledRequest_SceneName{SceneName: scene.Name}
+	}
+	resp, err := c.OBSClient.GetSceneItemEnabled(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSceneItemEnabled: %w", err)
+	}
+	return &GetSceneItemEnabledResult{SceneItemEnabled: resp.GetSceneItemEnabled()}, nil
+}
+
+// SetSceneItemEnabled sends request SetSceneItemEnabled.
+//
+// Sets the enable state of a scene item.
+//
+// Scenes and Groups
+func (c *Client) SetSceneItemEnabled(ctx context.Context, scene Ref, sceneItemID int64, sceneItemEnabled bool) error {
+	req := &obs_grpc.SetSceneItemEnabledRequest{
+		SceneItemEnabled: sceneItemEnabled,
+		SceneItemID:      sceneItemID,
+	}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.SetSceneItemEnabledRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.SetSceneItemEnabledRequest_SceneName{SceneName: scene.Name}
+	}
+	if _, err := c.OBSClient.SetSceneItemEnabled(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetSceneItemEnabled: %w", err)
+	}
+	return nil
+}
+
+// GetSceneItemLockedResult is the response of GetSceneItemLocked.
+type GetSceneItemLockedResult struct {
+	SceneItemLocked bool
+}
+
+// GetSceneItemLocked sends request GetSceneItemLocked.
+//
+// Gets the lock state of a scene item.
+//
+// Scenes and Groups
+func (c *Client) GetSceneItemLocked(ctx context.Context, scene Ref, sceneItemID int64) (*GetSceneItemLockedResult, error) {
+	req := &obs_grpc.GetSceneItemLockedRequest{SceneItemID: sceneItemID}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.GetSceneItemLockedRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.GetSceneItemLockedRequest_SceneName{SceneName: scene.Name}
+	}
+	resp, err := c.OBSClient.GetSceneItemLocked(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSceneItemLocked: %w", err)
+	}
+	return &GetSceneItemLockedResult{SceneItemLocked: resp.GetSceneItemLocked()}, nil
+}
+
+// SetSceneItemLocked sends request SetSceneItemLocked.
+//
+// Sets the lock state of a scene item.
+//
+// Scenes and Group
+func (c *Client) SetSceneItemLocked(ctx context.Context, scene Ref, sceneItemID int64, sceneItemLocked bool) error {
+	req := &obs_grpc.SetSceneItemLockedRequest{
+		SceneItemID:     sceneItemID,
+		SceneItemLocked: sceneItemLocked,
+	}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.SetSceneItemLockedRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.SetSceneItemLockedRequest_SceneName{SceneName: scene.Name}
+	}
+	if _, err := c.OBSClient.SetSceneItemLocked(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetSceneItemLocked: %w", err)
+	}
+	return nil
+}
+
+// GetSceneItemIndexResult is the response of GetSceneItemIndex.
+type GetSceneItemIndexResult struct {
+	SceneItemIndex int64
+}
+
+// GetSceneItemIndex sends request GetSceneItemIndex.
+//
+// Gets the index position of a scene item in a scene.
+//
+// An index of 0 is at the bottom of the source list in the UI.
+//
+// Scenes and Groups
+func (c *Client) GetSceneItemIndex(ctx context.Context, scene Ref, sceneItemID int64) (*GetSceneItemIndexResult, error) {
+	req := &obs_grpc.GetSceneItemIndexRequest{SceneItemID: sceneItemID}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.GetSceneItemIndexRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.GetSceneItemIndexRequest_SceneName{SceneName: scene.Name}
+	}
+	resp, err := c.OBSClient.GetSceneItemIndex(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSceneItemIndex: %w", err)
+	}
+	return &GetSceneItemIndexResult{SceneItemIndex: resp.GetSceneItemIndex()}, nil
+}
+
+// SetSceneItemIndex sends request SetSceneItemIndex.
+//
+// Sets the index position of a scene item in a scene.
+//
+// Scenes and Groups
+func (c *Client) SetSceneItemIndex(ctx context.Context, scene Ref, sceneItemID int64, sceneItemIndex int64) error {
+	req := &obs_grpc.SetSceneItemIndexRequest{
+		SceneItemID:    sceneItemID,
+		SceneItemIndex: sceneItemIndex,
+	}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.SetSceneItemIndexRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.SetSceneItemIndexRequest_SceneName{SceneName: scene.Name}
+	}
+	if _, err := c.OBSClient.SetSceneItemIndex(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetSceneItemIndex: %w", err)
+	}
+	return nil
+}
+
+// GetSceneItemBlendModeResult is the response of GetSceneItemBlendMode.
+type GetSceneItemBlendModeResult struct {
+	SceneItemBlendMode string
+}
+
+// GetSceneItemBlendMode sends request GetSceneItemBlendMode.
+//
+// Gets the blend mode of a scene item.
+//
+// Blend modes:
+//
+// - `OBS_BLEND_NORMAL`
+// - `OBS_BLEND_ADDITIVE`
+// - `OBS_BLEND_SUBTRACT`
+// - `OBS_BLEND_SCREEN`
+// - `OBS_BLEND_MULTIPLY`
+// - `OBS_BLEND_LIGHTEN`
+// - `OBS_BLEND_DARKEN`
+//
+// Scenes and Groups
+func (c *Client) GetSceneItemBlendMode(ctx context.Context, scene Ref, sceneItemID int64) (*GetSceneItemBlendModeResult, error) {
+	req := &obs_grpc.GetSceneItemBlendModeRequest{SceneItemID: sceneItemID}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.GetSceneItemBlendModeRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.GetSceneItemBlendModeRequest_SceneName{SceneName: scene.Name}
+	}
+	resp, err := c.OBSClient.GetSceneItemBlendMode(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSceneItemBlendMode: %w", err)
+	}
+	return &GetSceneItemBlendModeResult{SceneItemBlendMode: string(resp.GetSceneItemBlendMode())}, nil
+}
+
+// SetSceneItemBlendMode sends request SetSceneItemBlendMode.
+//
+// Sets the blend mode of a scene item.
+//
+// Scenes and Groups
+func (c *Client) SetSceneItemBlendMode(ctx context.Context, scene Ref, sceneItemID int64, sceneItemBlendMode string) error {
+	req := &obs_grpc.SetSceneItemBlendModeRequest{
+		SceneItemBlendMode: []byte(sceneItemBlendMode),
+		SceneItemID:        sceneItemID,
+	}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.SetSceneItemBlendModeRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.SetSceneItemBlendModeRequest_SceneName{SceneName: scene.Name}
+	}
+	if _, err := c.OBSClient.SetSceneItemBlendMode(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetSceneItemBlendMode: %w", err)
+	}
+	return nil
+}
+
+// GetSceneListResult is the response of GetSceneList.
+type GetSceneListResult struct {
+	CurrentProgramSceneName string
+	CurrentProgramSceneUUID string
+	CurrentPreviewSceneName string
+	CurrentPreviewSceneUUID string
+	Scenes                  []*obs_grpc.Scene
+}
+
+// GetSceneList sends request GetSceneList.
+//
+// Gets an array of all scenes in OBS.
+func (c *Client) GetSceneList(ctx context.Context) (*GetSceneListResult, error) {
+	req := &obs_grpc.GetSceneListRequest{}
+	resp, err := c.OBSClient.GetSceneList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSceneList: %w", err)
+	}
+	return &GetSceneListResult{
+		CurrentPreviewSceneName: resp.GetCurrentPreviewSceneName(),
+		CurrentPreviewSceneUUID: resp.GetCurrentPreviewSceneUUID(),
+		CurrentProgramSceneName: resp.GetCurrentProgramSceneName(),
+		CurrentProgramSceneUUID: resp.GetCurrentProgramSceneUUID(),
+		Scenes:                  resp.GetScenes(),
+	}, nil
+}
+
+// GetSceneListSeq is like GetSceneList, but iterates over field Scenes of the response.
+func (c *Client) GetSceneListSeq(ctx context.Context) Seq[*obs_grpc.Scene] {
+	result, err := c.GetSceneList(ctx)
+	if err != nil {
+		return seqOf[*obs_grpc.Scene](nil, err)
+	}
+	return seqOf(result.Scenes, nil)
+}
+
+// GetGroupListResult is the response of GetGroupList.
+type GetGroupListResult struct {
+	Groups []string
+}
+
+// GetGroupList sends request GetGroupList.
+//
+// Gets an array of all groups in OBS.
+//
+// Groups in OBS are actually scenes, but renamed and modified. In obs-websocket, we treat them as scenes where we can.
+func (c *Client) GetGroupList(ctx context.Context) (*GetGroupListResult, error) {
+	req := &obs_grpc.GetGroupListRequest{}
+	resp, err := c.OBSClient.GetGroupList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetGroupList: %w", err)
+	}
+	return &GetGroupListResult{Groups: bytesToStrings(resp.GetGroups())}, nil
+}
+
+// GetGroupListSeq is like GetGroupList, but iterates over field Groups of the response.
+func (c *Client) GetGroupListSeq(ctx context.Context) Seq[string] {
+	result, err := c.GetGroupList(ctx)
+	if err != nil {
+		return seqOf[string](nil, err)
+	}
+	return seqOf(result.Groups, nil)
+}
+
+// GetCurrentProgramSceneResult is the response of GetCurrentProgramScene.
+type GetCurrentProgramSceneResult struct {
+	SceneName               string
+	SceneUUID               string
+	CurrentProgramSceneName string
+	CurrentProgramSceneUUID string
+}
+
+// GetCurrentProgramScene sends request GetCurrentProgramScene.
+//
+// Gets the current program scene.
+//
+// Note: This request is slated to have the `currentProgram`-prefixed fields removed from in an upcoming RPC version.
+func (c *Client) GetCurrentProgramScene(ctx context.Context) (*GetCurrentProgramSceneResult, error) {
+	req := &obs_grpc.GetCurrentProgramSceneRequest{}
+	resp, err := c.OBSClient.GetCurrentProgramScene(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetCurrentProgramScene: %w", err)
+	}
+	return &GetCurrentProgramSceneResult{
+		CurrentProgramSceneName: resp.GetCurrentProgramSceneName(),
+		CurrentProgramSceneUUID: resp.GetCurrentProgramSceneUUID(),
+		SceneName:               resp.GetSceneName(),
+		SceneUUID:               resp.GetSceneUUID(),
+	}, nil
+}
+
+// SetCurrentProgramScene sends request SetCurrentProgramScene.
+//
+// Sets the current program scene.
+func (c *Client) SetCurrentProgramScene(ctx context.Context, scene Ref) error {
+	req := &obs_grpc.SetCurrentProgramSceneRequest{}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.SetCurrentProgramSceneRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.SetCurrentProgramSceneRequest_SceneName{SceneName: scene.Name}
+	}
+	if _, err := c.OBSClient.SetCurrentProgramScene(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetCurrentProgramScene: %w", err)
+	}
+	return nil
+}
+
+// GetCurrentPreviewSceneResult is the response of GetCurrentPreviewScene.
+type GetCurrentPreviewSceneResult struct {
+	SceneName               string
+	SceneUUID               string
+	CurrentPreviewSceneName string
+	CurrentPreviewSceneUUID string
+}
+
+// GetCurrentPreviewScene sends request GetCurrentPreviewScene.
+//
+// Gets the current preview scene.
+//
+// Only available when studio mode is enabled.
+//
+// Note: This request is slated to have the `currentPreview`-prefixed fields removed from in an upcoming RPC version.
+func (c *Client) GetCurrentPreviewScene(ctx context.Context) (*GetCurrentPreviewSceneResult, error) {
+	req := &obs_grpc.GetCurrentPreviewSceneRequest{}
+	resp, err := c.OBSClient.GetCurrentPreviewScene(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetCurrentPreviewScene: %w", err)
+	}
+	return &GetCurrentPreviewSceneResult{
+		CurrentPreviewSceneName: resp.GetCurrentPreviewSceneName(),
+		CurrentPreviewSceneUUID: resp.GetCurrentPreviewSceneUUID(),
+		SceneName:               resp.GetSceneName(),
+		SceneUUID:               resp.GetSceneUUID(),
+	}, nil
+}
+
+// SetCurrentPreviewScene sends request SetCurrentPreviewScene.
+//
+// Sets the current preview scene.
+//
+// Only available when studio mode is enabled.
+func (c *Client) SetCurrentPreviewScene(ctx context.Context, scene Ref) error {
+	req := &obs_grpc.SetCurrentPreviewSceneRequest{}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.SetCurrentPreviewSceneRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.SetCurrentPreviewSceneRequest_SceneName{SceneName: scene.Name}
+	}
+	if _, err := c.OBSClient.SetCurrentPreviewScene(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetCurrentPreviewScene: %w", err)
+	}
+	return nil
+}
+
+// CreateSceneResult is the response of CreateScene.
+type CreateSceneResult struct {
+	SceneUUID string
+}
+
+// CreateScene sends request CreateScene.
+//
+// Creates a new scene in OBS.
+func (c *Client) CreateScene(ctx context.Context, sceneName string) (*CreateSceneResult, error) {
+	req := &obs_grpc.CreateSceneRequest{SceneName: sceneName}
+	resp, err := c.OBSClient.CreateScene(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to CreateScene: %w", err)
+	}
+	return &CreateSceneResult{SceneUUID: resp.GetSceneUUID()}, nil
+}
+
+// RemoveScene sends request RemoveScene.
+//
+// Removes a scene from OBS.
+func (c *Client) RemoveScene(ctx context.Context, scene Ref) error {
+	req := &obs_grpc.RemoveSceneRequest{}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.RemoveSceneRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.RemoveSceneRequest_SceneName{SceneName: scene.Name}
+	}
+	if _, err := c.OBSClient.RemoveScene(ctx, req); err != nil {
+		return fmt.Errorf("unable to RemoveScene: %w", err)
+	}
+	return nil
+}
+
+// SetSceneName sends request SetSceneName.
+//
+// Sets the name of a scene (rename).
+func (c *Client) SetSceneName(ctx context.Context, scene Ref, newSceneName string) error {
+	req := &obs_grpc.SetSceneNameRequest{NewSceneName: newSceneName}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.SetSceneNameRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.SetSceneNameRequest_SceneName{SceneName: scene.Name}
+	}
+	if _, err := c.OBSClient.SetSceneName(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetSceneName: %w", err)
+	}
+	return nil
+}
+
+// GetSceneSceneTransitionOverrideResult is the response of GetSceneSceneTransitionOverride.
+type GetSceneSceneTransitionOverrideResult struct {
+	TransitionName     string
+	TransitionDuration int64
+}
+
+// GetSceneSceneTransitionOverride sends request GetSceneSceneTransitionOverride.
+//
+// Gets the scene transition overridden for a scene.
+//
+// Note: A transition UUID response field is not currently able to be implemented as of 2024-1-18.
+func (c *Client) GetSceneSceneTransitionOverride(ctx context.Context, scene Ref) (*GetSceneSceneTransitionOverrideResult, error) {
+	req := &obs_grpc.GetSceneSceneTransitionOverrideRequest{}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.GetSceneSceneTransitionOverrideRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.GetSceneSceneTransitionOverrideRequest_SceneName{SceneName: scene.Name}
+	}
+	resp, err := c.OBSClient.GetSceneSceneTransitionOverride(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSceneSceneTransitionOverride: %w", err)
+	}
+	return &GetSceneSceneTransitionOverrideResult{
+		TransitionDuration: resp.GetTransitionDuration(),
+		TransitionName:     resp.GetTransitionName(),
+	}, nil
+}
+
+// SetSceneSceneTransitionOverrideOption is an optional argument of SetSceneSceneTransitionOverride.
+type SetSceneSceneTransitionOverrideOption interface {
+	applySetSceneSceneTransitionOverride(req *obs_grpc.SetSceneSceneTransitionOverrideRequest)
+}
+
+// SetSceneSceneTransitionOverride sends request SetSceneSceneTransitionOverride.
+//
+// Sets the scene transition overridden for a scene.
+func (c *Client) SetSceneSceneTransitionOverride(ctx context.Context, scene Ref, opts ...SetSceneSceneTransitionOverrideOption) error {
+	req := &obs_grpc.SetSceneSceneTransitionOverrideRequest{}
+	if scene.UUID != "" {
+		req.Scene = &obs_grpc.SetSceneSceneTransitionOverrideRequest_SceneUUID{SceneUUID: scene.UUID}
+	} else {
+		req.Scene = &obs_grpc.SetSceneSceneTransitionOverrideRequest_SceneName{SceneName: scene.Name}
+	}
+	for _, opt := range opts {
+		opt.applySetSceneSceneTransitionOverride(req)
+	}
+	if _, err := c.OBSClient.SetSceneSceneTransitionOverride(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetSceneSceneTransitionOverride: %w", err)
+	}
+	return nil
+}
+
+// GetSourceActiveResult is the response of GetSourceActive.
+type GetSourceActiveResult struct {
+	VideoActive  bool
+	VideoShowing bool
+}
+
+// GetSourceActive sends request GetSourceActive.
+//
+// Gets the active and show state of a source.
+//
+// **Compatible with inputs and scenes.**
+func (c *Client) GetSourceActive(ctx context.Context, source Ref) (*GetSourceActiveResult, error) {
+	req := &obs_grpc.GetSourceActiveRequest{}
+	if source.UUID != "" {
+		req.Source = &obs_grpc.GetSourceActiveRequest_SourceUUID{SourceUUID: source.UUID}
+	} else {
+		req.Source = &obs_grpc.GetSourceActiveRequest_SourceName{SourceName: source.Name}
+	}
+	resp, err := c.OBSClient.GetSourceActive(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSourceActive: %w", err)
+	}
+	return &GetSourceActiveResult{
+		VideoActive:  resp.GetVideoActive(),
+		VideoShowing: resp.GetVideoShowing(),
+	}, nil
+}
+
+// GetSourceScreenshotOption is an optional argument of GetSourceScreenshot.
+type GetSourceScreenshotOption interface {
+	applyGetSourceScreenshot(req *obs_grpc.GetSourceScreenshotRequest)
+}
+
+// GetSourceScreenshotResult is the response of GetSourceScreenshot.
+type GetSourceScreenshotResult struct {
+	ImageData string
+}
+
+// GetSourceScreenshot sends request GetSourceScreenshot.
+//
+// Gets a Base64-encoded screenshot of a source.
+//
+// The `imageWidth` and `imageHeight` parameters are treated as "scale to inner", meaning the smallest ratio will be used and the aspect ratio of the original resolution is kept.
+// If `imageWidth` and `imageHeight` are not specified, the compressed image will use the full resolution of the source.
+//
+// **Compatible with inputs and scenes.**
+func (c *Client) GetSourceScreenshot(ctx context.Context, source Ref, imageFormat string, opts ...GetSourceScreenshotOption) (*GetSourceScreenshotResult, error) {
+	req := &obs_grpc.GetSourceScreenshotRequest{ImageFormat: []byte(imageFormat)}
+	if source.UUID != "" {
+		req.Source = &obs_grpc.GetSourceScreenshotRequest_SourceUUID{SourceUUID: source.UUID}
+	} else {
+		req.Source = &obs_grpc.GetSourceScreenshotRequest_SourceName{SourceName: source.Name}
+	}
+	for _, opt := range opts {
+		opt.applyGetSourceScreenshot(req)
+	}
+	resp, err := c.OBSClient.GetSourceScreenshot(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSourceScreenshot: %w", err)
+	}
+	return &GetSourceScreenshotResult{ImageData: string(resp.GetImageData())}, nil
+}
+
+// SaveSourceScreenshotOption is an optional argument of SaveSourceScreenshot.
+type SaveSourceScreenshotOption interface {
+	applySaveSourceScreenshot(req *obs_grpc.SaveSourceScreenshotRequest)
+}
+
+// SaveSourceScreenshot sends request SaveSourceScreenshot.
+//
+// Saves a screenshot of a source to the filesystem.
+//
+// The `imageWidth` and `imageHeight` parameters are treated as "scale to inner", meaning the smallest ratio will be used and the aspect ratio of the original resolution is kept.
+// If `imageWidth` and `imageHeight` are not specified, the compressed image will use the full resolution of the source.
+//
+// **Compatible with inputs and scenes.**
+func (c *Client) SaveSourceScreenshot(ctx context.Context, source Ref, imageFormat string, imageFilePath string, opts ...SaveSourceScreenshotOption) error {
+	req := &obs_grpc.SaveSourceScreenshotRequest{
+		ImageFilePath: imageFilePath,
+		ImageFormat:   []byte(imageFormat),
+	}
+	if source.UUID != "" {
+		req.Source = &obs_grpc.SaveSourceScreenshotRequest_SourceUUID{SourceUUID: source.UUID}
+	} else {
+		req.Source = &obs_grpc.SaveSourceScreenshotRequest_SourceName{SourceName: source.Name}
+	}
+	for _, opt := range opts {
+		opt.applySaveSourceScreenshot(req)
+	}
+	if _, err := c.OBSClient.SaveSourceScreenshot(ctx, req); err != nil {
+		return fmt.Errorf("unable to SaveSourceScreenshot: %w", err)
+	}
+	return nil
+}
+
+// GetStreamStatusResult is the response of GetStreamStatus.
+type GetStreamStatusResult struct {
+	OutputActive        bool
+	OutputReconnecting  bool
+	OutputTimecode      string
+	OutputDuration      int64
+	OutputCongestion    int64
+	OutputBytes         int64
+	OutputSkippedFrames int64
+	OutputTotalFrames   int64
+}
+
+// GetStreamStatus sends request GetStreamStatus.
+//
+// Gets the status of the stream output.
+func (c *Client) GetStreamStatus(ctx context.Context) (*GetStreamStatusResult, error) {
+	req := &obs_grpc.GetStreamStatusRequest{}
+	resp, err := c.OBSClient.GetStreamStatus(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetStreamStatus: %w", err)
+	}
+	return &GetStreamStatusResult{
+		OutputActive:        resp.GetOutputActive(),
+		OutputBytes:         resp.GetOutputBytes(),
+		OutputCongestion:    resp.GetOutputCongestion(),
+		OutputDuration:      resp.GetOutputDuration(),
+		OutputReconnecting:  resp.GetOutputReconnecting(),
+		OutputSkippedFrames: resp.GetOutputSkippedFrames(),
+		OutputTimecode:      string(resp.GetOutputTimecode()),
+		OutputTotalFrames:   resp.GetOutputTotalFrames(),
+	}, nil
+}
+
+// ToggleStreamResult is the response of ToggleStream.
+type ToggleStreamResult struct {
+	OutputActive bool
+}
+
+// ToggleStream sends request ToggleStream.
+//
+// Toggles the status of the stream output.
+func (c *Client) ToggleStream(ctx context.Context) (*ToggleStreamResult, error) {
+	req := &obs_grpc.ToggleStreamRequest{}
+	resp, err := c.OBSClient.ToggleStream(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to ToggleStream: %w", err)
+	}
+	return &ToggleStreamResult{OutputActive: resp.GetOutputActive()}, nil
+}
+
+// StartStream sends request StartStream.
+//
+// Starts the stream output.
+func (c *Client) StartStream(ctx context.Context) error {
+	req := &obs_grpc.StartStreamRequest{}
+	if _, err := c.OBSClient.StartStream(ctx, req); err != nil {
+		return fmt.Errorf("unable to StartStream: %w", err)
+	}
+	return nil
+}
+
+// StopStream sends request StopStream.
+//
+// Stops the stream output.
+func (c *Client) StopStream(ctx context.Context) error {
+	req := &obs_grpc.StopStreamRequest{}
+	if _, err := c.OBSClient.StopStream(ctx, req); err != nil {
+		return fmt.Errorf("unable to StopStream: %w", err)
+	}
+	return nil
+}
+
+// SendStreamCaption sends request SendStreamCaption.
+//
+// Sends CEA-608 caption text over the stream output.
+func (c *Client) SendStreamCaption(ctx context.Context, captionText string) error {
+	req := &obs_grpc.SendStreamCaptionRequest{CaptionText: []byte(captionText)}
+	if _, err := c.OBSClient.SendStreamCaption(ctx, req); err != nil {
+		return fmt.Errorf("unable to SendStreamCaption: %w", err)
+	}
+	return nil
+}
+
+// GetTransitionKindListResult is the response of GetTransitionKindList.
+type GetTransitionKindListResult struct {
+	TransitionKinds []string
+}
+
+// GetTransitionKindList sends request GetTransitionKindList.
+//
+// Gets an array of all available transition kinds.
+//
+// Similar to `GetInputKindList`
+func (c *Client) GetTransitionKindList(ctx context.Context) (*GetTransitionKindListResult, error) {
+	req := &obs_grpc.GetTransitionKindListRequest{}
+	resp, err := c.OBSClient.GetTransitionKindList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetTransitionKindList: %w", err)
+	}
+	return &GetTransitionKindListResult{TransitionKinds: resp.GetTransitionKinds()}, nil
+}
+
+// GetTransitionKindListSeq is like GetTransitionKindList, but iterates over field TransitionKinds of the response.
+func (c *Client) GetTransitionKindListSeq(ctx context.Context) Seq[string] {
+	result, err := c.GetTransitionKindList(ctx)
+	if err != nil {
+		return seqOf[string](nil, err)
+	}
+	return seqOf(result.TransitionKinds, nil)
+}
+
+// GetSceneTransitionListResult is the response of GetSceneTransitionList.
+type GetSceneTransitionListResult struct {
+	CurrentSceneTransitionName string
+	CurrentSceneTransitionUUID string
+	CurrentSceneTransitionKind string
+	Transitions                []*obs_grpc.Transition
+}
+
+// GetSceneTransitionList sends request GetSceneTransitionList.
+//
+// Gets an array of all scene transitions in OBS.
+func (c *Client) GetSceneTransitionList(ctx context.Context) (*GetSceneTransitionListResult, error) {
+	req := &obs_grpc.GetSceneTransitionListRequest{}
+	resp, err := c.OBSClient.GetSceneTransitionList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetSceneTransitionList: %w", err)
+	}
+	return &GetSceneTransitionListResult{
+		CurrentSceneTransitionKind: resp.GetCurrentSceneTransitionKind(),
+		CurrentSceneTransitionName: resp.GetCurrentSceneTransitionName(),
+		CurrentSceneTransitionUUID: resp.GetCurrentSceneTransitionUUID(),
+		Transitions:                resp.GetTransitions(),
+	}, nil
+}
+
+// GetSceneTransitionListSeq is like GetSceneTransitionList, but iterates over field Transitions of the response.
+func (c *Client) GetSceneTransitionListSeq(ctx context.Context) Seq[*obs_grpc.Transition] {
+	result, err := c.GetSceneTransitionList(ctx)
+	if err != nil {
+		return seqOf[*obs_grpc.Transition](nil, err)
+	}
+	return seqOf(result.Transitions, nil)
+}
+
+// GetCurrentSceneTransitionResult is the response of GetCurrentSceneTransition.
+type GetCurrentSceneTransitionResult struct {
+	TransitionName         string
+	TransitionUUID         string
+	TransitionKind         string
+	TransitionFixed        bool
+	TransitionDuration     int64
+	TransitionConfigurable bool
+	TransitionSettings     *obs_grpc.AbstractObject
+}
+
+// GetCurrentSceneTransition sends request GetCurrentSceneTransition.
+//
+// Gets information about the current scene transition.
+func (c *Client) GetCurrentSceneTransition(ctx context.Context) (*GetCurrentSceneTransitionResult, error) {
+	req := &obs_grpc.GetCurrentSceneTransitionRequest{}
+	resp, err := c.OBSClient.GetCurrentSceneTransition(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetCurrentSceneTransition: %w", err)
+	}
+	return &GetCurrentSceneTransitionResult{
+		TransitionConfigurable: resp.GetTransitionConfigurable(),
+		TransitionDuration:     resp.GetTransitionDuration(),
+		TransitionFixed:        resp.GetTransitionFixed(),
+		TransitionKind:         resp.GetTransitionKind(),
+		TransitionName:         resp.GetTransitionName(),
+		TransitionSettings:     resp.GetTransitionSettings(),
+		TransitionUUID:         resp.GetTransitionUUID(),
+	}, nil
+}
+
+// SetCurrentSceneTransition sends request SetCurrentSceneTransition.
+//
+// Sets the current scene transition.
+//
+// Small note: While the namespace of scene transitions is generally unique, that uniqueness is not a guarantee as it is with other resources like inputs.
+func (c *Client) SetCurrentSceneTransition(ctx context.Context, transitionName string) error {
+	req := &obs_grpc.SetCurrentSceneTransitionRequest{TransitionName: transitionName}
+	if _, err := c.OBSClient.SetCurrentSceneTransition(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetCurrentSceneTransition: %w", err)
+	}
+	return nil
+}
+
+// SetCurrentSceneTransitionDuration sends request SetCurrentSceneTransitionDuration.
+//
+// Sets the duration of the current scene transition, if it is not fixed.
+func (c *Client) SetCurrentSceneTransitionDuration(ctx context.Context, transitionDuration int64) error {
+	req := &obs_grpc.SetCurrentSceneTransitionDurationRequest{TransitionDuration: transitionDuration}
+	if _, err := c.OBSClient.SetCurrentSceneTransitionDuration(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetCurrentSceneTransitionDuration: %w", err)
+	}
+	return nil
+}
+
+// SetCurrentSceneTransitionSettingsOption is an optional argument of SetCurrentSceneTransitionSettings.
+type SetCurrentSceneTransitionSettingsOption interface {
+	applySetCurrentSceneTransitionSettings(req *obs_grpc.SetCurrentSceneTransitionSettingsRequest)
+}
+
+// SetCurrentSceneTransitionSettings sends request SetCurrentSceneTransitionSettings.
+//
+// Sets the settings of the current scene transition.
+func (c *Client) SetCurrentSceneTransitionSettings(ctx context.Context, transitionSettings *obs_grpc.AbstractObject, opts ...SetCurrentSceneTransitionSettingsOption) error {
+	req := &obs_grpc.SetCurrentSceneTransitionSettingsRequest{TransitionSettings: transitionSettings}
+	for _, opt := range opts {
+		opt.applySetCurrentSceneTransitionSettings(req)
+	}
+	if _, err := c.OBSClient.SetCurrentSceneTransitionSettings(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetCurrentSceneTransitionSettings: %w", err)
+	}
+	return nil
+}
+
+// GetCurrentSceneTransitionCursorResult is the response of GetCurrentSceneTransitionCursor.
+type GetCurrentSceneTransitionCursorResult struct {
+	TransitionCursor float64
+}
+
+// GetCurrentSceneTransitionCursor sends request GetCurrentSceneTransitionCursor.
+//
+// Gets the cursor position of the current scene transition.
+//
+// Note: `transitionCursor` will return 1.0 when the transition is inactive.
+func (c *Client) GetCurrentSceneTransitionCursor(ctx context.Context) (*GetCurrentSceneTransitionCursorResult, error) {
+	req := &obs_grpc.GetCurrentSceneTransitionCursorRequest{}
+	resp, err := c.OBSClient.GetCurrentSceneTransitionCursor(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetCurrentSceneTransitionCursor: %w", err)
+	}
+	return &GetCurrentSceneTransitionCursorResult{TransitionCursor: resp.GetTransitionCursor()}, nil
+}
+
+// TriggerStudioModeTransition sends request TriggerStudioModeTransition.
+//
+// Triggers the current scene transition. Same functionality as the `Transition` button in studio mode.
+func (c *Client) TriggerStudioModeTransition(ctx context.Context) error {
+	req := &obs_grpc.TriggerStudioModeTransitionRequest{}
+	if _, err := c.OBSClient.TriggerStudioModeTransition(ctx, req); err != nil {
+		return fmt.Errorf("unable to TriggerStudioModeTransition: %w", err)
+	}
+	return nil
+}
+
+// SetTBarPositionOption is an optional argument of SetTBarPosition.
+type SetTBarPositionOption interface {
+	applySetTBarPosition(req *obs_grpc.SetTBarPositionRequest)
+}
+
+// SetTBarPosition sends request SetTBarPosition.
+//
+// Sets the position of the TBar.
+//
+// **Very important note**: This will be deprecated and replaced in a future version of obs-websocket.
+func (c *Client) SetTBarPosition(ctx context.Context, position int64, opts ...SetTBarPositionOption) error {
+	req := &obs_grpc.SetTBarPositionRequest{Position: position}
+	for _, opt := range opts {
+		opt.applySetTBarPosition(req)
+	}
+	if _, err := c.OBSClient.SetTBarPosition(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetTBarPosition: %w", err)
+	}
+	return nil
+}
+
+// GetStudioModeEnabledResult is the response of GetStudioModeEnabled.
+type GetStudioModeEnabledResult struct {
+	StudioModeEnabled bool
+}
+
+// GetStudioModeEnabled sends request GetStudioModeEnabled.
+//
+// Gets whether studio is enabled.
+func (c *Client) GetStudioModeEnabled(ctx context.Context) (*GetStudioModeEnabledResult, error) {
+	req := &obs_grpc.GetStudioModeEnabledRequest{}
+	resp, err := c.OBSClient.GetStudioModeEnabled(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetStudioModeEnabled: %w", err)
+	}
+	return &GetStudioModeEnabledResult{StudioModeEnabled: resp.GetStudioModeEnabled()}, nil
+}
+
+// SetStudioModeEnabled sends request SetStudioModeEnabled.
+//
+// Enables or disables studio mode
+func (c *Client) SetStudioModeEnabled(ctx context.Context, studioModeEnabled bool) error {
+	req := &obs_grpc.SetStudioModeEnabledRequest{StudioModeEnabled: studioModeEnabled}
+	if _, err := c.OBSClient.SetStudioModeEnabled(ctx, req); err != nil {
+		return fmt.Errorf("unable to SetStudioModeEnabled: %w", err)
+	}
+	return nil
+}
+
+// OpenInputPropertiesDialog sends request OpenInputPropertiesDialog.
+//
+// Opens the properties dialog of an input.
+func (c *Client) OpenInputPropertiesDialog(ctx context.Context, input Ref) error {
+	req := &obs_grpc.OpenInputPropertiesDialogRequest{}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.OpenInputPropertiesDialogRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.OpenInputPropertiesDialogRequest_InputName{InputName: input.Name}
+	}
+	if _, err := c.OBSClient.OpenInputPropertiesDialog(ctx, req); err != nil {
+		return fmt.Errorf("unable to OpenInputPropertiesDialog: %w", err)
+	}
+	return nil
+}
+
+// OpenInputFiltersDialog sends request OpenInputFiltersDialog.
+//
+// Opens the filters dialog of an input.
+func (c *Client) OpenInputFiltersDialog(ctx context.Context, input Ref) error {
+	req := &obs_grpc.OpenInputFiltersDialogRequest{}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.OpenInputFiltersDialogRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.OpenInputFiltersDialogRequest_InputName{InputName: input.Name}
+	}
+	if _, err := c.OBSClient.OpenInputFiltersDialog(ctx, req); err != nil {
+		return fmt.Errorf("unable to OpenInputFiltersDialog: %w", err)
+	}
+	return nil
+}
+
+// OpenInputInteractDialog sends request OpenInputInteractDialog.
+//
+// Opens the interact dialog of an input.
+func (c *Client) OpenInputInteractDialog(ctx context.Context, input Ref) error {
+	req := &obs_grpc.OpenInputInteractDialogRequest{}
+	if input.UUID != "" {
+		req.Input = &obs_grpc.OpenInputInteractDialogRequest_InputUUID{InputUUID: input.UUID}
+	} else {
+		req.Input = &obs_grpc.OpenInputInteractDialogRequest_InputName{InputName: input.Name}
+	}
+	if _, err := c.OBSClient.OpenInputInteractDialog(ctx, req); err != nil {
+		return fmt.Errorf("unable to OpenInputInteractDialog: %w", err)
+	}
+	return nil
+}
+
+// GetMonitorListResult is the response of GetMonitorList.
+type GetMonitorListResult struct {
+	Monitors []*obs_grpc.Monitor
+}
+
+// GetMonitorList sends request GetMonitorList.
+//
+// Gets a list of connected monitors and information about them.
+func (c *Client) GetMonitorList(ctx context.Context) (*GetMonitorListResult, error) {
+	req := &obs_grpc.GetMonitorListRequest{}
+	resp, err := c.OBSClient.GetMonitorList(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to GetMonitorList: %w", err)
+	}
+	return &GetMonitorListResult{Monitors: resp.GetMonitors()}, nil
+}
+
+// GetMonitorListSeq is like GetMonitorList, but iterates over field Monitors of the response.
+func (c *Client) GetMonitorListSeq(ctx context.Context) Seq[*obs_grpc.Monitor] {
+	result, err := c.GetMonitorList(ctx)
+	if err != nil {
+		return seqOf[*obs_grpc.Monitor](nil, err)
+	}
+	return seqOf(result.Monitors, nil)
+}
+
+// OpenVideoMixProjectorOption is an optional argument of OpenVideoMixProjector.
+type OpenVideoMixProjectorOption interface {
+	applyOpenVideoMixProjector(req *obs_grpc.OpenVideoMixProjectorRequest)
+}
+
+// OpenVideoMixProjector sends request OpenVideoMixProjector.
+//
+// Opens a projector for a specific output video mix.
+//
+// Mix types:
+//
+// - `OBS_WEBSOCKET_VIDEO_MIX_TYPE_PREVIEW`
+// - `OBS_WEBSOCKET_VIDEO_MIX_TYPE_PROGRAM`
+// - `OBS_WEBSOCKET_VIDEO_MIX_TYPE_MULTIVIEW`
+//
+// Note: This request serves to provide feature parity with 4.x. It is very likely to be changed/deprecated in a future release.
+func (c *Client) OpenVideoMixProjector(ctx context.Context, videoMixType string, opts ...OpenVideoMixProjectorOption) error {
+	req := &obs_grpc.OpenVideoMixProjectorRequest{VideoMixType: []byte(videoMixType)}
+	for _, opt := range opts {
+		opt.applyOpenVideoMixProjector(req)
+	}
+	if _, err := c.OBSClient.OpenVideoMixProjector(ctx, req); err != nil {
+		return fmt.Errorf("unable to OpenVideoMixProjector: %w", err)
+	}
+	return nil
+}
+
+// OpenSourceProjectorOption is an optional argument of OpenSourceProjector.
+type OpenSourceProjectorOption interface {
+	applyOpenSourceProjector(req *obs_grpc.OpenSourceProjectorRequest)
+}
+
+// OpenSourceProjector sends request OpenSourceProjector.
+//
+// Opens a projector for a source.
+//
+// Note: This request serves to provide feature parity with 4.x. It is very likely to be changed/deprecated in a future release.
+func (c *Client) OpenSourceProjector(ctx context.Context, source Ref, opts ...OpenSourceProjectorOption) error {
+	req := &obs_grpc.OpenSourceProjectorRequest{}
+	if source.UUID != "" {
+		req.Source = &obs_grpc.OpenSourceProjectorRequest_SourceUUID{SourceUUID: source.UUID}
+	} else {
+		req.Source = &obs_grpc.OpenSourceProjectorRequest_SourceName{SourceName: source.Name}
+	}
+	for _, opt := range opts {
+		opt.applyOpenSourceProjector(req)
+	}
+	if _, err := c.OBSClient.OpenSourceProjector(ctx, req); err != nil {
+		return fmt.Errorf("unable to OpenSourceProjector: %w", err)
+	}
+	return nil
+}
+
+// OptionBaseHeight sets optional field baseHeight of the requests.
+type OptionBaseHeight int64
+
+func (opt OptionBaseHeight) applySetVideoSettings(req *obs_grpc.SetVideoSettingsRequest) {
+	req.BaseHeight = ptr(int64(opt))
+}
+
+// OptionBaseWidth sets optional field baseWidth of the requests.
+type OptionBaseWidth int64
+
+func (opt OptionBaseWidth) applySetVideoSettings(req *obs_grpc.SetVideoSettingsRequest) {
+	req.BaseWidth = ptr(int64(opt))
+}
+
+// OptionChapterName sets optional field chapterName of the requests.
+type OptionChapterName string
+
+func (opt OptionChapterName) applyCreateRecordChapter(req *obs_grpc.CreateRecordChapterRequest) {
+	req.ChapterName = ptr(string(opt))
+}
+
+// OptionContextName sets optional field contextName of the requests.
+type OptionContextName string
+
+func (opt OptionContextName) applyTriggerHotkeyByName(req *obs_grpc.TriggerHotkeyByNameRequest) {
+	req.ContextName = ptr(string(opt))
+}
+
+// OptionDestinationScene sets optional field destinationScene of the requests.
+type OptionDestinationScene Ref
+
+func (opt OptionDestinationScene) applyDuplicateSceneItem(req *obs_grpc.DuplicateSceneItemRequest) {
+	if Ref(opt).UUID != "" {
+		req.DestinationScene = &obs_grpc.DuplicateSceneItemRequest_DestinationSceneUUID{DestinationSceneUUID: Ref(opt).UUID}
+	} else {
+		req.DestinationScene = &obs_grpc.DuplicateSceneItemRequest_DestinationSceneName{DestinationSceneName: Ref(opt).Name}
+	}
+}
+
+// OptionFilterSettings sets optional field filterSettings of the requests.
+type OptionFilterSettings struct {
+	Value *obs_grpc.AbstractObject
+}
+
+func (opt OptionFilterSettings) applyCreateSourceFilter(req *obs_grpc.CreateSourceFilterRequest) {
+	req.FilterSettings = opt.Value
+}
+
+// OptionFpsDenominator sets optional field fpsDenominator of the requests.
+type OptionFpsDenominator int64
+
+func (opt OptionFpsDenominator) applySetVideoSettings(req *obs_grpc.SetVideoSettingsRequest) {
+	req.FpsDenominator = ptr(int64(opt))
+}
+
+// OptionFpsNumerator sets optional field fpsNumerator of the requests.
+type OptionFpsNumerator int64
+
+func (opt OptionFpsNumerator) applySetVideoSettings(req *obs_grpc.SetVideoSettingsRequest) {
+	req.FpsNumerator = ptr(int64(opt))
+}
+
+// OptionImageCompressionQuality sets optional field imageCompressionQuality of the requests.
+type OptionImageCompressionQuality int64
+
+func (opt OptionImageCompressionQuality) applyGetSourceScreenshot(req *obs_grpc.GetSourceScreenshotRequest) {
+	req.ImageCompressionQuality = ptr(int64(opt))
+}
+
+func (opt OptionImageCompressionQuality) applySaveSourceScreenshot(req *obs_grpc.SaveSourceScreenshotRequest) {
+	req.ImageCompressionQuality = ptr(int64(opt))
+}
+
+// OptionImageHeight sets optional field imageHeight of the requests.
+type OptionImageHeight int64
+
+func (opt OptionImageHeight) applyGetSourceScreenshot(req *obs_grpc.GetSourceScreenshotRequest) {
+	req.ImageHeight = ptr(int64(opt))
+}
+
+func (opt OptionImageHeight) applySaveSourceScreenshot(req *obs_grpc.SaveSourceScreenshotRequest) {
+	req.ImageHeight = ptr(int64(opt))
+}
+
+// OptionImageWidth sets optional field imageWidth of the requests.
+type OptionImageWidth int64
+
+func (opt OptionImageWidth) applyGetSourceScreenshot(req *obs_grpc.GetSourceScreenshotRequest) {
+	req.ImageWidth = ptr(int64(opt))
+}
+
+func (opt OptionImageWidth) applySaveSourceScreenshot(req *obs_grpc.SaveSourceScreenshotRequest) {
+	req.ImageWidth = ptr(int64(opt))
+}
+
+// OptionInputKind sets optional field inputKind of the requests.
+type OptionInputKind string
+
+func (opt OptionInputKind) applyGetInputList(req *obs_grpc.GetInputListRequest) {
+	req.InputKind = ptr(string(opt))
+}
+
+// OptionInputSettings sets optional field inputSettings of the requests.
+type OptionInputSettings struct {
+	Value *obs_grpc.AbstractObject
+}
+
+func (opt OptionInputSettings) applyCreateInput(req *obs_grpc.CreateInputRequest) {
+	req.InputSettings = opt.Value
+}
+
+// OptionInputVolumeDb sets optional field inputVolumeDb of the requests.
+type OptionInputVolumeDb float64
+
+func (opt OptionInputVolumeDb) applySetInputVolume(req *obs_grpc.SetInputVolumeRequest) {
+	req.InputVolumeDb = ptr(float64(opt))
+}
+
+// OptionInputVolumeMul sets optional field inputVolumeMul of the requests.
+type OptionInputVolumeMul float64
+
+func (opt OptionInputVolumeMul) applySetInputVolume(req *obs_grpc.SetInputVolumeRequest) {
+	req.InputVolumeMul = ptr(float64(opt))
+}
+
+// OptionKeyID sets optional field keyId of the requests.
+type OptionKeyID string
+
+func (opt OptionKeyID) applyTriggerHotkeyByKeySequence(req *obs_grpc.TriggerHotkeyByKeySequenceRequest) {
+	req.KeyID = ptr(string(opt))
+}
+
+// OptionKeyModifiers sets optional field keyModifiers of the requests.
+type OptionKeyModifiers struct {
+	Value *obs_grpc.KeyModifiers
+}
+
+func (opt OptionKeyModifiers) applyTriggerHotkeyByKeySequence(req *obs_grpc.TriggerHotkeyByKeySequenceRequest) {
+	req.KeyModifiers = opt.Value
+}
+
+// OptionMonitorIndex sets optional field monitorIndex of the requests.
+type OptionMonitorIndex int64
+
+func (opt OptionMonitorIndex) applyOpenVideoMixProjector(req *obs_grpc.OpenVideoMixProjectorRequest) {
+	req.MonitorIndex = ptr(int64(opt))
+}
+
+func (opt OptionMonitorIndex) applyOpenSourceProjector(req *obs_grpc.OpenSourceProjectorRequest) {
+	req.MonitorIndex = ptr(int64(opt))
+}
+
+// OptionOutputHeight sets optional field outputHeight of the requests.
+type OptionOutputHeight int64
+
+func (opt OptionOutputHeight) applySetVideoSettings(req *obs_grpc.SetVideoSettingsRequest) {
+	req.OutputHeight = ptr(int64(opt))
+}
+
+// OptionOutputWidth sets optional field outputWidth of the requests.
+type OptionOutputWidth int64
+
+func (opt OptionOutputWidth) applySetVideoSettings(req *obs_grpc.SetVideoSettingsRequest) {
+	req.OutputWidth = ptr(int64(opt))
+}
+
+// OptionOverlay sets optional field overlay of the requests.
+type OptionOverlay bool
+
+func (opt OptionOverlay) applySetSourceFilterSettings(req *obs_grpc.SetSourceFilterSettingsRequest) {
+	req.Overlay = ptr(bool(opt))
+}
+
+func (opt OptionOverlay) applySetInputSettings(req *obs_grpc.SetInputSettingsRequest) {
+	req.Overlay = ptr(bool(opt))
+}
+
+func (opt OptionOverlay) applySetCurrentSceneTransitionSettings(req *obs_grpc.SetCurrentSceneTransitionSettingsRequest) {
+	req.Overlay = ptr(bool(opt))
+}
+
+// OptionProjectorGeometry sets optional field projectorGeometry of the requests.
+type OptionProjectorGeometry string
+
+func (opt OptionProjectorGeometry) applyOpenVideoMixProjector(req *obs_grpc.OpenVideoMixProjectorRequest) {
+	req.ProjectorGeometry = []byte(string(opt))
+}
+
+func (opt OptionProjectorGeometry) applyOpenSourceProjector(req *obs_grpc.OpenSourceProjectorRequest) {
+	req.ProjectorGeometry = []byte(string(opt))
+}
+
+// OptionRelease sets optional field release of the requests.
+type OptionRelease bool
+
+func (opt OptionRelease) applySetTBarPosition(req *obs_grpc.SetTBarPositionRequest) {
+	req.Release = ptr(bool(opt))
+}
+
+// OptionRequestData sets optional field requestData of the requests.
+type OptionRequestData struct {
+	Value *obs_grpc.AbstractObject
+}
+
+func (opt OptionRequestData) applyCallVendorRequest(req *obs_grpc.CallVendorRequestRequest) {
+	req.RequestData = opt.Value
+}
+
+// OptionSceneItemEnabled sets optional field sceneItemEnabled of the requests.
+type OptionSceneItemEnabled bool
+
+func (opt OptionSceneItemEnabled) applyCreateInput(req *obs_grpc.CreateInputRequest) {
+	req.SceneItemEnabled = ptr(bool(opt))
+}
+
+func (opt OptionSceneItemEnabled) applyCreateSceneItem(req *obs_grpc.CreateSceneItemRequest) {
+	req.SceneItemEnabled = ptr(bool(opt))
+}
+
+// OptionSearchOffset sets optional field searchOffset of the requests.
+type OptionSearchOffset int64
+
+func (opt OptionSearchOffset) applyGetSceneItemId(req *obs_grpc.GetSceneItemIdRequest) {
+	req.SearchOffset = ptr(int64(opt))
+}
+
+// OptionSleepFrames sets optional field sleepFrames of the requests.
+type OptionSleepFrames int64
+
+func (opt OptionSleepFrames) applySleep(req *obs_grpc.SleepRequest) {
+	req.SleepFrames = ptr(int64(opt))
+}
+
+// OptionSleepMillis sets optional field sleepMillis of the requests.
+type OptionSleepMillis int64
+
+func (opt OptionSleepMillis) applySleep(req *obs_grpc.SleepRequest) {
+	req.SleepMillis = ptr(int64(opt))
+}
+
+// OptionTransitionDuration sets optional field transitionDuration of the requests.
+type OptionTransitionDuration int64
+
+func (opt OptionTransitionDuration) applySetSceneSceneTransitionOverride(req *obs_grpc.SetSceneSceneTransitionOverrideRequest) {
+	req.TransitionDuration = ptr(int64(opt))
+}
+
+// OptionTransitionName sets optional field transitionName of the requests.
+type OptionTransitionName string
+
+func (opt OptionTransitionName) applySetSceneSceneTransitionOverride(req *obs_grpc.SetSceneSceneTransitionOverrideRequest) {
+	req.TransitionName = ptr(string(opt))
+}
+
+// OptionUnversioned sets optional field unversioned of the requests.
+type OptionUnversioned bool
+
+func (opt OptionUnversioned) applyGetInputKindList(req *obs_grpc.GetInputKindListRequest) {
+	req.Unversioned = ptr(bool(opt))
+}
@@ -0,0 +1,201 @@
+package obsclient_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andreykaipov/goobs"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsclient"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsfake"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsgrpcproxy"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// newTestProxy returns a Proxy connected to a fake OBS.
+func newTestProxy(t *testing.T, ctx context.Context) *obsgrpcproxy.Proxy {
+	srv := httptest.NewServer(obsfake.New())
+	t.Cleanup(srv.Close)
+	addr := strings.TrimPrefix(srv.URL, "http://")
+	return obsgrpcproxy.New(
+		ctx,
+		func(ctx context.Context) (*goobs.Client, context.CancelFunc, error) {
+			client, err := goobs.New(addr)
+			if err != nil {
+				return nil, nil, err
+			}
+			return client, func() { client.Disconnect() }, nil
+		},
+	)
+}
+
+func TestClientInProcess(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+	client := obsclient.New((*obsgrpcproxy.ProxyAsClient)(newTestProxy(t, ctx)))
+
+	_, err := client.CreateScene(ctx, "Live")
+	require.NoError(t, err)
+	require.NoError(t, client.SwitchScene(ctx, "Live"))
+	current, err := client.GetCurrentProgramScene(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "Live", current.SceneName)
+
+	scenes, err := client.GetSceneListSeq(ctx).Collect()
+	require.NoError(t, err)
+	var sceneNames []string
+	for _, scene := range scenes {
+		sceneNames = append(sceneNames, scene.GetSceneName())
+	}
+	require.Contains(t, sceneNames, "Live")
+
+	input, err := client.CreateInput(ctx, obsclient.ByName("Live"), "Mic", "pulse_input_capture")
+	require.NoError(t, err)
+	require.NoError(t, client.SetMute(ctx, "Mic", true))
+	mute, err := client.GetInputMute(ctx, obsclient.ByName("Mic"))
+	require.NoError(t, err)
+	require.True(t, mute.InputMuted)
+
+	require.NoError(t, client.SetSourceVisible(ctx, obsclient.ByName("Live"), "Mic", false))
+	enabled, err := client.GetSceneItemEnabled(ctx, obsclient.ByName("Live"), input.SceneItemID)
+	require.NoError(t, err)
+	require.False(t, enabled.SceneItemEnabled)
+
+	// the status of the error is kept
+	_, err = client.GetInputMute(ctx, obsclient.ByName("Camera"))
+	require.Error(t, err)
+	code, ok := obsgrpcproxy.RequestStatusFromError(err)
+	require.True(t, ok)
+	require.Equal(t, obs_grpc.RequestStatus_ResourceNotFound, code)
+}
+
+func TestClientRemote(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	obs_grpc.RegisterOBSServer(grpcServer, newTestProxy(t, ctx))
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	client := obsclient.NewFromConn(conn)
+
+	version, err := client.GetVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "5.5.0", version.ObsWebSocketVersion)
+	require.Contains(t, version.AvailableRequests, "SetInputVolume")
+
+	_, err = client.CreateInput(ctx, obsclient.ByName("Scene"), "Mic", "pulse_input_capture")
+	require.NoError(t, err)
+	require.NoError(t, client.SetInputVolume(ctx, obsclient.ByName("Mic"), obsclient.OptionInputVolumeDb(-10)))
+	volume, err := client.GetInputVolume(ctx, obsclient.ByName("Mic"))
+	require.NoError(t, err)
+	// the volume is converted to the multiplier and back in the fake OBS
+	require.InDelta(t, -10, volume.InputVolumeDb, 0.001)
+
+	err = client.SetInputVolume(ctx, obsclient.ByName("Mic"), obsclient.OptionInputVolumeMul(-1))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// fakeOBSClient records the requests, and responds with the given responses.
+type fakeOBSClient struct {
+	obs_grpc.OBSClient
+	Requests []any
+
+	SceneList *obs_grpc.GetSceneListResponse
+	Err       error
+}
+
+func (c *fakeOBSClient) GetSceneList(ctx context.Context, req *obs_grpc.GetSceneListRequest, opts ...grpc.CallOption) (*obs_grpc.GetSceneListResponse, error) {
+	c.Requests = append(c.Requests, req)
+	return c.SceneList, c.Err
+}
+
+func (c *fakeOBSClient) TriggerMediaInputAction(ctx context.Context, req *obs_grpc.TriggerMediaInputActionRequest, opts ...grpc.CallOption) (*obs_grpc.TriggerMediaInputActionResponse, error) {
+	c.Requests = append(c.Requests, req)
+	return &obs_grpc.TriggerMediaInputActionResponse{}, nil
+}
+
+func (c *fakeOBSClient) GetMediaInputStatus(ctx context.Context, req *obs_grpc.GetMediaInputStatusRequest, opts ...grpc.CallOption) (*obs_grpc.GetMediaInputStatusResponse, error) {
+	c.Requests = append(c.Requests, req)
+	return &obs_grpc.GetMediaInputStatusResponse{MediaState: []byte("OBS_MEDIA_STATE_PLAYING")}, nil
+}
+
+func (c *fakeOBSClient) DuplicateSceneItem(ctx context.Context, req *obs_grpc.DuplicateSceneItemRequest, opts ...grpc.CallOption) (*obs_grpc.DuplicateSceneItemResponse, error) {
+	c.Requests = append(c.Requests, req)
+	return &obs_grpc.DuplicateSceneItemResponse{SceneItemID: 2}, nil
+}
+
+func TestClientConversions(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeOBSClient{}
+	client := obsclient.New(fake)
+
+	require.NoError(t, client.TriggerMediaInputAction(ctx, obsclient.ByUUID("b5f3"), obs_grpc.ObsMediaInputAction_OBS_WEBSOCKET_MEDIA_INPUT_ACTION_PAUSE))
+	mediaStatus, err := client.GetMediaInputStatus(ctx, obsclient.ByName("Video"))
+	require.NoError(t, err)
+	require.Equal(t, obsclient.MediaStatePlaying, mediaStatus.MediaState)
+
+	item, err := client.DuplicateSceneItem(ctx, obsclient.ByName("Live"), 1, obsclient.OptionDestinationScene(obsclient.ByName("BRB")))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), item.SceneItemID)
+
+	require.Equal(t, []any{
+		&obs_grpc.TriggerMediaInputActionRequest{
+			Input:       &obs_grpc.TriggerMediaInputActionRequest_InputUUID{InputUUID: "b5f3"},
+			MediaAction: "OBS_WEBSOCKET_MEDIA_INPUT_ACTION_PAUSE",
+		},
+		&obs_grpc.GetMediaInputStatusRequest{
+			Input: &obs_grpc.GetMediaInputStatusRequest_InputName{InputName: "Video"},
+		},
+		&obs_grpc.DuplicateSceneItemRequest{
+			Scene:            &obs_grpc.DuplicateSceneItemRequest_SceneName{SceneName: "Live"},
+			SceneItemID:      1,
+			DestinationScene: &obs_grpc.DuplicateSceneItemRequest_DestinationSceneName{DestinationSceneName: "BRB"},
+		},
+	}, fake.Requests)
+}
+
+func TestClientSeq(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeOBSClient{SceneList: &obs_grpc.GetSceneListResponse{Scenes: []*obs_grpc.Scene{
+		{SceneName: ptr("Intro")},
+		{SceneName: ptr("Live")},
+		{SceneName: ptr("BRB")},
+	}}}
+	client := obsclient.New(fake)
+
+	scenes, err := client.GetSceneListSeq(ctx).Collect()
+	require.NoError(t, err)
+	require.Len(t, scenes, 3)
+
+	// stops early
+	var names []string
+	client.GetSceneListSeq(ctx)(func(scene *obs_grpc.Scene, err error) bool {
+		names = append(names, scene.GetSceneName())
+		return len(names) < 2
+	})
+	require.Equal(t, []string{"Intro", "Live"}, names)
+
+	fake.Err = fmt.Errorf("no OBS in tests")
+	scenes, err = client.GetSceneListSeq(ctx).Collect()
+	require.ErrorContains(t, err, "no OBS in tests")
+	require.Nil(t, scenes)
+}
+
+func ptr[T any](in T) *T {
+	return &in
+}
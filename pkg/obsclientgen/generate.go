@@ -0,0 +1,537 @@
+package obsclientgen
+
+import (
+	"context"
+	"fmt"
+	"go/token"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsdoc"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsprotobufgen"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const pkgOBSGRPC = "github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+
+// enumType is the Go type a string field with a fixed set of values is
+// represented with in package obsclient.
+type enumType struct {
+	Name string
+
+	// Proto is set if the type is a protobuf enum of package obs_grpc (its
+	// value names are the values of obs-websocket), otherwise it is
+	// a string type of package obsclient.
+	Proto bool
+}
+
+// enumFields are the fields of obs-websocket which are strings in protobuf,
+// but are represented with typed enums in package obsclient.
+var enumFields = map[string]enumType{
+	"mediaAction": {Name: "ObsMediaInputAction", Proto: true},
+	"outputState": {Name: "ObsOutputState", Proto: true},
+	"monitorType": {Name: "MonitorType"},
+	"mediaState":  {Name: "MediaState"},
+}
+
+// Generate generates the typed methods of obsclient.Client, one per
+// request, and the options of their optional fields.
+//
+// The protobuf messages are taken from package obs_grpc, so it should be
+// generated first.
+func Generate(
+	ctx context.Context,
+	w io.Writer,
+	p *obsdoc.Protocol,
+) error {
+	if p == nil {
+		return nil
+	}
+
+	code := jen.NewFile("obsclient")
+	code.ImportName(pkgOBSGRPC, "obs_grpc")
+	code.HeaderComment("This file was automatically generated by github.com/xaionaro-go/obs-grpc-proxy/scripts/generate")
+
+	g := &generator{
+		options: map[string]*option{},
+	}
+	for _, request := range p.Requests {
+		if err := g.generateRequest(code, request); err != nil {
+			return fmt.Errorf("unable to generate request %s: %w", request.RequestType, err)
+		}
+	}
+	g.generateOptions(code)
+
+	err := code.Render(w)
+	if err != nil {
+		return fmt.Errorf("unable to render the code: %w", err)
+	}
+
+	return nil
+}
+
+type generator struct {
+	options map[string]*option
+}
+
+// option is a type setting an optional field, shared by all the requests
+// having the field.
+type option struct {
+	Name     string
+	ObsName  string
+	Type     jen.Code
+	TypeKey  string
+	IsStruct bool
+	Applies  []jen.Code
+}
+
+// field is a request or a response field (or a name-or-UUID oneof of
+// request fields).
+type field struct {
+	ObsName  string
+	Desc     protoreflect.FieldDescriptor
+	GoName   string
+	Optional bool
+	Oneof    *obsprotobufgen.Oneof
+}
+
+// goName returns the name of the field (or of the oneof) in the generated
+// Go structure.
+func (f field) goName() string {
+	if f.Oneof != nil {
+		return title(f.Oneof.Name)
+	}
+	return f.GoName
+}
+
+func (g *generator) fields(msgName string, fields []obsdoc.Field, withOneofs bool) ([]field, error) {
+	md := obs_grpc.File_obs_proto.Messages().ByName(protoreflect.Name(msgName))
+	if md == nil {
+		return nil, fmt.Errorf("message %s is not found, the protobuf code is probably outdated", msgName)
+	}
+
+	var oneofs map[string]obsprotobufgen.Oneof
+	if withOneofs {
+		oneofs = obsprotobufgen.RequestOneofs(fields)
+	}
+	var result []field
+	for _, f := range fields {
+		// the sub-fields (like "keyModifiers.shift") are set via their parent
+		if strings.Contains(f.ValueName, ".") {
+			continue
+		}
+		if oneof, ok := oneofs[f.ValueName]; ok {
+			if f.ValueName == oneof.NameField {
+				result = append(result, field{ObsName: oneof.Name, Optional: !oneof.Required, Oneof: &oneof})
+			}
+			continue
+		}
+		fd := md.Fields().ByName(protoreflect.Name(obsprotobufgen.FieldNameObs2Protobuf(f.ValueName)))
+		if fd == nil {
+			return nil, fmt.Errorf("field %s is not found in message %s", f.ValueName, msgName)
+		}
+		goName, err := goFieldName(md, fd)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, field{ObsName: f.ValueName, Desc: fd, GoName: goName, Optional: f.ValueOptional})
+	}
+	return result, nil
+}
+
+func (g *generator) generateRequest(code *jen.File, request obsdoc.Request) error {
+	reqName := request.RequestType + "Request"
+	reqFields, err := g.fields(reqName, request.RequestFields, true)
+	if err != nil {
+		return err
+	}
+	respFields, err := g.fields(request.RequestType+"Response", request.ResponseFields, false)
+	if err != nil {
+		return err
+	}
+
+	optionType := request.RequestType + "Option"
+	applyName := "apply" + request.RequestType
+	var (
+		params     []jen.Code
+		args       []jen.Code
+		assigns    = jen.Dict{}
+		refAssigns []jen.Code
+		hasOptions bool
+	)
+	for _, f := range reqFields {
+		if f.Optional {
+			hasOptions = true
+			if err := g.addOption(reqName, applyName, f); err != nil {
+				return err
+			}
+			continue
+		}
+		param := paramName(f.goName())
+		args = append(args, jen.Id(param))
+		if f.Oneof != nil {
+			params = append(params, jen.Id(param).Id("Ref"))
+			refAssigns = append(refAssigns, assignRef(reqName, *f.Oneof, jen.Id(param)))
+			continue
+		}
+		t, err := sdkType(f)
+		if err != nil {
+			return err
+		}
+		params = append(params, jen.Id(param).Add(t))
+		v, err := toProtoField(f, jen.Id(param))
+		if err != nil {
+			return err
+		}
+		assigns[jen.Id(f.GoName)] = v
+	}
+
+	if hasOptions {
+		code.Commentf("%s is an optional argument of %s.", optionType, request.RequestType)
+		code.Type().Id(optionType).Interface(
+			jen.Id(applyName).Params(jen.Id("req").Op("*").Qual(pkgOBSGRPC, reqName)),
+		)
+		code.Line()
+		params = append(params, jen.Id("opts").Op("...").Id(optionType))
+		args = append(args, jen.Id("opts").Op("..."))
+	}
+	allParams := append([]jen.Code{jen.Id("ctx").Qual("context", "Context")}, params...)
+	allArgs := append([]jen.Code{jen.Id("ctx")}, args...)
+
+	body := []jen.Code{
+		jen.Id("req").Op(":=").Op("&").Qual(pkgOBSGRPC, reqName).Values(assigns),
+	}
+	body = append(body, refAssigns...)
+	if hasOptions {
+		body = append(body, jen.For(jen.List(jen.Id("_"), jen.Id("opt")).Op(":=").Range().Id("opts")).Block(
+			jen.Id("opt").Dot(applyName).Call(jen.Id("req")),
+		))
+	}
+	call := jen.Id("c").Dot("OBSClient").Dot(request.RequestType).Call(jen.Id("ctx"), jen.Id("req"))
+	errReturn := jen.Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("unable to %s: %%w", request.RequestType)), jen.Id("err"))
+
+	if len(respFields) == 0 {
+		body = append(body,
+			jen.If(jen.List(jen.Id("_"), jen.Id("err")).Op(":=").Add(call), jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(errReturn),
+			),
+			jen.Return(jen.Nil()),
+		)
+		writeMethodComment(code, request)
+		code.Func().Params(jen.Id("c").Op("*").Id("Client")).Id(request.RequestType).Params(allParams...).Error().Block(body...)
+		code.Line()
+		return nil
+	}
+
+	resultType := request.RequestType + "Result"
+	var (
+		resultFields []jen.Code
+		resultValues = jen.Dict{}
+		listFields   []field
+	)
+	for _, f := range respFields {
+		t, err := sdkType(f)
+		if err != nil {
+			return err
+		}
+		resultFields = append(resultFields, jen.Id(f.GoName).Add(t))
+		v, err := fromProto(f, jen.Id("resp").Dot("Get"+f.GoName).Call())
+		if err != nil {
+			return err
+		}
+		resultValues[jen.Id(f.GoName)] = v
+		if f.Desc.IsList() {
+			listFields = append(listFields, f)
+		}
+	}
+	code.Commentf("%s is the response of %s.", resultType, request.RequestType)
+	code.Type().Id(resultType).Struct(resultFields...)
+	code.Line()
+
+	body = append(body,
+		jen.List(jen.Id("resp"), jen.Id("err")).Op(":=").Add(call),
+		jen.If(jen.Id("err").Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), errReturn),
+		),
+		jen.Return(jen.Op("&").Id(resultType).Values(resultValues), jen.Nil()),
+	)
+	writeMethodComment(code, request)
+	code.Func().Params(jen.Id("c").Op("*").Id("Client")).Id(request.RequestType).Params(allParams...).Params(
+		jen.Op("*").Id(resultType), jen.Error(),
+	).Block(body...)
+	code.Line()
+
+	// an iterator is only generated if it is clear what to iterate over
+	if len(listFields) != 1 {
+		return nil
+	}
+	list := listFields[0]
+	itemType, err := sdkItemType(list)
+	if err != nil {
+		return err
+	}
+	seqName := request.RequestType + "Seq"
+	code.Commentf("%s is like %s, but iterates over field %s of the response.", seqName, request.RequestType, list.GoName)
+	code.Func().Params(jen.Id("c").Op("*").Id("Client")).Id(seqName).Params(allParams...).Id("Seq").Types(itemType).Block(
+		jen.List(jen.Id("result"), jen.Id("err")).Op(":=").Id("c").Dot(request.RequestType).Call(allArgs...),
+		jen.If(jen.Id("err").Op("!=").Nil()).Block(
+			jen.Return(jen.Id("seqOf").Types(itemType).Call(jen.Nil(), jen.Id("err"))),
+		),
+		jen.Return(jen.Id("seqOf").Call(jen.Id("result").Dot(list.GoName), jen.Nil())),
+	)
+	code.Line()
+	return nil
+}
+
+func writeMethodComment(code *jen.File, request obsdoc.Request) {
+	code.Commentf("%s sends request %s.", request.RequestType, request.RequestType)
+	if request.Description != "" {
+		code.Comment("")
+		for _, line := range strings.Split(strings.TrimSpace(request.Description), "\n") {
+			code.Comment(line)
+		}
+	}
+	if request.Deprecated {
+		code.Comment("")
+		code.Commentf("Deprecated: request %s is deprecated by obs-websocket.", request.RequestType)
+	}
+}
+
+func (g *generator) addOption(reqName, applyName string, f field) error {
+	name := "Option" + title(f.goName())
+	var (
+		t        jen.Code
+		typeKey  string
+		isStruct bool
+		apply    []jen.Code
+	)
+	if f.Oneof != nil {
+		t, typeKey = jen.Id("Ref"), "Ref"
+		apply = []jen.Code{assignRef(reqName, *f.Oneof, jen.Id("Ref").Call(jen.Id("opt")))}
+	} else {
+		var err error
+		t, err = sdkType(f)
+		if err != nil {
+			return err
+		}
+		typeKey = fmt.Sprintf("%#v", t)
+		// a method could not be declared on a named pointer type
+		isStruct = f.Desc.Kind() == protoreflect.MessageKind && !f.Desc.IsList()
+		value := jen.Id("opt").Dot("Value")
+		if !isStruct {
+			value = jen.Add(t).Call(jen.Id("opt"))
+		}
+		v, err := toProtoField(f, value)
+		if err != nil {
+			return err
+		}
+		apply = []jen.Code{jen.Id("req").Dot(f.GoName).Op("=").Add(v)}
+	}
+
+	opt := g.options[name]
+	if opt == nil {
+		opt = &option{Name: name, ObsName: f.ObsName, Type: t, TypeKey: typeKey, IsStruct: isStruct}
+		g.options[name] = opt
+	}
+	if opt.TypeKey != typeKey {
+		return fmt.Errorf("field %s is of type %s, but it is %s in the other requests", f.ObsName, typeKey, opt.TypeKey)
+	}
+	opt.Applies = append(opt.Applies,
+		jen.Func().Params(jen.Id("opt").Id(name)).Id(applyName).Params(
+			jen.Id("req").Op("*").Qual(pkgOBSGRPC, reqName),
+		).Block(apply...),
+		jen.Line(),
+	)
+	return nil
+}
+
+func (g *generator) generateOptions(code *jen.File) {
+	names := make([]string, 0, len(g.options))
+	for name := range g.options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		opt := g.options[name]
+		code.Commentf("%s sets optional field %s of the requests.", opt.Name, opt.ObsName)
+		if opt.IsStruct {
+			code.Type().Id(opt.Name).Struct(jen.Id("Value").Add(opt.Type))
+		} else {
+			code.Type().Id(opt.Name).Add(opt.Type)
+		}
+		code.Line()
+		for _, apply := range opt.Applies {
+			code.Add(apply)
+		}
+	}
+}
+
+// assignRef sets the name-or-UUID oneof of the request to the Ref.
+func assignRef(reqName string, oneof obsprotobufgen.Oneof, ref jen.Code) jen.Code {
+	nameField := title(obsprotobufgen.FieldNameObs2Protobuf(oneof.NameField))
+	uuidField := title(obsprotobufgen.FieldNameObs2Protobuf(oneof.UUIDField))
+	oneofField := jen.Id("req").Dot(title(oneof.Name))
+	return jen.If(jen.Add(ref).Dot("UUID").Op("!=").Lit("")).Block(
+		oneofField.Clone().Op("=").Op("&").Qual(pkgOBSGRPC, reqName+"_"+uuidField).Values(jen.Dict{
+			jen.Id(uuidField): jen.Add(ref).Dot("UUID"),
+		}),
+	).Else().Block(
+		oneofField.Clone().Op("=").Op("&").Qual(pkgOBSGRPC, reqName+"_"+nameField).Values(jen.Dict{
+			jen.Id(nameField): jen.Add(ref).Dot("Name"),
+		}),
+	)
+}
+
+// sdkItemType returns the Go type of a (single) value of the field in
+// package obsclient.
+func sdkItemType(f field) (jen.Code, error) {
+	fd := f.Desc
+	if enum, ok := enumFields[f.ObsName]; ok {
+		if enum.Proto {
+			return jen.Qual(pkgOBSGRPC, enum.Name), nil
+		}
+		return jen.Id(enum.Name), nil
+	}
+	switch fd.Kind() {
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return jen.String(), nil
+	case protoreflect.BoolKind:
+		return jen.Bool(), nil
+	case protoreflect.Int64Kind:
+		return jen.Int64(), nil
+	case protoreflect.DoubleKind:
+		return jen.Float64(), nil
+	case protoreflect.MessageKind:
+		if fd.IsMap() {
+			return nil, fmt.Errorf("map field %s is not supported", f.ObsName)
+		}
+		return jen.Op("*").Qual(pkgOBSGRPC, string(fd.Message().Name())), nil
+	}
+	return nil, fmt.Errorf("field %s is of unsupported kind %s", f.ObsName, fd.Kind())
+}
+
+// sdkType returns the Go type of the field in package obsclient.
+func sdkType(f field) (jen.Code, error) {
+	t, err := sdkItemType(f)
+	if err != nil {
+		return nil, err
+	}
+	if f.Desc.IsList() {
+		return jen.Index().Add(t), nil
+	}
+	return t, nil
+}
+
+// toProto converts a value of the type returned by sdkType to the type of
+// the field in package obs_grpc (except the pointers of the optional
+// scalars).
+func toProto(f field, v jen.Code) (jen.Code, error) {
+	fd := f.Desc
+	enum, isEnum := enumFields[f.ObsName]
+	if fd.IsList() {
+		switch {
+		case isEnum:
+			return nil, fmt.Errorf("repeated enum field %s is not supported", f.ObsName)
+		case fd.Kind() == protoreflect.BytesKind:
+			return jen.Id("stringsToBytes").Call(v), nil
+		}
+		return v, nil
+	}
+	if isEnum {
+		if enum.Proto {
+			v = jen.Add(v).Dot("String").Call()
+		} else {
+			v = jen.String().Call(v)
+		}
+	}
+	if fd.Kind() == protoreflect.BytesKind {
+		return jen.Index().Byte().Call(v), nil
+	}
+	return v, nil
+}
+
+// toProtoField is like toProto, but it also takes the pointer of
+// the optional scalars.
+func toProtoField(f field, v jen.Code) (jen.Code, error) {
+	v, err := toProto(f, v)
+	if err != nil {
+		return nil, err
+	}
+	fd := f.Desc
+	// the optional bytes are not pointers in Go
+	if fd.HasPresence() && fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.BytesKind {
+		return jen.Id("ptr").Call(v), nil
+	}
+	return v, nil
+}
+
+// fromProto converts a value returned by the getter of the field in
+// package obs_grpc to the type returned by sdkType.
+func fromProto(f field, v jen.Code) (jen.Code, error) {
+	fd := f.Desc
+	enum, isEnum := enumFields[f.ObsName]
+	if fd.IsList() {
+		switch {
+		case isEnum:
+			return nil, fmt.Errorf("repeated enum field %s is not supported", f.ObsName)
+		case fd.Kind() == protoreflect.BytesKind:
+			return jen.Id("bytesToStrings").Call(v), nil
+		}
+		return v, nil
+	}
+	if fd.Kind() == protoreflect.BytesKind {
+		v = jen.String().Call(v)
+	}
+	if isEnum {
+		if enum.Proto {
+			// the unknown values are converted to the zero value
+			return jen.Qual(pkgOBSGRPC, enum.Name).Call(jen.Qual(pkgOBSGRPC, enum.Name+"_value").Index(v)), nil
+		}
+		return jen.Id(enum.Name).Call(v), nil
+	}
+	return v, nil
+}
+
+// paramName returns the name of the argument of the method for the field
+// with the given Go name.
+func paramName(goName string) string {
+	name := strings.ToLower(goName[:1]) + goName[1:]
+	switch name {
+	case "ctx", "req", "resp", "err", "opts", "opt", "c", "result":
+		return name + "Value"
+	}
+	if token.IsKeyword(name) {
+		return name + "Value"
+	}
+	return name
+}
+
+// goFieldName returns the name of the field in the generated Go structure.
+func goFieldName(md protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor) (string, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+	if err != nil {
+		return "", fmt.Errorf("unable to find the Go type of message %s: %w", md.FullName(), err)
+	}
+	t := reflect.TypeOf(mt.Zero().Interface()).Elem()
+	for idx := 0; idx < t.NumField(); idx++ {
+		for _, part := range strings.Split(t.Field(idx).Tag.Get("protobuf"), ",") {
+			if part == "name="+string(fd.Name()) {
+				return t.Field(idx).Name, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("field %s is not found in the Go type of message %s", fd.Name(), md.FullName())
+}
+
+func title(s string) string {
+	if len(s) == 0 {
+		return ""
+	}
+
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -29,6 +29,7 @@ type Field struct {
 	ValueRestrictions     any    `json:"valueRestrictions,omitempty"`
 	ValueOptional         bool   `json:"valueOptional,omitempty"`
 	ValueOptionalBehavior any    `json:"valueOptionalBehavior,omitempty"`
+	Deprecated            bool   `json:"deprecated,omitempty"`
 }
 type Request struct {
 	Description    string  `json:"description,omitempty"`
@@ -0,0 +1,63 @@
+package obsdoc
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ValueRestrictions are the bounds of a number described by field
+// "valueRestrictions" (like ">= 0, <= 20"). The unset bounds are nil.
+type ValueRestrictions struct {
+	Min          *float64
+	Max          *float64
+	ExclusiveMin *float64
+	ExclusiveMax *float64
+}
+
+func (r ValueRestrictions) IsEmpty() bool {
+	return r.Min == nil && r.Max == nil && r.ExclusiveMin == nil && r.ExclusiveMax == nil
+}
+
+var regexpValueRestriction = regexp.MustCompile(`^(>=|<=|>|<)\s*(-?[0-9]+(?:\.[0-9]+)?)$`)
+
+// ParseValueRestrictions parses field "valueRestrictions" of a Field.
+func ParseValueRestrictions(restrictions any) (ValueRestrictions, error) {
+	var result ValueRestrictions
+	if restrictions == nil {
+		return result, nil
+	}
+	s, ok := restrictions.(string)
+	if !ok {
+		return result, fmt.Errorf("expected a string, but received %T", restrictions)
+	}
+	if s == "None" {
+		return result, nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		match := regexpValueRestriction.FindStringSubmatch(part)
+		if match == nil {
+			return result, fmt.Errorf("unable to parse restriction '%s'", part)
+		}
+		value, err := strconv.ParseFloat(match[2], 64)
+		if err != nil {
+			return result, fmt.Errorf("unable to parse '%s' as a number: %w", match[2], err)
+		}
+		switch match[1] {
+		case ">=":
+			result.Min = &value
+		case "<=":
+			result.Max = &value
+		case ">":
+			result.ExclusiveMin = &value
+		case "<":
+			result.ExclusiveMax = &value
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,62 @@
+package obsdocdiff
+
+import (
+	"fmt"
+	"io"
+)
+
+// WriteChangelog writes the changes as a Markdown changelog: the breaking
+// changes first, then all the changes grouped by section and kind.
+func WriteChangelog(w io.Writer, changes Changes) error {
+	if len(changes) == 0 {
+		_, err := fmt.Fprintf(w, "No changes.\n")
+		return err
+	}
+
+	if breaking := changes.Breaking(); len(breaking) > 0 {
+		if _, err := fmt.Fprintf(w, "# Breaking changes\n\n"); err != nil {
+			return err
+		}
+		for _, c := range breaking {
+			if _, err := fmt.Fprintf(w, "* %s\n", c.Description); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "\n"); err != nil {
+			return err
+		}
+	}
+
+	for _, section := range []Section{SectionRequests, SectionEvents, SectionEnums} {
+		var sectionWritten bool
+		for _, kind := range []Kind{KindAdded, KindRemoved, KindChanged} {
+			var kindWritten bool
+			for _, c := range changes {
+				if c.Section != section || c.Kind != kind {
+					continue
+				}
+				if !sectionWritten {
+					if _, err := fmt.Fprintf(w, "# %s\n\n", section); err != nil {
+						return err
+					}
+					sectionWritten = true
+				}
+				if !kindWritten {
+					if _, err := fmt.Fprintf(w, "## %s\n\n", kind); err != nil {
+						return err
+					}
+					kindWritten = true
+				}
+				if _, err := fmt.Fprintf(w, "* %s\n", c); err != nil {
+					return err
+				}
+			}
+			if kindWritten {
+				if _, err := fmt.Fprintf(w, "\n"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
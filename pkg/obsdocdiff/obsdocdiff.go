@@ -0,0 +1,441 @@
+// Package obsdocdiff compares two versions of the protocol description of
+// obs-websocket (protocol.json), to decide if it is safe to regenerate
+// the proto and the proxy from the newer one.
+package obsdocdiff
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsdoc"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsprotobufgen"
+)
+
+type Kind int
+
+const (
+	KindAdded Kind = iota
+	KindRemoved
+	KindChanged
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindAdded:
+		return "Added"
+	case KindRemoved:
+		return "Removed"
+	case KindChanged:
+		return "Changed"
+	default:
+		return fmt.Sprintf("unknown_kind_%d", int(k))
+	}
+}
+
+type Section int
+
+const (
+	SectionRequests Section = iota
+	SectionEvents
+	SectionEnums
+)
+
+func (s Section) String() string {
+	switch s {
+	case SectionRequests:
+		return "Requests"
+	case SectionEvents:
+		return "Events"
+	case SectionEnums:
+		return "Enums"
+	default:
+		return fmt.Sprintf("unknown_section_%d", int(s))
+	}
+}
+
+// Change is a single difference between two protocol descriptions.
+type Change struct {
+	Section Section
+	Kind    Kind
+
+	// Subject is the name of the request, event or enum.
+	Subject string
+
+	// Description is a human-readable description of the change.
+	Description string
+
+	// Breaking is set if the change breaks the generated proto: the clients
+	// built from the old proto would not work with the new one.
+	Breaking bool
+}
+
+func (c Change) String() string {
+	if c.Breaking {
+		return "BREAKING: " + c.Description
+	}
+	return c.Description
+}
+
+type Changes []Change
+
+// Breaking returns the breaking changes only.
+func (s Changes) Breaking() Changes {
+	var result Changes
+	for _, c := range s {
+		if c.Breaking {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// Diff returns the changes from protocol description "old" to "new".
+func Diff(old, new *obsdoc.Protocol) Changes {
+	if old == nil {
+		old = &obsdoc.Protocol{}
+	}
+	if new == nil {
+		new = &obsdoc.Protocol{}
+	}
+	var result Changes
+	result = append(result, diffRequests(old.Requests, new.Requests)...)
+	result = append(result, diffEvents(old.Events, new.Events)...)
+	result = append(result, diffEnums(old.Enums, new.Enums)...)
+	return result
+}
+
+func diffRequests(old, new []obsdoc.Request) Changes {
+	var result Changes
+	newIndex := map[string]*obsdoc.Request{}
+	for idx := range new {
+		newIndex[new[idx].RequestType] = &new[idx]
+	}
+	oldIndex := map[string]*obsdoc.Request{}
+	for idx := range old {
+		oldRequest := &old[idx]
+		oldIndex[oldRequest.RequestType] = oldRequest
+		newRequest, ok := newIndex[oldRequest.RequestType]
+		if !ok {
+			result = append(result, Change{
+				Section:     SectionRequests,
+				Kind:        KindRemoved,
+				Subject:     oldRequest.RequestType,
+				Description: fmt.Sprintf("request `%s` is removed", oldRequest.RequestType),
+				Breaking:    true,
+			})
+			continue
+		}
+
+		var changes Changes
+		changes = append(changes, diffMetadata(
+			"request `"+oldRequest.RequestType+"`",
+			oldRequest.Category, newRequest.Category,
+			oldRequest.Deprecated, newRequest.Deprecated,
+			oldRequest.InitialVersion, newRequest.InitialVersion,
+			oldRequest.RPCVersion, newRequest.RPCVersion,
+		)...)
+		changes = append(changes, diffFields(
+			"the request of `"+oldRequest.RequestType+"`",
+			oldRequest.RequestFields, newRequest.RequestFields,
+		)...)
+		changes = append(changes, diffFields(
+			"the response of `"+oldRequest.RequestType+"`",
+			oldRequest.ResponseFields, newRequest.ResponseFields,
+		)...)
+		for _, c := range changes {
+			c.Section = SectionRequests
+			c.Subject = oldRequest.RequestType
+			result = append(result, c)
+		}
+	}
+	for _, newRequest := range new {
+		if _, ok := oldIndex[newRequest.RequestType]; ok {
+			continue
+		}
+		result = append(result, Change{
+			Section:     SectionRequests,
+			Kind:        KindAdded,
+			Subject:     newRequest.RequestType,
+			Description: fmt.Sprintf("request `%s` is added%s", newRequest.RequestType, sinceVersion(newRequest.InitialVersion)),
+		})
+	}
+	return result
+}
+
+func diffEvents(old, new []obsdoc.Event) Changes {
+	var result Changes
+	newIndex := map[string]int{}
+	for idx, event := range new {
+		newIndex[event.EventType] = idx
+	}
+	oldIndex := map[string]int{}
+	for oldIdx := range old {
+		oldEvent := &old[oldIdx]
+		oldIndex[oldEvent.EventType] = oldIdx
+		newIdx, ok := newIndex[oldEvent.EventType]
+		if !ok {
+			result = append(result, Change{
+				Section:     SectionEvents,
+				Kind:        KindRemoved,
+				Subject:     oldEvent.EventType,
+				Description: fmt.Sprintf("event `%s` is removed", oldEvent.EventType),
+				Breaking:    true,
+			})
+			continue
+		}
+		newEvent := &new[newIdx]
+
+		var changes Changes
+		if newIdx != oldIdx {
+			// the field numbers of the oneof of EventEnvelope are assigned in the order of the events
+			changes = append(changes, Change{
+				Kind:        KindChanged,
+				Description: fmt.Sprintf("event `%s` moved from position %d to %d (its field number in EventEnvelope changes)", oldEvent.EventType, oldIdx+1, newIdx+1),
+				Breaking:    true,
+			})
+		}
+		if newEvent.EventSubscription != oldEvent.EventSubscription {
+			changes = append(changes, Change{
+				Kind:        KindChanged,
+				Description: fmt.Sprintf("the subscription of event `%s` changed from `%s` to `%s`", oldEvent.EventType, oldEvent.EventSubscription, newEvent.EventSubscription),
+			})
+		}
+		changes = append(changes, diffMetadata(
+			"event `"+oldEvent.EventType+"`",
+			oldEvent.Category, newEvent.Category,
+			oldEvent.Deprecated, newEvent.Deprecated,
+			oldEvent.InitialVersion, newEvent.InitialVersion,
+			oldEvent.RPCVersion, newEvent.RPCVersion,
+		)...)
+		changes = append(changes, diffFields(
+			"event `"+oldEvent.EventType+"`",
+			oldEvent.DataFields, newEvent.DataFields,
+		)...)
+		for _, c := range changes {
+			c.Section = SectionEvents
+			c.Subject = oldEvent.EventType
+			result = append(result, c)
+		}
+	}
+	for _, newEvent := range new {
+		if _, ok := oldIndex[newEvent.EventType]; ok {
+			continue
+		}
+		result = append(result, Change{
+			Section:     SectionEvents,
+			Kind:        KindAdded,
+			Subject:     newEvent.EventType,
+			Description: fmt.Sprintf("event `%s` is added%s", newEvent.EventType, sinceVersion(newEvent.InitialVersion)),
+		})
+	}
+	return result
+}
+
+func diffEnums(old, new []obsdoc.Enum) Changes {
+	var result Changes
+	newIndex := map[string]*obsdoc.Enum{}
+	for idx := range new {
+		newIndex[new[idx].EnumType] = &new[idx]
+	}
+	oldIndex := map[string]struct{}{}
+	for _, oldEnum := range old {
+		oldIndex[oldEnum.EnumType] = struct{}{}
+		newEnum, ok := newIndex[oldEnum.EnumType]
+		if !ok {
+			result = append(result, Change{
+				Section:     SectionEnums,
+				Kind:        KindRemoved,
+				Subject:     oldEnum.EnumType,
+				Description: fmt.Sprintf("enum `%s` is removed", oldEnum.EnumType),
+				Breaking:    true,
+			})
+			continue
+		}
+		for _, c := range diffEnumIdentifiers(oldEnum.EnumType, oldEnum.EnumIdentifiers, newEnum.EnumIdentifiers) {
+			c.Section = SectionEnums
+			c.Subject = oldEnum.EnumType
+			result = append(result, c)
+		}
+	}
+	for _, newEnum := range new {
+		if _, ok := oldIndex[newEnum.EnumType]; ok {
+			continue
+		}
+		result = append(result, Change{
+			Section:     SectionEnums,
+			Kind:        KindAdded,
+			Subject:     newEnum.EnumType,
+			Description: fmt.Sprintf("enum `%s` is added", newEnum.EnumType),
+		})
+	}
+	return result
+}
+
+func diffEnumIdentifiers(enumType string, old, new []obsdoc.EnumIdentifier) Changes {
+	var result Changes
+	newIndex := map[string]*obsdoc.EnumIdentifier{}
+	for idx := range new {
+		newIndex[new[idx].EnumIdentifier] = &new[idx]
+	}
+	oldIndex := map[string]struct{}{}
+	for _, oldValue := range old {
+		oldIndex[oldValue.EnumIdentifier] = struct{}{}
+		newValue, ok := newIndex[oldValue.EnumIdentifier]
+		if !ok {
+			result = append(result, Change{
+				Kind:        KindRemoved,
+				Description: fmt.Sprintf("value `%s` of enum `%s` is removed", oldValue.EnumIdentifier, enumType),
+				Breaking:    true,
+			})
+			continue
+		}
+		if !reflect.DeepEqual(oldValue.EnumValue, newValue.EnumValue) {
+			result = append(result, Change{
+				Kind:        KindChanged,
+				Description: fmt.Sprintf("value `%s` of enum `%s` changed from %v to %v", oldValue.EnumIdentifier, enumType, oldValue.EnumValue, newValue.EnumValue),
+				Breaking:    true,
+			})
+		}
+		if oldValue.Deprecated != newValue.Deprecated {
+			result = append(result, Change{
+				Kind:        KindChanged,
+				Description: fmt.Sprintf("value `%s` of enum `%s` is %s", oldValue.EnumIdentifier, enumType, deprecatedString(newValue.Deprecated)),
+			})
+		}
+	}
+	for _, newValue := range new {
+		if _, ok := oldIndex[newValue.EnumIdentifier]; ok {
+			continue
+		}
+		result = append(result, Change{
+			Kind:        KindAdded,
+			Description: fmt.Sprintf("value `%s` (%v) is added to enum `%s`%s", newValue.EnumIdentifier, newValue.EnumValue, enumType, sinceVersion(newValue.InitialVersion)),
+		})
+	}
+	return result
+}
+
+func diffMetadata(
+	subject string,
+	oldCategory, newCategory string,
+	oldDeprecated, newDeprecated bool,
+	oldInitialVersion, newInitialVersion string,
+	oldRPCVersion, newRPCVersion string,
+) Changes {
+	var result Changes
+	if oldCategory != newCategory {
+		result = append(result, Change{
+			Kind:        KindChanged,
+			Description: fmt.Sprintf("the category of %s changed from `%s` to `%s`", subject, oldCategory, newCategory),
+		})
+	}
+	if oldDeprecated != newDeprecated {
+		result = append(result, Change{
+			Kind:        KindChanged,
+			Description: fmt.Sprintf("%s is %s", subject, deprecatedString(newDeprecated)),
+		})
+	}
+	if oldInitialVersion != newInitialVersion {
+		result = append(result, Change{
+			Kind:        KindChanged,
+			Description: fmt.Sprintf("the initial version of %s changed from %s to %s", subject, oldInitialVersion, newInitialVersion),
+		})
+	}
+	if oldRPCVersion != newRPCVersion {
+		result = append(result, Change{
+			Kind:        KindChanged,
+			Description: fmt.Sprintf("the RPC version of %s changed from %s to %s", subject, oldRPCVersion, newRPCVersion),
+		})
+	}
+	return result
+}
+
+// diffFields compares the fields of a message, the field numbers in
+// the generated proto are assigned in the order of the fields.
+func diffFields(subject string, old, new []obsdoc.Field) Changes {
+	var result Changes
+	newIndex := map[string]int{}
+	for idx, field := range new {
+		newIndex[field.ValueName] = idx
+	}
+	oldIndex := map[string]int{}
+	for oldIdx, oldField := range old {
+		oldIndex[oldField.ValueName] = oldIdx
+		newIdx, ok := newIndex[oldField.ValueName]
+		if !ok {
+			result = append(result, Change{
+				Kind:        KindRemoved,
+				Description: fmt.Sprintf("field `%s` is removed from %s", oldField.ValueName, subject),
+				Breaking:    true,
+			})
+			continue
+		}
+		newField := new[newIdx]
+		if newIdx != oldIdx {
+			result = append(result, Change{
+				Kind:        KindChanged,
+				Description: fmt.Sprintf("the field number of `%s` of %s changed from %d to %d", oldField.ValueName, subject, oldIdx+1, newIdx+1),
+				Breaking:    true,
+			})
+		}
+		if oldField.ValueType != newField.ValueType {
+			oldProtoType := obsprotobufgen.TypeNameObs2Protobuf(oldField.ValueType, oldField.ValueName, nil)
+			newProtoType := obsprotobufgen.TypeNameObs2Protobuf(newField.ValueType, newField.ValueName, nil)
+			result = append(result, Change{
+				Kind:        KindChanged,
+				Description: fmt.Sprintf("the type of `%s` of %s changed from %s to %s", oldField.ValueName, subject, oldField.ValueType, newField.ValueType),
+				Breaking:    oldProtoType != newProtoType,
+			})
+		}
+		if oldField.ValueOptional != newField.ValueOptional {
+			// the optional fields are pointers in Go
+			result = append(result, Change{
+				Kind:        KindChanged,
+				Description: fmt.Sprintf("field `%s` of %s is %s", oldField.ValueName, subject, optionalString(newField.ValueOptional)),
+				Breaking:    true,
+			})
+		}
+		if !reflect.DeepEqual(oldField.ValueRestrictions, newField.ValueRestrictions) {
+			result = append(result, Change{
+				Kind:        KindChanged,
+				Description: fmt.Sprintf("the restrictions of `%s` of %s changed from %v to %v", oldField.ValueName, subject, oldField.ValueRestrictions, newField.ValueRestrictions),
+			})
+		}
+	}
+	for newIdx, newField := range new {
+		if _, ok := oldIndex[newField.ValueName]; ok {
+			continue
+		}
+		c := Change{
+			Kind:        KindAdded,
+			Description: fmt.Sprintf("%s field `%s` is added to %s", optionalString(newField.ValueOptional), newField.ValueName, subject),
+		}
+		if newIdx < len(old) {
+			c.Description += fmt.Sprintf(" (taking field number %d of `%s`)", newIdx+1, old[newIdx].ValueName)
+			c.Breaking = true
+		}
+		result = append(result, c)
+	}
+	return result
+}
+
+func sinceVersion(version string) string {
+	if version == "" {
+		return ""
+	}
+	return " (since " + version + ")"
+}
+
+func deprecatedString(deprecated bool) string {
+	if deprecated {
+		return "deprecated"
+	}
+	return "no longer deprecated"
+}
+
+func optionalString(optional bool) string {
+	if optional {
+		return "optional"
+	}
+	return "required"
+}
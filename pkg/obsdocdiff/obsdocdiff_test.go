@@ -0,0 +1,109 @@
+package obsdocdiff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsdoc"
+)
+
+func testProtocol() *obsdoc.Protocol {
+	return &obsdoc.Protocol{
+		Enums: []obsdoc.Enum{{
+			EnumType: "EventSubscription",
+			EnumIdentifiers: []obsdoc.EnumIdentifier{
+				{EnumIdentifier: "None", EnumValue: int64(0)},
+				{EnumIdentifier: "General", EnumValue: int64(1)},
+			},
+		}},
+		Requests: []obsdoc.Request{{
+			RequestType:    "GetInputMute",
+			InitialVersion: "5.0.0",
+			RequestFields: []obsdoc.Field{
+				{ValueName: "inputName", ValueType: "String", ValueOptional: true},
+				{ValueName: "inputUuid", ValueType: "String", ValueOptional: true},
+			},
+			ResponseFields: []obsdoc.Field{
+				{ValueName: "inputMuted", ValueType: "Boolean"},
+			},
+		}, {
+			RequestType:    "GetVersion",
+			InitialVersion: "5.0.0",
+		}},
+		Events: []obsdoc.Event{
+			{EventType: "ExitStarted"},
+			{EventType: "InputMuteStateChanged", DataFields: []obsdoc.Field{
+				{ValueName: "inputName", ValueType: "String"},
+			}},
+		},
+	}
+}
+
+func TestDiffNoChanges(t *testing.T) {
+	require.Empty(t, Diff(testProtocol(), testProtocol()))
+}
+
+func TestDiffCompatible(t *testing.T) {
+	old, new := testProtocol(), testProtocol()
+	new.Requests = append(new.Requests, obsdoc.Request{RequestType: "CreateRecordChapter", InitialVersion: "5.5.0"})
+	new.Requests[0].ResponseFields = append(new.Requests[0].ResponseFields, obsdoc.Field{ValueName: "inputUuid", ValueType: "String"})
+	new.Requests[1].Deprecated = true
+	new.Events = append(new.Events, obsdoc.Event{EventType: "ExitFinished"})
+	new.Enums[0].EnumIdentifiers = append(new.Enums[0].EnumIdentifiers, obsdoc.EnumIdentifier{EnumIdentifier: "Config", EnumValue: int64(2)})
+
+	changes := Diff(old, new)
+	require.Empty(t, changes.Breaking())
+	require.Equal(t, []string{
+		"required field `inputUuid` is added to the response of `GetInputMute`",
+		"request `GetVersion` is deprecated",
+		"request `CreateRecordChapter` is added (since 5.5.0)",
+		"event `ExitFinished` is added",
+		"value `Config` (2) is added to enum `EventSubscription`",
+	}, descriptions(changes))
+}
+
+func TestDiffBreaking(t *testing.T) {
+	old, new := testProtocol(), testProtocol()
+	new.Requests = new.Requests[:1]
+	new.Requests[0].RequestFields = new.Requests[0].RequestFields[1:]
+	new.Requests[0].ResponseFields[0].ValueType = "Number"
+	new.Events[0], new.Events[1] = new.Events[1], new.Events[0]
+	new.Enums[0].EnumIdentifiers[1].EnumValue = int64(2)
+
+	changes := Diff(old, new)
+	require.Equal(t, changes, changes.Breaking())
+	require.Equal(t, []string{
+		"field `inputName` is removed from the request of `GetInputMute`",
+		"the field number of `inputUuid` of the request of `GetInputMute` changed from 2 to 1",
+		"the type of `inputMuted` of the response of `GetInputMute` changed from Boolean to Number",
+		"request `GetVersion` is removed",
+		"event `ExitStarted` moved from position 1 to 2 (its field number in EventEnvelope changes)",
+		"event `InputMuteStateChanged` moved from position 2 to 1 (its field number in EventEnvelope changes)",
+		"value `General` of enum `EventSubscription` changed from 1 to 2",
+	}, descriptions(changes))
+}
+
+func TestWriteChangelog(t *testing.T) {
+	old, new := testProtocol(), testProtocol()
+	new.Requests = append(new.Requests[:1], obsdoc.Request{RequestType: "CreateRecordChapter", InitialVersion: "5.5.0"})
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteChangelog(&buf, Diff(old, new)))
+	require.Equal(t, "# Breaking changes\n\n"+
+		"* request `GetVersion` is removed\n\n"+
+		"# Requests\n\n"+
+		"## Added\n\n"+
+		"* request `CreateRecordChapter` is added (since 5.5.0)\n\n"+
+		"## Removed\n\n"+
+		"* BREAKING: request `GetVersion` is removed\n\n",
+		buf.String())
+}
+
+func descriptions(changes Changes) []string {
+	result := make([]string, 0, len(changes))
+	for _, c := range changes {
+		result = append(result, c.Description)
+	}
+	return result
+}
@@ -0,0 +1,298 @@
+package obsfake
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+)
+
+type scene struct {
+	Name  string
+	UUID  string
+	Items []*sceneItem // the first one is the bottom one
+
+	lastSceneItemID int
+}
+
+type sceneItem struct {
+	ID      int
+	Input   *input
+	Enabled bool
+	Locked  bool
+}
+
+type input struct {
+	Name      string
+	UUID      string
+	Kind      string
+	Settings  map[string]any
+	Muted     bool
+	VolumeMul float64
+}
+
+type output struct {
+	Active    bool
+	Paused    bool
+	StartedAt time.Time
+}
+
+func (out *output) Duration() time.Duration {
+	if !out.Active {
+		return 0
+	}
+	return time.Since(out.StartedAt)
+}
+
+// Timecode returns the duration formatted the same as obs-websocket does.
+func (out *output) Timecode() string {
+	d := out.Duration()
+	return fmt.Sprintf(
+		"%02d:%02d:%02d.%03d",
+		int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60, d.Milliseconds()%1000,
+	)
+}
+
+// newUUID returns a unique (but predictable) UUID.
+func (o *OBS) newUUID() string {
+	o.lastUUID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012x", o.lastUUID)
+}
+
+func (o *OBS) addScene(name string) *scene {
+	s := &scene{Name: name, UUID: o.newUUID()}
+	o.scenes = append(o.scenes, s)
+	return s
+}
+
+func (o *OBS) sceneIndex(s *scene) int {
+	for idx, candidate := range o.scenes {
+		if candidate == s {
+			return idx
+		}
+	}
+	return -1
+}
+
+func (o *OBS) sceneByName(name string) *scene {
+	for _, s := range o.scenes {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+func (o *OBS) inputByName(name string) *input {
+	for _, in := range o.inputs {
+		if in.Name == name {
+			return in
+		}
+	}
+	return nil
+}
+
+// findScene returns the scene selected by fields "<prefix>Name"
+// and "<prefix>Uuid" of the request.
+func (o *OBS) findScene(p params, prefix string) (*scene, error) {
+	name, uuid, err := p.nameOrUUID(prefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range o.scenes {
+		if (uuid != "" && s.UUID == uuid) || (uuid == "" && s.Name == name) {
+			return s, nil
+		}
+	}
+	return nil, errNotFound("scene", name, uuid)
+}
+
+// findInput returns the input selected by fields "<prefix>Name"
+// and "<prefix>Uuid" of the request.
+func (o *OBS) findInput(p params, prefix string) (*input, error) {
+	name, uuid, err := p.nameOrUUID(prefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, in := range o.inputs {
+		if (uuid != "" && in.UUID == uuid) || (uuid == "" && in.Name == name) {
+			return in, nil
+		}
+	}
+	return nil, errNotFound("input", name, uuid)
+}
+
+// findSceneItem returns the scene item selected by the scene fields and
+// field "sceneItemId" of the request.
+func (o *OBS) findSceneItem(p params) (*scene, *sceneItem, int, error) {
+	s, err := o.findScene(p, "scene")
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	id, err := p.requireInt("sceneItemId")
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	for idx, item := range s.Items {
+		if item.ID == id {
+			return s, item, idx, nil
+		}
+	}
+	return nil, nil, 0, RequestError{
+		Code:    obs_grpc.RequestStatus_ResourceNotFound,
+		Comment: fmt.Sprintf("No scene items were found in scene `%s` with the ID `%d`.", s.Name, id),
+	}
+}
+
+func errNotFound(kind, name, uuid string) error {
+	if uuid != "" {
+		return RequestError{
+			Code:    obs_grpc.RequestStatus_ResourceNotFound,
+			Comment: fmt.Sprintf("No %s was found by the UUID `%s`.", kind, uuid),
+		}
+	}
+	return RequestError{
+		Code:    obs_grpc.RequestStatus_ResourceNotFound,
+		Comment: fmt.Sprintf("No %s was found by the name of `%s`.", kind, name),
+	}
+}
+
+func volumeDB(mul float64) float64 {
+	if mul <= 0 {
+		return -100
+	}
+	return 20 * math.Log10(mul)
+}
+
+func dbToMul(db float64) float64 {
+	return math.Pow(10, db/20)
+}
+
+// params is the request data with the accessors returning the errors
+// of obs-websocket.
+type params map[string]any
+
+func errMissingField(key string) error {
+	return RequestError{
+		Code:    obs_grpc.RequestStatus_MissingRequestField,
+		Comment: fmt.Sprintf("Your request is missing the `%s` field.", key),
+	}
+}
+
+func errFieldType(key, typeName string) error {
+	return RequestError{
+		Code:    obs_grpc.RequestStatus_InvalidRequestFieldType,
+		Comment: fmt.Sprintf("The field value of `%s` must be %s.", key, typeName),
+	}
+}
+
+func (p params) string(key string) (string, bool, error) {
+	v, ok := p[key]
+	if !ok || v == nil {
+		return "", false, nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", false, errFieldType(key, "a string")
+	}
+	if s == "" {
+		return "", false, RequestError{
+			Code:    obs_grpc.RequestStatus_RequestFieldEmpty,
+			Comment: fmt.Sprintf("The field value of `%s` must not be empty.", key),
+		}
+	}
+	return s, true, nil
+}
+
+func (p params) requireString(key string) (string, error) {
+	s, ok, err := p.string(key)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", errMissingField(key)
+	}
+	return s, nil
+}
+
+func (p params) bool(key string) (bool, bool, error) {
+	v, ok := p[key]
+	if !ok || v == nil {
+		return false, false, nil
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, false, errFieldType(key, "a boolean")
+	}
+	return b, true, nil
+}
+
+func (p params) requireBool(key string) (bool, error) {
+	b, ok, err := p.bool(key)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return false, errMissingField(key)
+	}
+	return b, nil
+}
+
+func (p params) number(key string) (float64, bool, error) {
+	v, ok := p[key]
+	if !ok || v == nil {
+		return 0, false, nil
+	}
+	f, ok := v.(float64)
+	if !ok {
+		return 0, false, errFieldType(key, "a number")
+	}
+	return f, true, nil
+}
+
+func (p params) requireInt(key string) (int, error) {
+	f, ok, err := p.number(key)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, errMissingField(key)
+	}
+	if f != math.Trunc(f) {
+		return 0, errFieldType(key, "an integer")
+	}
+	return int(f), nil
+}
+
+func (p params) object(key string) (map[string]any, bool, error) {
+	v, ok := p[key]
+	if !ok || v == nil {
+		return nil, false, nil
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, false, errFieldType(key, "an object")
+	}
+	return m, true, nil
+}
+
+// nameOrUUID returns the values of fields "<prefix>Name" and
+// "<prefix>Uuid"; at least one of them is required.
+func (p params) nameOrUUID(prefix string) (string, string, error) {
+	name, hasName, err := p.string(prefix + "Name")
+	if err != nil {
+		return "", "", err
+	}
+	uuid, hasUUID, err := p.string(prefix + "Uuid")
+	if err != nil {
+		return "", "", err
+	}
+	if !hasName && !hasUUID {
+		return "", "", RequestError{
+			Code:    obs_grpc.RequestStatus_MissingRequestField,
+			Comment: fmt.Sprintf("Your request must contain at least one of the following fields: `%sName` or `%sUuid`.", prefix, prefix),
+		}
+	}
+	return name, uuid, nil
+}
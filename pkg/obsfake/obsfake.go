@@ -0,0 +1,231 @@
+// Package obsfake implements an in-process fake OBS speaking obs-websocket
+// (v5), with an in-memory model of the scenes, inputs, scene items and
+// outputs. It is intended for the tests which could not rely on a real OBS:
+//
+//	fake := obsfake.New()
+//	srv := httptest.NewServer(fake)
+//	client, err := goobs.New(strings.TrimPrefix(srv.URL, "http://"))
+package obsfake
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obswsbridge"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+)
+
+// eventBufferSize is the amount of the events buffered for a subscriber,
+// the events exceeding it are dropped.
+const eventBufferSize = 1024
+
+// RequestHandler handles a request received by OBS, overriding the built-in
+// handler (if any). If the returned error is not a RequestError, then
+// the request fails with RequestProcessingFailed.
+type RequestHandler func(ctx context.Context, requestData map[string]any) (map[string]any, error)
+
+// Request is a request received by OBS.
+type Request struct {
+	RequestType string
+	RequestData map[string]any
+}
+
+// RequestError is an error with the status code of obs-websocket.
+type RequestError struct {
+	Code    obs_grpc.RequestStatus
+	Comment string
+}
+
+func (err RequestError) Error() string {
+	return fmt.Sprintf("%s (%d): %s", err.Code, err.Code, err.Comment)
+}
+
+// OBS is the fake OBS. It is an http.Handler serving obs-websocket.
+type OBS struct {
+	cfg    configT
+	server *obswsbridge.Server
+
+	locker       sync.Mutex
+	lastUUID     uint64
+	scenes       []*scene
+	inputs       []*input
+	programScene *scene
+	previewScene *scene
+	studioMode   bool
+	stream       output
+	record       output
+	virtualCam   output
+	replayBuffer output
+	handlers     map[string]RequestHandler
+	requests     []Request
+
+	subscribersLocker sync.Mutex
+	subscribers       map[chan obswsbridge.Event]struct{}
+}
+
+var (
+	_ http.Handler        = (*OBS)(nil)
+	_ obswsbridge.Backend = (*OBS)(nil)
+)
+
+// New returns a fake OBS having a single empty scene "Scene" (like a fresh
+// installation of OBS).
+func New(opts ...Option) *OBS {
+	o := &OBS{
+		cfg:         Options(opts).config(),
+		handlers:    map[string]RequestHandler{},
+		subscribers: map[chan obswsbridge.Event]struct{}{},
+	}
+	o.server = obswsbridge.NewServer(o, obswsbridge.OptionPassword(o.cfg.Password))
+	o.programScene = o.addScene("Scene")
+	return o
+}
+
+func (o *OBS) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	o.server.ServeHTTP(w, req)
+}
+
+// HandleRequest overrides the handling of the request type. A nil handler
+// restores the built-in behavior.
+func (o *OBS) HandleRequest(requestType string, handler RequestHandler) {
+	o.locker.Lock()
+	defer o.locker.Unlock()
+	if handler == nil {
+		delete(o.handlers, requestType)
+		return
+	}
+	o.handlers[requestType] = handler
+}
+
+// Requests returns the requests received so far.
+func (o *OBS) Requests() []Request {
+	o.locker.Lock()
+	defer o.locker.Unlock()
+	return append([]Request{}, o.requests...)
+}
+
+// Emit sends an event to the subscribed clients.
+func (o *OBS) Emit(
+	eventType string,
+	intent obs_grpc.EventSubscription,
+	eventData map[string]any,
+) {
+	ev := obswsbridge.Event{
+		EventType:   eventType,
+		EventIntent: int(intent),
+		EventData:   eventData,
+	}
+	o.subscribersLocker.Lock()
+	defer o.subscribersLocker.Unlock()
+	for ch := range o.subscribers {
+		select {
+		case ch <- ev:
+		default:
+			// the subscriber does not keep up; the same as a lagging client
+			// of a real OBS, it just misses the event
+		}
+	}
+}
+
+func (o *OBS) OBSWebSocketVersion(ctx context.Context) string {
+	return o.cfg.OBSWebSocketVersion
+}
+
+func (o *OBS) ExecuteRequest(
+	ctx context.Context,
+	requestType string,
+	requestData map[string]any,
+) (map[string]any, obswsbridge.RequestStatus) {
+	o.locker.Lock()
+	o.requests = append(o.requests, Request{
+		RequestType: requestType,
+		RequestData: cloneMap(requestData),
+	})
+	handler := o.handlers[requestType]
+	o.locker.Unlock()
+
+	var (
+		resp map[string]any
+		err  error
+	)
+	switch {
+	case handler != nil:
+		resp, err = handler(ctx, requestData)
+	case builtinHandlers[requestType] != nil:
+		o.locker.Lock()
+		resp, err = builtinHandlers[requestType](o, params(requestData))
+		o.locker.Unlock()
+	default:
+		err = RequestError{
+			Code:    obs_grpc.RequestStatus_UnknownRequestType,
+			Comment: "Your request type is not valid.",
+		}
+	}
+	if err != nil {
+		reqErr, ok := err.(RequestError)
+		if !ok {
+			reqErr = RequestError{Code: obs_grpc.RequestStatus_RequestProcessingFailed, Comment: err.Error()}
+		}
+		return nil, obswsbridge.RequestStatus{Code: int(reqErr.Code), Comment: reqErr.Comment}
+	}
+	return resp, obswsbridge.RequestStatus{Result: true, Code: int(obs_grpc.RequestStatus_Success)}
+}
+
+func (o *OBS) SubscribeToEvents(ctx context.Context) (<-chan obswsbridge.Event, error) {
+	ch := make(chan obswsbridge.Event, eventBufferSize)
+	o.subscribersLocker.Lock()
+	o.subscribers[ch] = struct{}{}
+	o.subscribersLocker.Unlock()
+	go func() {
+		<-ctx.Done()
+		o.subscribersLocker.Lock()
+		defer o.subscribersLocker.Unlock()
+		delete(o.subscribers, ch)
+		close(ch)
+	}()
+	return ch, nil
+}
+
+// availableRequests returns the request types which could be handled.
+func (o *OBS) availableRequests() []string {
+	result := make([]string, 0, len(builtinHandlers)+len(o.handlers))
+	for requestType := range builtinHandlers {
+		result = append(result, requestType)
+	}
+	for requestType := range o.handlers {
+		if builtinHandlers[requestType] == nil {
+			result = append(result, requestType)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func cloneMap(m map[string]any) map[string]any {
+	if m == nil {
+		return nil
+	}
+	result := make(map[string]any, len(m))
+	for k, v := range m {
+		result[k] = cloneValue(v)
+	}
+	return result
+}
+
+func cloneValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		return cloneMap(v)
+	case []any:
+		result := make([]any, len(v))
+		for idx, item := range v {
+			result[idx] = cloneValue(item)
+		}
+		return result
+	default:
+		return v
+	}
+}
@@ -0,0 +1,136 @@
+package obsfake
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andreykaipov/goobs"
+	"github.com/andreykaipov/goobs/api/events"
+	"github.com/andreykaipov/goobs/api/requests/inputs"
+	"github.com/andreykaipov/goobs/api/requests/record"
+	"github.com/andreykaipov/goobs/api/requests/sceneitems"
+	"github.com/andreykaipov/goobs/api/requests/scenes"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, fake *OBS, opts ...goobs.Option) *goobs.Client {
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	client, err := goobs.New(strings.TrimPrefix(srv.URL, "http://"), opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Disconnect() })
+	return client
+}
+
+func nextEvent(t *testing.T, client *goobs.Client) any {
+	select {
+	case ev := <-client.IncomingEvents:
+		return ev
+	case <-time.After(10 * time.Second):
+		t.Fatal("no event received")
+		return nil
+	}
+}
+
+func TestOBS(t *testing.T) {
+	fake := New(OptionPassword("secret"))
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+	_, err := goobs.New(strings.TrimPrefix(srv.URL, "http://"), goobs.WithPassword("wrong"))
+	require.Error(t, err)
+	client := newTestClient(t, fake, goobs.WithPassword("secret"))
+
+	version, err := client.General.GetVersion()
+	require.NoError(t, err)
+	require.Equal(t, "30.2.0", version.ObsVersion)
+	require.Contains(t, version.AvailableRequests, "CreateInput")
+
+	_, err = client.Scenes.CreateScene(scenes.NewCreateSceneParams().WithSceneName("Live"))
+	require.NoError(t, err)
+	require.Equal(t, &events.SceneCreated{SceneName: "Live", SceneUuid: "00000000-0000-4000-8000-000000000002"}, nextEvent(t, client))
+	_, err = client.Scenes.CreateScene(scenes.NewCreateSceneParams().WithSceneName("Live"))
+	require.ErrorContains(t, err, "(601)")
+
+	_, err = client.Scenes.SetCurrentProgramScene(scenes.NewSetCurrentProgramSceneParams().WithSceneName("Live"))
+	require.NoError(t, err)
+	require.Equal(t, &events.CurrentProgramSceneChanged{SceneName: "Live", SceneUuid: "00000000-0000-4000-8000-000000000002"}, nextEvent(t, client))
+	sceneList, err := client.Scenes.GetSceneList()
+	require.NoError(t, err)
+	require.Equal(t, "Live", sceneList.CurrentProgramSceneName)
+	require.Len(t, sceneList.Scenes, 2)
+
+	input, err := client.Inputs.CreateInput(inputs.NewCreateInputParams().
+		WithSceneName("Live").
+		WithInputName("Camera").
+		WithInputKind("v4l2_input").
+		WithInputSettings(map[string]any{"device_id": "/dev/video0"}))
+	require.NoError(t, err)
+	require.Equal(t, 1, input.SceneItemId)
+	require.IsType(t, &events.InputCreated{}, nextEvent(t, client))
+	require.IsType(t, &events.SceneItemCreated{}, nextEvent(t, client))
+
+	_, err = client.SceneItems.SetSceneItemEnabled(sceneitems.NewSetSceneItemEnabledParams().
+		WithSceneName("Live").
+		WithSceneItemId(input.SceneItemId).
+		WithSceneItemEnabled(false))
+	require.NoError(t, err)
+	require.Equal(t, &events.SceneItemEnableStateChanged{
+		SceneName:   "Live",
+		SceneUuid:   "00000000-0000-4000-8000-000000000002",
+		SceneItemId: 1,
+	}, nextEvent(t, client))
+	items, err := client.SceneItems.GetSceneItemList(sceneitems.NewGetSceneItemListParams().WithSceneName("Live"))
+	require.NoError(t, err)
+	require.Len(t, items.SceneItems, 1)
+	require.Equal(t, "Camera", items.SceneItems[0].SourceName)
+	require.False(t, items.SceneItems[0].SceneItemEnabled)
+
+	mute, err := client.Inputs.ToggleInputMute(inputs.NewToggleInputMuteParams().WithInputName("Camera"))
+	require.NoError(t, err)
+	require.True(t, mute.InputMuted)
+	require.IsType(t, &events.InputMuteStateChanged{}, nextEvent(t, client))
+	_, err = client.Inputs.GetInputMute(inputs.NewGetInputMuteParams().WithInputName("Mic"))
+	require.ErrorContains(t, err, "(600)")
+
+	_, err = client.Record.StartRecord()
+	require.NoError(t, err)
+	require.IsType(t, &events.RecordStateChanged{}, nextEvent(t, client))
+	_, err = client.Record.StartRecord()
+	require.ErrorContains(t, err, "(500)")
+	status, err := client.Record.GetRecordStatus()
+	require.NoError(t, err)
+	require.True(t, status.OutputActive)
+	stopped, err := client.Record.StopRecord(&record.StopRecordParams{})
+	require.NoError(t, err)
+	require.NotEmpty(t, stopped.OutputPath)
+	require.Equal(t, &events.RecordStateChanged{
+		OutputState: "OBS_WEBSOCKET_OUTPUT_STOPPED",
+		OutputPath:  stopped.OutputPath,
+	}, nextEvent(t, client))
+}
+
+func TestHandleRequest(t *testing.T) {
+	fake := New()
+	client := newTestClient(t, fake)
+
+	fake.HandleRequest("GetInputMute", func(ctx context.Context, requestData map[string]any) (map[string]any, error) {
+		return map[string]any{"inputMuted": requestData["inputName"] == "Mic"}, nil
+	})
+	mute, err := client.Inputs.GetInputMute(inputs.NewGetInputMuteParams().WithInputName("Mic"))
+	require.NoError(t, err)
+	require.True(t, mute.InputMuted)
+
+	fake.HandleRequest("GetInputMute", nil)
+	_, err = client.Inputs.GetInputMute(inputs.NewGetInputMuteParams().WithInputName("Mic"))
+	require.ErrorContains(t, err, "(600)")
+
+	var requestTypes []string
+	for _, req := range fake.Requests() {
+		requestTypes = append(requestTypes, req.RequestType)
+	}
+	require.Equal(t, []string{"GetInputMute", "GetInputMute"}, requestTypes)
+	require.Equal(t, map[string]any{"inputName": "Mic"}, fake.Requests()[0].RequestData)
+}
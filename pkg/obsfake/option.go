@@ -0,0 +1,56 @@
+package obsfake
+
+type configT struct {
+	Password            string
+	OBSVersion          string
+	OBSWebSocketVersion string
+}
+
+func defaultConfig() configT {
+	return configT{
+		OBSVersion:          "30.2.0",
+		OBSWebSocketVersion: "5.5.0",
+	}
+}
+
+type Option interface {
+	apply(cfg *configT)
+}
+
+type Options []Option
+
+func (s Options) apply(cfg *configT) {
+	for _, opt := range s {
+		opt.apply(cfg)
+	}
+}
+
+func (s Options) config() configT {
+	cfg := defaultConfig()
+	s.apply(&cfg)
+	return cfg
+}
+
+// OptionPassword enables the authentication of the clients with
+// the password. By default the authentication is disabled.
+type OptionPassword string
+
+func (opt OptionPassword) apply(cfg *configT) {
+	cfg.Password = string(opt)
+}
+
+// OptionVersion sets the versions reported by GetVersion (and by message
+// Hello).
+type OptionVersion struct {
+	OBSVersion          string
+	OBSWebSocketVersion string
+}
+
+func (opt OptionVersion) apply(cfg *configT) {
+	if opt.OBSVersion != "" {
+		cfg.OBSVersion = opt.OBSVersion
+	}
+	if opt.OBSWebSocketVersion != "" {
+		cfg.OBSWebSocketVersion = opt.OBSWebSocketVersion
+	}
+}
@@ -0,0 +1,996 @@
+package obsfake
+
+import (
+	"path"
+	"runtime"
+	"time"
+
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+)
+
+// builtinHandler handles a request; it is called with OBS.locker held.
+type builtinHandler func(o *OBS, p params) (map[string]any, error)
+
+// builtinHandlers are the requests implemented by the model, see also
+// OBS.HandleRequest.
+var builtinHandlers map[string]builtinHandler
+
+func init() {
+	builtinHandlers = map[string]builtinHandler{
+		// general
+		"GetVersion":           (*OBS).getVersion,
+		"GetStats":             (*OBS).getStats,
+		"BroadcastCustomEvent": (*OBS).broadcastCustomEvent,
+
+		// ui
+		"GetStudioModeEnabled": (*OBS).getStudioModeEnabled,
+		"SetStudioModeEnabled": (*OBS).setStudioModeEnabled,
+
+		// scenes
+		"GetSceneList":           (*OBS).getSceneList,
+		"GetGroupList":           (*OBS).getGroupList,
+		"GetCurrentProgramScene": (*OBS).getCurrentProgramScene,
+		"SetCurrentProgramScene": (*OBS).setCurrentProgramScene,
+		"GetCurrentPreviewScene": (*OBS).getCurrentPreviewScene,
+		"SetCurrentPreviewScene": (*OBS).setCurrentPreviewScene,
+		"CreateScene":            (*OBS).createScene,
+		"RemoveScene":            (*OBS).removeScene,
+		"SetSceneName":           (*OBS).setSceneName,
+
+		// inputs
+		"GetInputList":     (*OBS).getInputList,
+		"CreateInput":      (*OBS).createInput,
+		"RemoveInput":      (*OBS).removeInput,
+		"SetInputName":     (*OBS).setInputName,
+		"GetInputSettings": (*OBS).getInputSettings,
+		"SetInputSettings": (*OBS).setInputSettings,
+		"GetInputMute":     (*OBS).getInputMute,
+		"SetInputMute":     (*OBS).setInputMute,
+		"ToggleInputMute":  (*OBS).toggleInputMute,
+		"GetInputVolume":   (*OBS).getInputVolume,
+		"SetInputVolume":   (*OBS).setInputVolume,
+
+		// scene items
+		"GetSceneItemList":    (*OBS).getSceneItemList,
+		"GetSceneItemId":      (*OBS).getSceneItemID,
+		"CreateSceneItem":     (*OBS).createSceneItem,
+		"RemoveSceneItem":     (*OBS).removeSceneItem,
+		"GetSceneItemEnabled": (*OBS).getSceneItemEnabled,
+		"SetSceneItemEnabled": (*OBS).setSceneItemEnabled,
+		"GetSceneItemLocked":  (*OBS).getSceneItemLocked,
+		"SetSceneItemLocked":  (*OBS).setSceneItemLocked,
+		"GetSceneItemIndex":   (*OBS).getSceneItemIndex,
+		"SetSceneItemIndex":   (*OBS).setSceneItemIndex,
+
+		// outputs
+		"GetStreamStatus":       (*OBS).getStreamStatus,
+		"StartStream":           (*OBS).startStream,
+		"StopStream":            (*OBS).stopStream,
+		"ToggleStream":          (*OBS).toggleStream,
+		"GetRecordStatus":       (*OBS).getRecordStatus,
+		"StartRecord":           (*OBS).startRecord,
+		"StopRecord":            (*OBS).stopRecord,
+		"ToggleRecord":          (*OBS).toggleRecord,
+		"PauseRecord":           (*OBS).pauseRecord,
+		"ResumeRecord":          (*OBS).resumeRecord,
+		"ToggleRecordPause":     (*OBS).toggleRecordPause,
+		"GetVirtualCamStatus":   (*OBS).getVirtualCamStatus,
+		"StartVirtualCam":       (*OBS).startVirtualCam,
+		"StopVirtualCam":        (*OBS).stopVirtualCam,
+		"ToggleVirtualCam":      (*OBS).toggleVirtualCam,
+		"GetReplayBufferStatus": (*OBS).getReplayBufferStatus,
+		"StartReplayBuffer":     (*OBS).startReplayBuffer,
+		"StopReplayBuffer":      (*OBS).stopReplayBuffer,
+		"ToggleReplayBuffer":    (*OBS).toggleReplayBuffer,
+	}
+}
+
+const (
+	outputStateStarted = "OBS_WEBSOCKET_OUTPUT_STARTED"
+	outputStateStopped = "OBS_WEBSOCKET_OUTPUT_STOPPED"
+	outputStatePaused  = "OBS_WEBSOCKET_OUTPUT_PAUSED"
+	outputStateResumed = "OBS_WEBSOCKET_OUTPUT_RESUMED"
+)
+
+// general
+
+func (o *OBS) getVersion(p params) (map[string]any, error) {
+	return map[string]any{
+		"obsVersion":            o.cfg.OBSVersion,
+		"obsWebSocketVersion":   o.cfg.OBSWebSocketVersion,
+		"rpcVersion":            1,
+		"availableRequests":     o.availableRequests(),
+		"supportedImageFormats": []string{"jpeg", "jpg", "png"},
+		"platform":              runtime.GOOS,
+		"platformDescription":   "obsfake",
+	}, nil
+}
+
+func (o *OBS) getStats(p params) (map[string]any, error) {
+	return map[string]any{
+		"cpuUsage":                         0.0,
+		"memoryUsage":                      0.0,
+		"availableDiskSpace":               0.0,
+		"activeFps":                        60.0,
+		"averageFrameRenderTime":           0.0,
+		"renderSkippedFrames":              0,
+		"renderTotalFrames":                0,
+		"outputSkippedFrames":              0,
+		"outputTotalFrames":                0,
+		"webSocketSessionIncomingMessages": 0,
+		"webSocketSessionOutgoingMessages": 0,
+	}, nil
+}
+
+func (o *OBS) broadcastCustomEvent(p params) (map[string]any, error) {
+	eventData, ok, err := p.object("eventData")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errMissingField("eventData")
+	}
+	o.Emit("CustomEvent", obs_grpc.EventSubscription_General, map[string]any{"eventData": cloneMap(eventData)})
+	return nil, nil
+}
+
+// ui
+
+func (o *OBS) getStudioModeEnabled(p params) (map[string]any, error) {
+	return map[string]any{"studioModeEnabled": o.studioMode}, nil
+}
+
+func (o *OBS) setStudioModeEnabled(p params) (map[string]any, error) {
+	enabled, err := p.requireBool("studioModeEnabled")
+	if err != nil {
+		return nil, err
+	}
+	if enabled == o.studioMode {
+		return nil, nil
+	}
+	o.studioMode = enabled
+	o.previewScene = nil
+	if enabled {
+		o.previewScene = o.programScene
+	}
+	o.Emit("StudioModeStateChanged", obs_grpc.EventSubscription_Ui, map[string]any{"studioModeEnabled": enabled})
+	return nil, nil
+}
+
+// scenes
+
+func (o *OBS) getSceneList(p params) (map[string]any, error) {
+	// the same as OBS: the first scene of the list is the bottom one
+	scenes := make([]any, 0, len(o.scenes))
+	for idx := len(o.scenes) - 1; idx >= 0; idx-- {
+		s := o.scenes[idx]
+		scenes = append(scenes, map[string]any{
+			"sceneIndex": idx,
+			"sceneName":  s.Name,
+			"sceneUuid":  s.UUID,
+		})
+	}
+	result := map[string]any{
+		"currentProgramSceneName": o.programScene.Name,
+		"currentProgramSceneUuid": o.programScene.UUID,
+		"currentPreviewSceneName": nil,
+		"currentPreviewSceneUuid": nil,
+		"scenes":                  scenes,
+	}
+	if o.previewScene != nil {
+		result["currentPreviewSceneName"] = o.previewScene.Name
+		result["currentPreviewSceneUuid"] = o.previewScene.UUID
+	}
+	return result, nil
+}
+
+func (o *OBS) getGroupList(p params) (map[string]any, error) {
+	return map[string]any{"groups": []any{}}, nil
+}
+
+func (o *OBS) getCurrentProgramScene(p params) (map[string]any, error) {
+	return map[string]any{
+		"sceneName":               o.programScene.Name,
+		"sceneUuid":               o.programScene.UUID,
+		"currentProgramSceneName": o.programScene.Name,
+		"currentProgramSceneUuid": o.programScene.UUID,
+	}, nil
+}
+
+func (o *OBS) setCurrentProgramScene(p params) (map[string]any, error) {
+	s, err := o.findScene(p, "scene")
+	if err != nil {
+		return nil, err
+	}
+	o.switchProgramScene(s)
+	return nil, nil
+}
+
+func (o *OBS) switchProgramScene(s *scene) {
+	if o.programScene == s {
+		return
+	}
+	o.programScene = s
+	o.Emit("CurrentProgramSceneChanged", obs_grpc.EventSubscription_Scenes, map[string]any{
+		"sceneName": s.Name,
+		"sceneUuid": s.UUID,
+	})
+}
+
+func errStudioModeNotActive() error {
+	return RequestError{
+		Code:    obs_grpc.RequestStatus_StudioModeNotActive,
+		Comment: "Studio mode is not active.",
+	}
+}
+
+func (o *OBS) getCurrentPreviewScene(p params) (map[string]any, error) {
+	if !o.studioMode {
+		return nil, errStudioModeNotActive()
+	}
+	return map[string]any{
+		"sceneName":               o.previewScene.Name,
+		"sceneUuid":               o.previewScene.UUID,
+		"currentPreviewSceneName": o.previewScene.Name,
+		"currentPreviewSceneUuid": o.previewScene.UUID,
+	}, nil
+}
+
+func (o *OBS) setCurrentPreviewScene(p params) (map[string]any, error) {
+	if !o.studioMode {
+		return nil, errStudioModeNotActive()
+	}
+	s, err := o.findScene(p, "scene")
+	if err != nil {
+		return nil, err
+	}
+	if o.previewScene != s {
+		o.previewScene = s
+		o.Emit("CurrentPreviewSceneChanged", obs_grpc.EventSubscription_Scenes, map[string]any{
+			"sceneName": s.Name,
+			"sceneUuid": s.UUID,
+		})
+	}
+	return nil, nil
+}
+
+func (o *OBS) checkSourceNameIsFree(name string) error {
+	if o.sceneByName(name) != nil || o.inputByName(name) != nil {
+		return RequestError{
+			Code:    obs_grpc.RequestStatus_ResourceAlreadyExists,
+			Comment: "A source already exists by that name.",
+		}
+	}
+	return nil
+}
+
+func (o *OBS) createScene(p params) (map[string]any, error) {
+	name, err := p.requireString("sceneName")
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkSourceNameIsFree(name); err != nil {
+		return nil, err
+	}
+	s := o.addScene(name)
+	o.Emit("SceneCreated", obs_grpc.EventSubscription_Scenes, map[string]any{
+		"sceneName": s.Name,
+		"sceneUuid": s.UUID,
+		"isGroup":   false,
+	})
+	return map[string]any{"sceneUuid": s.UUID}, nil
+}
+
+func (o *OBS) removeScene(p params) (map[string]any, error) {
+	s, err := o.findScene(p, "scene")
+	if err != nil {
+		return nil, err
+	}
+	if len(o.scenes) == 1 {
+		return nil, RequestError{
+			Code:    obs_grpc.RequestStatus_NotEnoughResources,
+			Comment: "You cannot remove the last scene in the collection.",
+		}
+	}
+	idx := o.sceneIndex(s)
+	o.scenes = append(o.scenes[:idx], o.scenes[idx+1:]...)
+	if o.programScene == s {
+		o.switchProgramScene(o.scenes[0])
+	}
+	if o.previewScene == s {
+		o.previewScene = o.programScene
+	}
+	o.Emit("SceneRemoved", obs_grpc.EventSubscription_Scenes, map[string]any{
+		"sceneName": s.Name,
+		"sceneUuid": s.UUID,
+		"isGroup":   false,
+	})
+	return nil, nil
+}
+
+func (o *OBS) setSceneName(p params) (map[string]any, error) {
+	s, err := o.findScene(p, "scene")
+	if err != nil {
+		return nil, err
+	}
+	newName, err := p.requireString("newSceneName")
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkSourceNameIsFree(newName); err != nil {
+		return nil, err
+	}
+	oldName := s.Name
+	s.Name = newName
+	o.Emit("SceneNameChanged", obs_grpc.EventSubscription_Scenes, map[string]any{
+		"sceneUuid":    s.UUID,
+		"oldSceneName": oldName,
+		"sceneName":    newName,
+	})
+	return nil, nil
+}
+
+// inputs
+
+func (o *OBS) getInputList(p params) (map[string]any, error) {
+	kind, _, err := p.string("inputKind")
+	if err != nil {
+		return nil, err
+	}
+	inputs := make([]any, 0, len(o.inputs))
+	for _, in := range o.inputs {
+		if kind != "" && in.Kind != kind {
+			continue
+		}
+		inputs = append(inputs, map[string]any{
+			"inputName":            in.Name,
+			"inputUuid":            in.UUID,
+			"inputKind":            in.Kind,
+			"unversionedInputKind": in.Kind,
+		})
+	}
+	return map[string]any{"inputs": inputs}, nil
+}
+
+func (o *OBS) createInput(p params) (map[string]any, error) {
+	s, err := o.findScene(p, "scene")
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.requireString("inputName")
+	if err != nil {
+		return nil, err
+	}
+	kind, err := p.requireString("inputKind")
+	if err != nil {
+		return nil, err
+	}
+	settings, _, err := p.object("inputSettings")
+	if err != nil {
+		return nil, err
+	}
+	enabled, hasEnabled, err := p.bool("sceneItemEnabled")
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkSourceNameIsFree(name); err != nil {
+		return nil, err
+	}
+
+	in := &input{
+		Name:      name,
+		UUID:      o.newUUID(),
+		Kind:      kind,
+		Settings:  cloneMap(settings),
+		VolumeMul: 1,
+	}
+	if in.Settings == nil {
+		in.Settings = map[string]any{}
+	}
+	o.inputs = append(o.inputs, in)
+	o.Emit("InputCreated", obs_grpc.EventSubscription_Inputs, map[string]any{
+		"inputName":            in.Name,
+		"inputUuid":            in.UUID,
+		"inputKind":            in.Kind,
+		"unversionedInputKind": in.Kind,
+		"inputSettings":        cloneMap(in.Settings),
+		"defaultInputSettings": map[string]any{},
+	})
+	item := o.addSceneItem(s, in, !hasEnabled || enabled)
+	return map[string]any{
+		"inputUuid":   in.UUID,
+		"sceneItemId": item.ID,
+	}, nil
+}
+
+func (o *OBS) removeInput(p params) (map[string]any, error) {
+	in, err := o.findInput(p, "input")
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range o.scenes {
+		for idx := len(s.Items) - 1; idx >= 0; idx-- {
+			if s.Items[idx].Input == in {
+				o.deleteSceneItem(s, idx)
+			}
+		}
+	}
+	for idx, candidate := range o.inputs {
+		if candidate == in {
+			o.inputs = append(o.inputs[:idx], o.inputs[idx+1:]...)
+			break
+		}
+	}
+	o.Emit("InputRemoved", obs_grpc.EventSubscription_Inputs, map[string]any{
+		"inputName": in.Name,
+		"inputUuid": in.UUID,
+	})
+	return nil, nil
+}
+
+func (o *OBS) setInputName(p params) (map[string]any, error) {
+	in, err := o.findInput(p, "input")
+	if err != nil {
+		return nil, err
+	}
+	newName, err := p.requireString("newInputName")
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkSourceNameIsFree(newName); err != nil {
+		return nil, err
+	}
+	oldName := in.Name
+	in.Name = newName
+	o.Emit("InputNameChanged", obs_grpc.EventSubscription_Inputs, map[string]any{
+		"inputUuid":    in.UUID,
+		"oldInputName": oldName,
+		"inputName":    newName,
+	})
+	return nil, nil
+}
+
+func (o *OBS) getInputSettings(p params) (map[string]any, error) {
+	in, err := o.findInput(p, "input")
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"inputSettings": cloneMap(in.Settings),
+		"inputKind":     in.Kind,
+	}, nil
+}
+
+func (o *OBS) setInputSettings(p params) (map[string]any, error) {
+	in, err := o.findInput(p, "input")
+	if err != nil {
+		return nil, err
+	}
+	settings, ok, err := p.object("inputSettings")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errMissingField("inputSettings")
+	}
+	overlay, hasOverlay, err := p.bool("overlay")
+	if err != nil {
+		return nil, err
+	}
+	if hasOverlay && !overlay {
+		in.Settings = map[string]any{}
+	}
+	for k, v := range settings {
+		in.Settings[k] = cloneValue(v)
+	}
+	o.Emit("InputSettingsChanged", obs_grpc.EventSubscription_Inputs, map[string]any{
+		"inputName":     in.Name,
+		"inputUuid":     in.UUID,
+		"inputSettings": cloneMap(in.Settings),
+	})
+	return nil, nil
+}
+
+func (o *OBS) getInputMute(p params) (map[string]any, error) {
+	in, err := o.findInput(p, "input")
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"inputMuted": in.Muted}, nil
+}
+
+func (o *OBS) setInputMute(p params) (map[string]any, error) {
+	in, err := o.findInput(p, "input")
+	if err != nil {
+		return nil, err
+	}
+	muted, err := p.requireBool("inputMuted")
+	if err != nil {
+		return nil, err
+	}
+	o.setMuted(in, muted)
+	return nil, nil
+}
+
+func (o *OBS) toggleInputMute(p params) (map[string]any, error) {
+	in, err := o.findInput(p, "input")
+	if err != nil {
+		return nil, err
+	}
+	o.setMuted(in, !in.Muted)
+	return map[string]any{"inputMuted": in.Muted}, nil
+}
+
+func (o *OBS) setMuted(in *input, muted bool) {
+	if in.Muted == muted {
+		return
+	}
+	in.Muted = muted
+	o.Emit("InputMuteStateChanged", obs_grpc.EventSubscription_Inputs, map[string]any{
+		"inputName":  in.Name,
+		"inputUuid":  in.UUID,
+		"inputMuted": muted,
+	})
+}
+
+func (o *OBS) getInputVolume(p params) (map[string]any, error) {
+	in, err := o.findInput(p, "input")
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"inputVolumeMul": in.VolumeMul,
+		"inputVolumeDb":  volumeDB(in.VolumeMul),
+	}, nil
+}
+
+func (o *OBS) setInputVolume(p params) (map[string]any, error) {
+	in, err := o.findInput(p, "input")
+	if err != nil {
+		return nil, err
+	}
+	mul, hasMul, err := p.number("inputVolumeMul")
+	if err != nil {
+		return nil, err
+	}
+	db, hasDB, err := p.number("inputVolumeDb")
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case hasMul && hasDB:
+		return nil, RequestError{
+			Code:    obs_grpc.RequestStatus_TooManyRequestFields,
+			Comment: "You may only specify one volume field.",
+		}
+	case hasMul:
+		if mul < 0 || mul > 20 {
+			return nil, RequestError{
+				Code:    obs_grpc.RequestStatus_RequestFieldOutOfRange,
+				Comment: "The field value of `inputVolumeMul` is out of range [0, 20].",
+			}
+		}
+	case hasDB:
+		if db < -100 || db > 26 {
+			return nil, RequestError{
+				Code:    obs_grpc.RequestStatus_RequestFieldOutOfRange,
+				Comment: "The field value of `inputVolumeDb` is out of range [-100, 26].",
+			}
+		}
+		mul = 0
+		if db > -100 {
+			mul = dbToMul(db)
+		}
+	default:
+		return nil, RequestError{
+			Code:    obs_grpc.RequestStatus_MissingRequestField,
+			Comment: "Your request must contain at least one of the following fields: `inputVolumeMul` or `inputVolumeDb`.",
+		}
+	}
+	in.VolumeMul = mul
+	o.Emit("InputVolumeChanged", obs_grpc.EventSubscription_Inputs, map[string]any{
+		"inputName":      in.Name,
+		"inputUuid":      in.UUID,
+		"inputVolumeMul": in.VolumeMul,
+		"inputVolumeDb":  volumeDB(in.VolumeMul),
+	})
+	return nil, nil
+}
+
+// scene items
+
+func (o *OBS) addSceneItem(s *scene, in *input, enabled bool) *sceneItem {
+	s.lastSceneItemID++
+	item := &sceneItem{
+		ID:      s.lastSceneItemID,
+		Input:   in,
+		Enabled: enabled,
+	}
+	s.Items = append(s.Items, item)
+	o.Emit("SceneItemCreated", obs_grpc.EventSubscription_SceneItems, map[string]any{
+		"sceneName":      s.Name,
+		"sceneUuid":      s.UUID,
+		"sourceName":     in.Name,
+		"sourceUuid":     in.UUID,
+		"sceneItemId":    item.ID,
+		"sceneItemIndex": len(s.Items) - 1,
+	})
+	return item
+}
+
+func (o *OBS) deleteSceneItem(s *scene, idx int) {
+	item := s.Items[idx]
+	s.Items = append(s.Items[:idx], s.Items[idx+1:]...)
+	o.Emit("SceneItemRemoved", obs_grpc.EventSubscription_SceneItems, map[string]any{
+		"sceneName":   s.Name,
+		"sceneUuid":   s.UUID,
+		"sourceName":  item.Input.Name,
+		"sourceUuid":  item.Input.UUID,
+		"sceneItemId": item.ID,
+	})
+}
+
+func (o *OBS) getSceneItemList(p params) (map[string]any, error) {
+	s, err := o.findScene(p, "scene")
+	if err != nil {
+		return nil, err
+	}
+	items := make([]any, 0, len(s.Items))
+	for idx, item := range s.Items {
+		items = append(items, map[string]any{
+			"inputKind":          item.Input.Kind,
+			"isGroup":            false,
+			"sceneItemBlendMode": "OBS_BLEND_NORMAL",
+			"sceneItemEnabled":   item.Enabled,
+			"sceneItemId":        item.ID,
+			"sceneItemIndex":     idx,
+			"sceneItemLocked":    item.Locked,
+			"sourceName":         item.Input.Name,
+			"sourceUuid":         item.Input.UUID,
+			"sourceType":         "OBS_SOURCE_TYPE_INPUT",
+		})
+	}
+	return map[string]any{"sceneItems": items}, nil
+}
+
+func (o *OBS) getSceneItemID(p params) (map[string]any, error) {
+	s, err := o.findScene(p, "scene")
+	if err != nil {
+		return nil, err
+	}
+	sourceName, err := p.requireString("sourceName")
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range s.Items {
+		if item.Input.Name == sourceName {
+			return map[string]any{"sceneItemId": item.ID}, nil
+		}
+	}
+	return nil, RequestError{
+		Code:    obs_grpc.RequestStatus_ResourceNotFound,
+		Comment: "No scene items were found in the specified scene by that name or offset.",
+	}
+}
+
+func (o *OBS) createSceneItem(p params) (map[string]any, error) {
+	s, err := o.findScene(p, "scene")
+	if err != nil {
+		return nil, err
+	}
+	in, err := o.findInput(p, "source")
+	if err != nil {
+		return nil, err
+	}
+	enabled, hasEnabled, err := p.bool("sceneItemEnabled")
+	if err != nil {
+		return nil, err
+	}
+	item := o.addSceneItem(s, in, !hasEnabled || enabled)
+	return map[string]any{"sceneItemId": item.ID}, nil
+}
+
+func (o *OBS) removeSceneItem(p params) (map[string]any, error) {
+	s, _, idx, err := o.findSceneItem(p)
+	if err != nil {
+		return nil, err
+	}
+	o.deleteSceneItem(s, idx)
+	return nil, nil
+}
+
+func (o *OBS) getSceneItemEnabled(p params) (map[string]any, error) {
+	_, item, _, err := o.findSceneItem(p)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"sceneItemEnabled": item.Enabled}, nil
+}
+
+func (o *OBS) setSceneItemEnabled(p params) (map[string]any, error) {
+	s, item, _, err := o.findSceneItem(p)
+	if err != nil {
+		return nil, err
+	}
+	enabled, err := p.requireBool("sceneItemEnabled")
+	if err != nil {
+		return nil, err
+	}
+	if item.Enabled != enabled {
+		item.Enabled = enabled
+		o.Emit("SceneItemEnableStateChanged", obs_grpc.EventSubscription_SceneItems, map[string]any{
+			"sceneName":        s.Name,
+			"sceneUuid":        s.UUID,
+			"sceneItemId":      item.ID,
+			"sceneItemEnabled": enabled,
+		})
+	}
+	return nil, nil
+}
+
+func (o *OBS) getSceneItemLocked(p params) (map[string]any, error) {
+	_, item, _, err := o.findSceneItem(p)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"sceneItemLocked": item.Locked}, nil
+}
+
+func (o *OBS) setSceneItemLocked(p params) (map[string]any, error) {
+	s, item, _, err := o.findSceneItem(p)
+	if err != nil {
+		return nil, err
+	}
+	locked, err := p.requireBool("sceneItemLocked")
+	if err != nil {
+		return nil, err
+	}
+	if item.Locked != locked {
+		item.Locked = locked
+		o.Emit("SceneItemLockStateChanged", obs_grpc.EventSubscription_SceneItems, map[string]any{
+			"sceneName":       s.Name,
+			"sceneUuid":       s.UUID,
+			"sceneItemId":     item.ID,
+			"sceneItemLocked": locked,
+		})
+	}
+	return nil, nil
+}
+
+func (o *OBS) getSceneItemIndex(p params) (map[string]any, error) {
+	_, _, idx, err := o.findSceneItem(p)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"sceneItemIndex": idx}, nil
+}
+
+func (o *OBS) setSceneItemIndex(p params) (map[string]any, error) {
+	s, item, idx, err := o.findSceneItem(p)
+	if err != nil {
+		return nil, err
+	}
+	newIdx, err := p.requireInt("sceneItemIndex")
+	if err != nil {
+		return nil, err
+	}
+	if newIdx < 0 {
+		return nil, RequestError{
+			Code:    obs_grpc.RequestStatus_RequestFieldOutOfRange,
+			Comment: "The field value of `sceneItemIndex` is below the minimum of `0`.",
+		}
+	}
+	if newIdx >= len(s.Items) {
+		newIdx = len(s.Items) - 1
+	}
+	s.Items = append(s.Items[:idx], s.Items[idx+1:]...)
+	s.Items = append(s.Items[:newIdx], append([]*sceneItem{item}, s.Items[newIdx:]...)...)
+
+	sceneItems := make([]any, 0, len(s.Items))
+	for idx, item := range s.Items {
+		sceneItems = append(sceneItems, map[string]any{
+			"sceneItemId":    item.ID,
+			"sceneItemIndex": idx,
+		})
+	}
+	o.Emit("SceneItemListReindexed", obs_grpc.EventSubscription_SceneItems, map[string]any{
+		"sceneName":  s.Name,
+		"sceneUuid":  s.UUID,
+		"sceneItems": sceneItems,
+	})
+	return nil, nil
+}
+
+// outputs
+
+func errOutputRunning() error {
+	return RequestError{
+		Code:    obs_grpc.RequestStatus_OutputRunning,
+		Comment: "The output is already running.",
+	}
+}
+
+func errOutputNotRunning() error {
+	return RequestError{
+		Code:    obs_grpc.RequestStatus_OutputNotRunning,
+		Comment: "The output is not running.",
+	}
+}
+
+// setOutputActive changes the state of the output and emits the event
+// "<eventPrefix>StateChanged" with the extra event data.
+func (o *OBS) setOutputActive(
+	out *output,
+	active bool,
+	eventPrefix string,
+	extraEventData map[string]any,
+) error {
+	if out.Active == active {
+		if active {
+			return errOutputRunning()
+		}
+		return errOutputNotRunning()
+	}
+	out.Active = active
+	out.Paused = false
+	state := outputStateStopped
+	if active {
+		out.StartedAt = time.Now()
+		state = outputStateStarted
+	}
+	eventData := map[string]any{
+		"outputActive": active,
+		"outputState":  state,
+	}
+	for k, v := range extraEventData {
+		eventData[k] = v
+	}
+	o.Emit(eventPrefix+"StateChanged", obs_grpc.EventSubscription_Outputs, eventData)
+	return nil
+}
+
+func (o *OBS) getStreamStatus(p params) (map[string]any, error) {
+	return map[string]any{
+		"outputActive":        o.stream.Active,
+		"outputReconnecting":  false,
+		"outputTimecode":      o.stream.Timecode(),
+		"outputDuration":      o.stream.Duration().Milliseconds(),
+		"outputCongestion":    0.0,
+		"outputBytes":         0,
+		"outputSkippedFrames": 0,
+		"outputTotalFrames":   0,
+	}, nil
+}
+
+func (o *OBS) startStream(p params) (map[string]any, error) {
+	return nil, o.setOutputActive(&o.stream, true, "Stream", nil)
+}
+
+func (o *OBS) stopStream(p params) (map[string]any, error) {
+	return nil, o.setOutputActive(&o.stream, false, "Stream", nil)
+}
+
+func (o *OBS) toggleStream(p params) (map[string]any, error) {
+	if err := o.setOutputActive(&o.stream, !o.stream.Active, "Stream", nil); err != nil {
+		return nil, err
+	}
+	return map[string]any{"outputActive": o.stream.Active}, nil
+}
+
+// recordPath returns the path of the file "recorded" since the start.
+func (o *OBS) recordPath() string {
+	return path.Join("/obsfake", o.record.StartedAt.Format("2006-01-02 15-04-05")+".mkv")
+}
+
+func (o *OBS) getRecordStatus(p params) (map[string]any, error) {
+	return map[string]any{
+		"outputActive":   o.record.Active,
+		"outputPaused":   o.record.Paused,
+		"outputTimecode": o.record.Timecode(),
+		"outputDuration": o.record.Duration().Milliseconds(),
+		"outputBytes":    0,
+	}, nil
+}
+
+func (o *OBS) startRecord(p params) (map[string]any, error) {
+	return nil, o.setOutputActive(&o.record, true, "Record", map[string]any{"outputPath": nil})
+}
+
+func (o *OBS) stopRecord(p params) (map[string]any, error) {
+	outputPath := o.recordPath()
+	if err := o.setOutputActive(&o.record, false, "Record", map[string]any{"outputPath": outputPath}); err != nil {
+		return nil, err
+	}
+	return map[string]any{"outputPath": outputPath}, nil
+}
+
+func (o *OBS) toggleRecord(p params) (map[string]any, error) {
+	var err error
+	if o.record.Active {
+		_, err = o.stopRecord(p)
+	} else {
+		_, err = o.startRecord(p)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"outputActive": o.record.Active}, nil
+}
+
+func (o *OBS) setRecordPaused(paused bool) error {
+	switch {
+	case !o.record.Active:
+		return errOutputNotRunning()
+	case o.record.Paused && paused:
+		return RequestError{
+			Code:    obs_grpc.RequestStatus_OutputPaused,
+			Comment: "The record output is already paused.",
+		}
+	case !o.record.Paused && !paused:
+		return RequestError{
+			Code:    obs_grpc.RequestStatus_OutputNotPaused,
+			Comment: "The record output is not paused.",
+		}
+	}
+	o.record.Paused = paused
+	state := outputStateResumed
+	if paused {
+		state = outputStatePaused
+	}
+	o.Emit("RecordStateChanged", obs_grpc.EventSubscription_Outputs, map[string]any{
+		"outputActive": true,
+		"outputState":  state,
+		"outputPath":   nil,
+	})
+	return nil
+}
+
+func (o *OBS) pauseRecord(p params) (map[string]any, error) {
+	return nil, o.setRecordPaused(true)
+}
+
+func (o *OBS) resumeRecord(p params) (map[string]any, error) {
+	return nil, o.setRecordPaused(false)
+}
+
+func (o *OBS) toggleRecordPause(p params) (map[string]any, error) {
+	return nil, o.setRecordPaused(!o.record.Paused)
+}
+
+func (o *OBS) getVirtualCamStatus(p params) (map[string]any, error) {
+	return map[string]any{"outputActive": o.virtualCam.Active}, nil
+}
+
+func (o *OBS) startVirtualCam(p params) (map[string]any, error) {
+	return nil, o.setOutputActive(&o.virtualCam, true, "Virtualcam", nil)
+}
+
+func (o *OBS) stopVirtualCam(p params) (map[string]any, error) {
+	return nil, o.setOutputActive(&o.virtualCam, false, "Virtualcam", nil)
+}
+
+func (o *OBS) toggleVirtualCam(p params) (map[string]any, error) {
+	if err := o.setOutputActive(&o.virtualCam, !o.virtualCam.Active, "Virtualcam", nil); err != nil {
+		return nil, err
+	}
+	return map[string]any{"outputActive": o.virtualCam.Active}, nil
+}
+
+func (o *OBS) getReplayBufferStatus(p params) (map[string]any, error) {
+	return map[string]any{"outputActive": o.replayBuffer.Active}, nil
+}
+
+func (o *OBS) startReplayBuffer(p params) (map[string]any, error) {
+	return nil, o.setOutputActive(&o.replayBuffer, true, "ReplayBuffer", nil)
+}
+
+func (o *OBS) stopReplayBuffer(p params) (map[string]any, error) {
+	return nil, o.setOutputActive(&o.replayBuffer, false, "ReplayBuffer", nil)
+}
+
+func (o *OBS) toggleReplayBuffer(p params) (map[string]any, error) {
+	if err := o.setOutputActive(&o.replayBuffer, !o.replayBuffer.Active, "ReplayBuffer", nil); err != nil {
+		return nil, err
+	}
+	return map[string]any{"outputActive": o.replayBuffer.Active}, nil
+}
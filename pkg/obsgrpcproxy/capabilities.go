@@ -0,0 +1,191 @@
+package obsgrpcproxy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	goobs "github.com/andreykaipov/goobs"
+	"github.com/andreykaipov/goobs/api/requests/general"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type requestMetadataT struct {
+	Category       string
+	InitialVersion string
+	RPCVersion     int64
+	Deprecated     bool
+}
+
+// obsCapabilities is what the connected OBS reported by GetVersion.
+type obsCapabilities struct {
+	OBSVersion          string
+	OBSWebSocketVersion string
+	RPCVersion          int64
+	AvailableRequests   map[string]struct{}
+}
+
+func getOBSCapabilities(
+	ctx context.Context,
+	client *goobs.Client,
+) (*obsCapabilities, error) {
+	var version *general.GetVersionResponse
+	err := callWithContext(ctx, func() error {
+		var err error
+		version, err = client.General.GetVersion()
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the version of OBS: %w", err)
+	}
+	result := &obsCapabilities{
+		OBSVersion:          version.ObsVersion,
+		OBSWebSocketVersion: version.ObsWebSocketVersion,
+		RPCVersion:          int64(version.RpcVersion),
+		AvailableRequests:   make(map[string]struct{}, len(version.AvailableRequests)),
+	}
+	for _, requestType := range version.AvailableRequests {
+		result.AvailableRequests[requestType] = struct{}{}
+	}
+	return result, nil
+}
+
+// requestAvailability returns if the request is supported by OBS, and
+// the reason if it is not. The requests not described by protocol.json
+// (like ExecuteRequestSequence) are always available, and so is GetVersion,
+// since it is what the capabilities are negotiated with.
+func (caps *obsCapabilities) requestAvailability(requestType string) (bool, string) {
+	metadata, ok := requestMetadata[requestType]
+	if caps == nil || !ok || requestType == "GetVersion" {
+		return true, ""
+	}
+	if len(caps.AvailableRequests) > 0 {
+		if _, ok := caps.AvailableRequests[requestType]; !ok {
+			return false, fmt.Sprintf("obs-websocket %s does not provide it (it was added in %s)", caps.OBSWebSocketVersion, metadata.InitialVersion)
+		}
+		return true, ""
+	}
+	if compareVersions(caps.OBSWebSocketVersion, metadata.InitialVersion) < 0 {
+		return false, fmt.Sprintf("it was added in obs-websocket %s, but OBS runs %s", metadata.InitialVersion, caps.OBSWebSocketVersion)
+	}
+	if caps.RPCVersion < metadata.RPCVersion {
+		return false, fmt.Sprintf("it requires RPC version %d, but %d was negotiated", metadata.RPCVersion, caps.RPCVersion)
+	}
+	return true, ""
+}
+
+// compareVersions compares dot-separated versions (like "5.4.2"), the parts
+// which are not numbers are compared as strings, the missing parts are zeros.
+func compareVersions(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for idx := 0; idx < len(aParts) || idx < len(bParts); idx++ {
+		aPart, bPart := "0", "0"
+		if idx < len(aParts) {
+			aPart = aParts[idx]
+		}
+		if idx < len(bParts) {
+			bPart = bParts[idx]
+		}
+		aNum, aErr := strconv.ParseInt(aPart, 10, 64)
+		bNum, bErr := strconv.ParseInt(bPart, 10, 64)
+		switch {
+		case aErr == nil && bErr == nil && aNum != bNum:
+			if aNum < bNum {
+				return -1
+			}
+			return 1
+		case (aErr != nil || bErr != nil) && aPart != bPart:
+			return strings.Compare(aPart, bPart)
+		}
+	}
+	return 0
+}
+
+func (proxy *Proxy) getCapabilities() *obsCapabilities {
+	proxy.clientLocker.Lock()
+	defer proxy.clientLocker.Unlock()
+	return proxy.capabilities
+}
+
+// checkRequestAvailable returns an error with code Unimplemented if
+// the connected OBS does not support the request.
+func (proxy *Proxy) checkRequestAvailable(requestType string) error {
+	available, reason := proxy.getCapabilities().requestAvailability(requestType)
+	if available {
+		return nil
+	}
+	return status.Errorf(codes.Unimplemented, "request %s is not supported by the connected OBS: %s", requestType, reason)
+}
+
+// GetProxyCapabilities reports the versions of the connected OBS and
+// which requests it supports.
+func (proxy *Proxy) GetProxyCapabilities(
+	ctx context.Context,
+	req *obs_grpc.GetProxyCapabilitiesRequest,
+) (_ret *obs_grpc.GetProxyCapabilitiesResponse, _err error) {
+	logger.Tracef(ctx, "GetProxyCapabilities")
+	defer func() {
+		_err = proxy.afterRequest(ctx, "GetProxyCapabilities", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
+		logger.Tracef(ctx, "/GetProxyCapabilities: %v", _err)
+	}()
+	if err := proxy.beforeRequest(ctx, "GetProxyCapabilities", req); err != nil {
+		return nil, err
+	}
+
+	if _, err := proxy.getClient(ctx); err != nil {
+		return nil, fmt.Errorf("unable to get a client: %w", err)
+	}
+	caps := proxy.getCapabilities()
+	if caps == nil {
+		return nil, status.Errorf(codes.Unavailable, "the capabilities of OBS are unknown (GetVersion failed)")
+	}
+
+	result := &obs_grpc.GetProxyCapabilitiesResponse{
+		ObsVersion:          caps.OBSVersion,
+		ObsWebSocketVersion: caps.OBSWebSocketVersion,
+		RpcVersion:          caps.RPCVersion,
+	}
+	for requestType, metadata := range requestMetadata {
+		available, _ := caps.requestAvailability(requestType)
+		result.Requests = append(result.Requests, &obs_grpc.RequestCapability{
+			RequestType:    requestType,
+			Available:      available,
+			InitialVersion: metadata.InitialVersion,
+			RpcVersion:     metadata.RPCVersion,
+		})
+	}
+	sort.Slice(result.Requests, func(i, j int) bool {
+		return result.Requests[i].RequestType < result.Requests[j].RequestType
+	})
+	for requestType := range caps.AvailableRequests {
+		if _, ok := requestMetadata[requestType]; !ok {
+			result.UnsupportedRequests = append(result.UnsupportedRequests, requestType)
+		}
+	}
+	sort.Strings(result.UnsupportedRequests)
+	return result, nil
+}
+
+func (p *ProxyAsClient) GetProxyCapabilities(
+	ctx context.Context,
+	req *obs_grpc.GetProxyCapabilitiesRequest,
+	opts ...grpc.CallOption,
+) (*obs_grpc.GetProxyCapabilitiesResponse, error) {
+	return (*Proxy)(p).GetProxyCapabilities(ctx, req)
+}
+
+func (p *ClientAsServer) GetProxyCapabilities(
+	ctx context.Context,
+	req *obs_grpc.GetProxyCapabilitiesRequest,
+) (*obs_grpc.GetProxyCapabilitiesResponse, error) {
+	return p.OBSClient.GetProxyCapabilities(ctx, req)
+}
@@ -0,0 +1,39 @@
+package obsgrpcproxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareVersions(t *testing.T) {
+	require.Equal(t, 0, compareVersions("5.4.0", "5.4.0"))
+	require.Equal(t, 0, compareVersions("5.4", "5.4.0"))
+	require.Equal(t, -1, compareVersions("5.3.2", "5.4.0"))
+	require.Equal(t, 1, compareVersions("5.10.0", "5.9.1"))
+	require.Equal(t, 1, compareVersions("6", "5.5.0"))
+	require.Equal(t, -1, compareVersions("5.5.0-beta", "5.5.0-rc"))
+}
+
+func TestRequestAvailability(t *testing.T) {
+	var caps *obsCapabilities
+	available, _ := caps.requestAvailability("CreateRecordChapter")
+	require.True(t, available, "the capabilities are unknown")
+
+	caps = &obsCapabilities{OBSWebSocketVersion: "5.4.2", RPCVersion: 1}
+	available, _ = caps.requestAvailability("GetSceneItemSource")
+	require.True(t, available)
+	available, reason := caps.requestAvailability("CreateRecordChapter")
+	require.False(t, available)
+	require.Contains(t, reason, "5.5.0")
+	available, _ = caps.requestAvailability("ExecuteRequestSequence")
+	require.True(t, available)
+
+	caps.AvailableRequests = map[string]struct{}{"CreateRecordChapter": {}}
+	available, _ = caps.requestAvailability("CreateRecordChapter")
+	require.True(t, available)
+	available, _ = caps.requestAvailability("GetSceneItemSource")
+	require.False(t, available)
+	available, _ = caps.requestAvailability("GetVersion")
+	require.True(t, available)
+}
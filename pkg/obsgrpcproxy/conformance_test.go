@@ -0,0 +1,91 @@
+package obsgrpcproxy_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsfake"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsgrpcproxy"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// conformanceTest is a test case generated by obsproxygen: Request is sent
+// through Proxy to a fake OBS, which is expected to receive RequestData and
+// replies with ResponseData, which is expected to be converted to Response.
+type conformanceTest struct {
+	RequestType  string
+	Request      proto.Message
+	RequestData  map[string]any
+	ResponseData map[string]any
+	Response     proto.Message
+
+	// KnownIssue is the reason the test is expected to fail; the test
+	// fails if it does not (so that the fixed issues are noticed).
+	KnownIssue string
+}
+
+func TestConformance(t *testing.T) {
+	for _, tc := range conformanceTests {
+		tc := tc
+		t.Run(tc.RequestType, func(t *testing.T) {
+			t.Parallel()
+			err := testConformance(t, tc)
+			if tc.KnownIssue == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err, "the known issue is not reproduced anymore (remove it from knownIssues of obsproxygen): %s", tc.KnownIssue)
+			t.Logf("known issue: %s: %v", tc.KnownIssue, err)
+		})
+	}
+}
+
+// testConformance returns an error if the request or the response are
+// converted differently than expected.
+func testConformance(t *testing.T, tc conformanceTest) error {
+	ctx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelFn()
+
+	fake := obsfake.New()
+	fake.HandleRequest(tc.RequestType, func(ctx context.Context, requestData map[string]any) (map[string]any, error) {
+		return tc.ResponseData, nil
+	})
+	proxy := newTestProxy(t, ctx, fake)
+
+	method := obsgrpcproxy.FindMethod(tc.RequestType)
+	require.NotNil(t, method)
+	resp, err := method.Handler(proxy, ctx, func(in any) error {
+		proto.Merge(in.(proto.Message), tc.Request)
+		return nil
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("the request failed: %w", err)
+	}
+
+	var (
+		requestData map[string]any
+		sent        bool
+	)
+	for _, req := range fake.Requests() {
+		if req.RequestType == tc.RequestType {
+			requestData, sent = req.RequestData, true
+		}
+	}
+	if !sent {
+		return fmt.Errorf("the request was not sent to OBS")
+	}
+	if (len(tc.RequestData) > 0 || len(requestData) > 0) && !reflect.DeepEqual(tc.RequestData, requestData) {
+		return fmt.Errorf("unexpected request data:\nexpected: %#v\nactual:   %#v", tc.RequestData, requestData)
+	}
+
+	opts := protojson.MarshalOptions{Multiline: true}
+	if expected, actual := opts.Format(tc.Response), opts.Format(resp.(proto.Message)); expected != actual {
+		return fmt.Errorf("unexpected response:\nexpected: %s\nactual:   %s", expected, actual)
+	}
+	return nil
+}
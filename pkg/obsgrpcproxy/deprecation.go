@@ -0,0 +1,58 @@
+package obsgrpcproxy
+
+import (
+	"context"
+	"net"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"google.golang.org/grpc/peer"
+)
+
+type deprecationWarnedKey struct {
+	Client      string
+	RequestType string
+}
+
+// IsDeprecatedRequest returns true if the request is deprecated by
+// obs-websocket (and thus may be removed by the next versions).
+func IsDeprecatedRequest(requestType string) bool {
+	return requestMetadata[requestType].Deprecated
+}
+
+// observeDeprecatedRequest reports a call of a deprecated request to
+// the hooks, and logs a warning once per client and request type.
+func (proxy *Proxy) observeDeprecatedRequest(
+	ctx context.Context,
+	requestType string,
+) {
+	if !IsDeprecatedRequest(requestType) {
+		return
+	}
+	for _, hook := range proxy.config.DeprecationHooks {
+		hook.ProcessDeprecatedRequest(ctx, requestType)
+	}
+
+	key := deprecationWarnedKey{
+		Client:      clientAddr(ctx),
+		RequestType: requestType,
+	}
+	if _, warned := proxy.deprecationWarned.LoadOrStore(key, struct{}{}); warned {
+		return
+	}
+	logger.Warnf(ctx, "client %s called request %s, which is deprecated by obs-websocket", key.Client, requestType)
+}
+
+// clientAddr returns the address of the gRPC client (without the port, since
+// it changes on every connection), or "local" if the call is not received
+// through gRPC.
+func clientAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "local"
+	}
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
@@ -0,0 +1,59 @@
+package obsgrpcproxy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/andreykaipov/goobs"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/peer"
+)
+
+type deprecationHookCounter map[string]int
+
+func (c deprecationHookCounter) ProcessDeprecatedRequest(_ context.Context, requestType string) {
+	c[requestType]++
+}
+
+func TestObserveDeprecatedRequest(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	orig := requestMetadata["GetStats"]
+	deprecated := orig
+	deprecated.Deprecated = true
+	requestMetadata["GetStats"] = deprecated
+	t.Cleanup(func() { requestMetadata["GetStats"] = orig })
+
+	counter := deprecationHookCounter{}
+	proxy := New(
+		ctx,
+		func(ctx context.Context) (*goobs.Client, context.CancelFunc, error) {
+			return nil, nil, fmt.Errorf("no OBS in tests")
+		},
+		OptionDeprecationHook{DeprecationHook: counter},
+	)
+
+	clientCtx := func(addr string) context.Context {
+		return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 50000}})
+	}
+	proxy.observeDeprecatedRequest(clientCtx("192.0.2.1"), "GetStats")
+	proxy.observeDeprecatedRequest(clientCtx("192.0.2.1"), "GetStats")
+	proxy.observeDeprecatedRequest(clientCtx("192.0.2.2"), "GetStats")
+	proxy.observeDeprecatedRequest(ctx, "GetStats")
+	proxy.observeDeprecatedRequest(ctx, "GetVersion")
+	require.Equal(t, deprecationHookCounter{"GetStats": 4}, counter)
+
+	var warned []deprecationWarnedKey
+	proxy.deprecationWarned.Range(func(key, _ any) bool {
+		warned = append(warned, key.(deprecationWarnedKey))
+		return true
+	})
+	require.ElementsMatch(t, []deprecationWarnedKey{
+		{Client: "192.0.2.1", RequestType: "GetStats"},
+		{Client: "192.0.2.2", RequestType: "GetStats"},
+		{Client: "local", RequestType: "GetStats"},
+	}, warned)
+}
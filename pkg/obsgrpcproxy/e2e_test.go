@@ -0,0 +1,338 @@
+package obsgrpcproxy_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andreykaipov/goobs"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsfake"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsgrpcproxy"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// newTestProxy returns a Proxy connected to a fake OBS.
+func newTestProxy(t *testing.T, ctx context.Context, fake *obsfake.OBS, opts ...obsgrpcproxy.Option) *obsgrpcproxy.Proxy {
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	addr := strings.TrimPrefix(srv.URL, "http://")
+	return obsgrpcproxy.New(
+		ctx,
+		func(ctx context.Context) (*goobs.Client, context.CancelFunc, error) {
+			client, err := goobs.New(addr, obsgrpcproxy.GoOBSOptions(opts...)...)
+			if err != nil {
+				return nil, nil, err
+			}
+			return client, func() { client.Disconnect() }, nil
+		},
+		opts...,
+	)
+}
+
+func TestProxyEndToEnd(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+	proxy := newTestProxy(t, ctx, obsfake.New())
+
+	streamCtx, streamCancelFn := context.WithTimeout(ctx, 10*time.Second)
+	defer streamCancelFn()
+	stream, err := (*obsgrpcproxy.ProxyAsClient)(proxy).SubscribeToEvents(streamCtx, &obs_grpc.SubscribeToEventsRequest{})
+	require.NoError(t, err)
+
+	_, err = proxy.CreateScene(ctx, &obs_grpc.CreateSceneRequest{SceneName: "Live"})
+	require.NoError(t, err)
+	_, err = proxy.SetCurrentProgramScene(ctx, &obs_grpc.SetCurrentProgramSceneRequest{Scene: &obs_grpc.SetCurrentProgramSceneRequest_SceneName{SceneName: "Live"}})
+	require.NoError(t, err)
+	sceneList, err := proxy.GetSceneList(ctx, &obs_grpc.GetSceneListRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "Live", sceneList.GetCurrentProgramSceneName())
+	require.Len(t, sceneList.GetScenes(), 2)
+
+	input, err := proxy.CreateInput(ctx, &obs_grpc.CreateInputRequest{
+		Scene:     &obs_grpc.CreateInputRequest_SceneName{SceneName: "Live"},
+		InputName: "Mic",
+		InputKind: "pulse_input_capture",
+		InputSettings: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+			"device_id": {Union: &obs_grpc.Any_String_{String_: []byte("default")}},
+		}},
+	})
+	require.NoError(t, err)
+	settings, err := proxy.GetInputSettings(ctx, &obs_grpc.GetInputSettingsRequest{Input: &obs_grpc.GetInputSettingsRequest_InputName{InputName: "Mic"}})
+	require.NoError(t, err)
+	require.Equal(t, []byte("default"), settings.GetInputSettings().GetFields()["device_id"].GetString_())
+
+	_, err = proxy.SetSceneItemEnabled(ctx, &obs_grpc.SetSceneItemEnabledRequest{
+		Scene:            &obs_grpc.SetSceneItemEnabledRequest_SceneName{SceneName: "Live"},
+		SceneItemID:      input.GetSceneItemID(),
+		SceneItemEnabled: false,
+	})
+	require.NoError(t, err)
+	enabled, err := proxy.GetSceneItemEnabled(ctx, &obs_grpc.GetSceneItemEnabledRequest{
+		Scene:       &obs_grpc.GetSceneItemEnabledRequest_SceneName{SceneName: "Live"},
+		SceneItemID: input.GetSceneItemID(),
+	})
+	require.NoError(t, err)
+	require.False(t, enabled.GetSceneItemEnabled())
+
+	_, err = proxy.GetInputMute(ctx, &obs_grpc.GetInputMuteRequest{Input: &obs_grpc.GetInputMuteRequest_InputName{InputName: "Camera"}})
+	code, ok := obsgrpcproxy.RequestStatusFromError(err)
+	require.True(t, ok)
+	require.Equal(t, obs_grpc.RequestStatus_ResourceNotFound, code)
+
+	_, err = proxy.StartStream(ctx, &obs_grpc.StartStreamRequest{})
+	require.NoError(t, err)
+	streamStatus, err := proxy.GetStreamStatus(ctx, &obs_grpc.GetStreamStatusRequest{})
+	require.NoError(t, err)
+	require.True(t, streamStatus.GetOutputActive())
+
+	var eventTypes []string
+	for len(eventTypes) < 6 {
+		ev, err := stream.Recv()
+		require.NoError(t, err)
+		eventTypes = append(eventTypes, ev.GetEventType())
+	}
+	require.Equal(t, []string{
+		"SceneCreated",
+		"CurrentProgramSceneChanged",
+		"InputCreated",
+		"SceneItemCreated",
+		"SceneItemEnableStateChanged",
+		"StreamStateChanged",
+	}, eventTypes)
+}
+
+func TestProxyTracePropagation(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	prevTracerProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(tracerProvider)
+	defer otel.SetTracerProvider(prevTracerProvider)
+	propagator := propagation.TraceContext{}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler(
+		otelgrpc.WithTracerProvider(tracerProvider),
+		otelgrpc.WithPropagators(propagator),
+	)))
+	obs_grpc.RegisterOBSServer(grpcServer, newTestProxy(t, ctx, obsfake.New()))
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(
+		listener.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler(
+			otelgrpc.WithTracerProvider(tracerProvider),
+			otelgrpc.WithPropagators(propagator),
+		)),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	callCtx, root := tracerProvider.Tracer("test").Start(ctx, "root")
+	_, err = obs_grpc.NewOBSClient(conn).GetSceneList(callCtx, &obs_grpc.GetSceneListRequest{})
+	require.NoError(t, err)
+	root.End()
+
+	spans := map[string]tracetest.SpanStub{}
+	for _, span := range exporter.GetSpans() {
+		spans[span.Name+"/"+span.SpanKind.String()] = span
+	}
+	clientSpan := spans["OBS/GetSceneList/"+trace.SpanKindClient.String()]
+	serverSpan := spans["OBS/GetSceneList/"+trace.SpanKindServer.String()]
+	obsSpan := spans["obs-websocket/GetSceneList/"+trace.SpanKindClient.String()]
+
+	// the trace continues from the client via the proxy to obs-websocket
+	require.Equal(t, root.SpanContext().TraceID(), obsSpan.SpanContext.TraceID())
+	require.Equal(t, root.SpanContext().SpanID(), clientSpan.Parent.SpanID())
+	require.Equal(t, clientSpan.SpanContext.SpanID(), serverSpan.Parent.SpanID())
+	require.Equal(t, serverSpan.SpanContext.SpanID(), obsSpan.Parent.SpanID())
+	require.Contains(t, serverSpan.Attributes, attribute.String("obs.request_type", "GetSceneList"))
+	require.Contains(t, obsSpan.Attributes, attribute.String("obs.request_status", "Success"))
+}
+
+func ptr[T any](in T) *T {
+	return &in
+}
+
+func TestProxyCapabilities(t *testing.T) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelFn()
+	fake := obsfake.New(obsfake.OptionVersion{OBSWebSocketVersion: "5.4.2"})
+	proxy := newTestProxy(t, ctx, fake)
+
+	caps, err := proxy.GetProxyCapabilities(ctx, &obs_grpc.GetProxyCapabilitiesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "5.4.2", caps.GetObsWebSocketVersion())
+	require.Equal(t, int64(1), caps.GetRpcVersion())
+	availability := map[string]bool{}
+	for _, request := range caps.GetRequests() {
+		availability[request.GetRequestType()] = request.GetAvailable()
+	}
+	require.True(t, availability["CreateScene"])
+	require.False(t, availability["CreateRecordChapter"])
+
+	// the fake OBS does not provide CreateRecordChapter, so the request
+	// should be rejected without reaching OBS
+	_, err = proxy.CreateRecordChapter(ctx, &obs_grpc.CreateRecordChapterRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+	for _, req := range fake.Requests() {
+		require.NotEqual(t, "CreateRecordChapter", req.RequestType)
+	}
+}
+
+func TestProxyRequestTimeouts(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	fake := obsfake.New()
+	delayFn := func(ctx context.Context, requestData map[string]any) (map[string]any, error) {
+		time.Sleep(500 * time.Millisecond)
+		return map[string]any{}, nil
+	}
+	fake.HandleRequest("Sleep", delayFn)
+	fake.HandleRequest("GetStats", delayFn)
+	opts := []obsgrpcproxy.Option{
+		obsgrpcproxy.OptionDefaultRequestTimeout(100 * time.Millisecond),
+		obsgrpcproxy.OptionRequestTimeout{RequestType: "Sleep", Timeout: 5 * time.Second},
+	}
+	client := (*obsgrpcproxy.ProxyAsClient)(newTestProxy(t, ctx, fake, opts...))
+
+	// the timeout of goobs does not cut the longer timeouts
+	_, err := client.Sleep(ctx, &obs_grpc.SleepRequest{SleepMillis: ptr(int64(500))})
+	require.NoError(t, err)
+
+	_, err = client.GetStats(ctx, &obs_grpc.GetStatsRequest{})
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
+
+func TestGoOBSOptions(t *testing.T) {
+	fake := obsfake.New()
+	fake.HandleRequest("GetStats", func(ctx context.Context, requestData map[string]any) (map[string]any, error) {
+		time.Sleep(time.Second)
+		return map[string]any{}, nil
+	})
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	client, err := goobs.New(
+		strings.TrimPrefix(srv.URL, "http://"),
+		obsgrpcproxy.GoOBSOptions(
+			obsgrpcproxy.OptionDefaultRequestTimeout(100*time.Millisecond),
+			obsgrpcproxy.OptionRequestTimeout{RequestType: "Sleep", Timeout: 200 * time.Millisecond},
+		)...,
+	)
+	require.NoError(t, err)
+	defer client.Disconnect()
+
+	startTS := time.Now()
+	_, err = client.General.GetStats()
+	require.Error(t, err)
+	require.Less(t, time.Since(startTS), 900*time.Millisecond)
+}
+
+type requestHookFuncs struct {
+	before func(ctx context.Context, methodName string, req proto.Message) error
+	after  func(ctx context.Context, methodName string, req proto.Message, resp proto.Message, err error) error
+}
+
+func (h requestHookFuncs) BeforeRequest(ctx context.Context, methodName string, req proto.Message) error {
+	return h.before(ctx, methodName, req)
+}
+
+func (h requestHookFuncs) AfterRequest(ctx context.Context, methodName string, req proto.Message, resp proto.Message, err error) error {
+	return h.after(ctx, methodName, req, resp, err)
+}
+
+func TestProxyRequestHookMutates(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	errReplaced := errors.New("replaced")
+	fake := obsfake.New()
+	proxy := newTestProxy(t, ctx, fake, obsgrpcproxy.OptionRequestHook{RequestHook: requestHookFuncs{
+		before: func(ctx context.Context, methodName string, req proto.Message) error {
+			// redirect to the backup scene
+			if req, ok := req.(*obs_grpc.SetCurrentProgramSceneRequest); ok && req.GetSceneName() == "Live" {
+				req.Scene = &obs_grpc.SetCurrentProgramSceneRequest_SceneName{SceneName: "Backup"}
+			}
+			return nil
+		},
+		after: func(ctx context.Context, methodName string, req proto.Message, resp proto.Message, err error) error {
+			if resp, ok := resp.(*obs_grpc.GetCurrentProgramSceneResponse); ok {
+				resp.SceneName = "[on air] " + resp.SceneName
+			}
+			if methodName == "SetCurrentProgramScene" && err != nil {
+				return errReplaced
+			}
+			return err
+		},
+	}})
+
+	for _, sceneName := range []string{"Live", "Backup"} {
+		_, err := proxy.CreateScene(ctx, &obs_grpc.CreateSceneRequest{SceneName: sceneName})
+		require.NoError(t, err)
+	}
+
+	// the request modified by BeforeRequest reaches OBS
+	_, err := proxy.SetCurrentProgramScene(ctx, &obs_grpc.SetCurrentProgramSceneRequest{Scene: &obs_grpc.SetCurrentProgramSceneRequest_SceneName{SceneName: "Live"}})
+	require.NoError(t, err)
+	requests := fake.Requests()
+	require.Equal(t, "SetCurrentProgramScene", requests[len(requests)-1].RequestType)
+	require.Equal(t, "Backup", requests[len(requests)-1].RequestData["sceneName"])
+
+	// the response modified by AfterRequest reaches the caller
+	resp, err := proxy.GetCurrentProgramScene(ctx, &obs_grpc.GetCurrentProgramSceneRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "[on air] Backup", resp.GetSceneName())
+
+	// and so does the error replaced by AfterRequest
+	_, err = proxy.SetCurrentProgramScene(ctx, &obs_grpc.SetCurrentProgramSceneRequest{Scene: &obs_grpc.SetCurrentProgramSceneRequest_SceneName{SceneName: "NoSuchScene"}})
+	require.ErrorIs(t, err, errReplaced)
+}
+
+func TestProxyCapabilitiesCallerCanceled(t *testing.T) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelFn()
+	fake := obsfake.New(obsfake.OptionVersion{OBSWebSocketVersion: "5.4.2"})
+	fake.HandleRequest("GetVersion", func(ctx context.Context, requestData map[string]any) (map[string]any, error) {
+		time.Sleep(100 * time.Millisecond)
+		return map[string]any{
+			"obsWebSocketVersion": "5.4.2",
+			"rpcVersion":          1,
+			"availableRequests":   []any{"CreateScene"},
+		}, nil
+	})
+	proxy := newTestProxy(t, ctx, fake)
+
+	// the first caller gives up before the capabilities are received
+	shortCtx, shortCancelFn := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer shortCancelFn()
+	_, err := proxy.CreateScene(shortCtx, &obs_grpc.CreateSceneRequest{SceneName: "Live"})
+	require.Error(t, err)
+
+	// but the capabilities are still known for the connection
+	_, err = proxy.CreateRecordChapter(ctx, &obs_grpc.CreateRecordChapterRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
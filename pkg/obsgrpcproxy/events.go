@@ -0,0 +1,353 @@
+package obsgrpcproxy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type eventMetadataT struct {
+	Category     string
+	Subscription obs_grpc.EventSubscription
+}
+
+const eventSubscriberBufferSize = 1024
+
+// EventGo2Protobuf converts an event received from goobs
+// (for example *events.StreamStateChanged). The returned envelope has no
+// sequence number or timestamp set.
+//
+// If the payload of the event cannot be converted, then the envelope is still
+// returned (without the payload) together with the error.
+func EventGo2Protobuf(ev any) (*obs_grpc.EventEnvelope, error) {
+	t := reflect.TypeOf(ev)
+	if t == nil {
+		return nil, fmt.Errorf("the event is nil")
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	eventType := t.Name()
+
+	result := &obs_grpc.EventEnvelope{
+		EventType: eventType,
+	}
+	if metadata, ok := eventMetadata[eventType]; ok {
+		result.EventCategory = metadata.Category
+		result.EventSubscription = metadata.Subscription
+	}
+
+	fd := eventEnvelopeUnionField(eventType)
+	if fd == nil {
+		return result, fmt.Errorf("unknown event type '%s'", eventType)
+	}
+
+	msg := result.ProtoReflect()
+	payload := msg.NewField(fd)
+	if err := GoOBSStruct2Protobuf(ev, payload.Message()); err != nil {
+		return result, fmt.Errorf("unable to convert the payload of event '%s': %w", eventType, err)
+	}
+	msg.Set(fd, payload)
+	return result, nil
+}
+
+func eventEnvelopeUnionField(eventType string) protoreflect.FieldDescriptor {
+	fields := (*obs_grpc.EventEnvelope)(nil).ProtoReflect().Descriptor().Oneofs().ByName("Union").Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if string(fd.Message().Name()) == "Event"+eventType {
+			return fd
+		}
+	}
+	return nil
+}
+
+type eventSubscriber struct {
+	ch chan *obs_grpc.EventEnvelope
+
+	// missedFromSeqNo is the first event which did not fit into ch (the
+	// subscriber is too slow); the later events are not sent to ch either,
+	// until the subscriber catches up (see eventBus.catchUp).
+	//
+	// Protected by eventBus.locker.
+	missedFromSeqNo uint64
+}
+
+// eventBus delivers the events to the subscribers and keeps a bounded
+// history of them, so that a subscriber could catch up. The subscribers
+// share the events, so the events must not be modified after they are
+// published (the in-process subscribers receive copies, see localStream).
+type eventBus struct {
+	locker           sync.Mutex
+	maxCount         int
+	maxAge           time.Duration
+	lastSeqNo        uint64
+	history          []*obs_grpc.EventEnvelope
+	lastDroppedSeqNo uint64
+	lastDroppedTS    int64
+	subscribers      map[*eventSubscriber]struct{}
+}
+
+func newEventBus(maxCount int, maxAge time.Duration) *eventBus {
+	return &eventBus{
+		maxCount:    maxCount,
+		maxAge:      maxAge,
+		subscribers: map[*eventSubscriber]struct{}{},
+	}
+}
+
+func (bus *eventBus) publish(ev *obs_grpc.EventEnvelope) {
+	bus.locker.Lock()
+	defer bus.locker.Unlock()
+
+	bus.lastSeqNo++
+	ev.SeqNo = bus.lastSeqNo
+	ev.TimestampUnixNano = time.Now().UnixNano()
+
+	bus.history = append(bus.history, ev)
+	bus.trimHistory(ev.TimestampUnixNano)
+
+	for sub := range bus.subscribers {
+		if sub.missedFromSeqNo != 0 {
+			continue
+		}
+		select {
+		case sub.ch <- ev:
+		default:
+			sub.missedFromSeqNo = ev.SeqNo
+		}
+	}
+}
+
+func (bus *eventBus) trimHistory(nowUnixNano int64) {
+	dropCount := 0
+	for dropCount < len(bus.history) {
+		ev := bus.history[dropCount]
+		tooMany := bus.maxCount >= 0 && len(bus.history)-dropCount > bus.maxCount
+		tooOld := bus.maxAge > 0 && nowUnixNano-ev.TimestampUnixNano > int64(bus.maxAge)
+		if !tooMany && !tooOld {
+			break
+		}
+		bus.lastDroppedSeqNo = ev.SeqNo
+		bus.lastDroppedTS = ev.TimestampUnixNano
+		dropCount++
+	}
+	if dropCount == 0 {
+		return
+	}
+	for idx := 0; idx < dropCount; idx++ {
+		bus.history[idx] = nil
+	}
+	bus.history = bus.history[dropCount:]
+}
+
+func (bus *eventBus) subscribe(
+	req *obs_grpc.SubscribeToEventsRequest,
+) (*eventSubscriber, []*obs_grpc.EventEnvelope, *obs_grpc.EventsGap) {
+	bus.locker.Lock()
+	defer bus.locker.Unlock()
+
+	now := time.Now().UnixNano()
+	bus.trimHistory(now)
+
+	sub := &eventSubscriber{
+		ch: make(chan *obs_grpc.EventEnvelope, eventSubscriberBufferSize),
+	}
+	bus.subscribers[sub] = struct{}{}
+
+	if req == nil || (req.AfterSeqNo == nil && req.MaxAgeNanoseconds == nil) {
+		return sub, nil, nil
+	}
+
+	var gap *obs_grpc.EventsGap
+	afterSeqNo := uint64(0)
+	if req.AfterSeqNo != nil {
+		afterSeqNo = *req.AfterSeqNo
+		switch {
+		case afterSeqNo > bus.lastSeqNo:
+			// the sequence number is from the future, so it was received
+			// before the restart of the proxy: the range of missed events is unknown
+			gap = &obs_grpc.EventsGap{}
+			afterSeqNo = 0
+		case afterSeqNo < bus.lastDroppedSeqNo:
+			gap = &obs_grpc.EventsGap{
+				FromSeqNo: afterSeqNo + 1,
+				ToSeqNo:   bus.lastDroppedSeqNo,
+			}
+		}
+	}
+	sinceTS := int64(0)
+	if req.MaxAgeNanoseconds != nil {
+		sinceTS = now - *req.MaxAgeNanoseconds
+		if bus.lastDroppedTS >= sinceTS && gap == nil {
+			gap = &obs_grpc.EventsGap{
+				ToSeqNo: bus.lastDroppedSeqNo,
+			}
+		}
+	}
+
+	var replay []*obs_grpc.EventEnvelope
+	for _, ev := range bus.history {
+		if ev.SeqNo <= afterSeqNo || ev.TimestampUnixNano < sinceTS {
+			continue
+		}
+		replay = append(replay, ev)
+	}
+	return sub, replay, gap
+}
+
+func (bus *eventBus) unsubscribe(sub *eventSubscriber) {
+	bus.locker.Lock()
+	defer bus.locker.Unlock()
+	delete(bus.subscribers, sub)
+}
+
+// catchUp returns the events missed by a slow subscriber (from the history)
+// once it received all the events sent to sub.ch, and resumes sending
+// the events to sub.ch. If the history does not cover the missed events
+// anymore, then the gap is returned as well.
+func (bus *eventBus) catchUp(sub *eventSubscriber) ([]*obs_grpc.EventEnvelope, *obs_grpc.EventsGap) {
+	bus.locker.Lock()
+	defer bus.locker.Unlock()
+	if sub.missedFromSeqNo == 0 || len(sub.ch) > 0 {
+		return nil, nil
+	}
+
+	bus.trimHistory(time.Now().UnixNano())
+
+	var gap *obs_grpc.EventsGap
+	if sub.missedFromSeqNo <= bus.lastDroppedSeqNo {
+		gap = &obs_grpc.EventsGap{
+			FromSeqNo: sub.missedFromSeqNo,
+			ToSeqNo:   bus.lastDroppedSeqNo,
+		}
+	}
+	var replay []*obs_grpc.EventEnvelope
+	for _, ev := range bus.history {
+		if ev.SeqNo >= sub.missedFromSeqNo {
+			replay = append(replay, ev)
+		}
+	}
+	sub.missedFromSeqNo = 0
+	return replay, gap
+}
+
+func (proxy *Proxy) publishEvent(
+	ctx context.Context,
+	ev any,
+) {
+	envelope, err := EventGo2Protobuf(ev)
+	if err != nil {
+		logger.Errorf(ctx, "unable to convert event %T: %v", ev, err)
+	}
+	if envelope == nil {
+		return
+	}
+	proxy.eventBus.publish(envelope)
+}
+
+func (proxy *Proxy) SubscribeToEvents(
+	req *obs_grpc.SubscribeToEventsRequest,
+	srv obs_grpc.OBS_SubscribeToEventsServer,
+) (_err error) {
+	ctx := srv.Context()
+	logger.Tracef(ctx, "SubscribeToEvents")
+	defer func() {
+		_err = proxy.afterRequest(ctx, "SubscribeToEvents", req, nil, _err)
+		logger.Tracef(ctx, "/SubscribeToEvents: %v", _err)
+	}()
+	if err := proxy.beforeRequest(ctx, "SubscribeToEvents", req); err != nil {
+		return err
+	}
+
+	sub, replay, gap := proxy.eventBus.subscribe(req)
+	defer proxy.eventBus.unsubscribe(sub)
+
+	if err := sendEvents(srv, replay, gap); err != nil {
+		return err
+	}
+
+	for {
+		var ev *obs_grpc.EventEnvelope
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev = <-sub.ch:
+		}
+		if err := srv.Send(ev); err != nil {
+			return fmt.Errorf("unable to send event #%d: %w", ev.SeqNo, err)
+		}
+
+		// the missed events are sent after the ones buffered in sub.ch,
+		// to keep the order of the sequence numbers
+		replay, gap := proxy.eventBus.catchUp(sub)
+		if gap != nil {
+			logger.Debugf(ctx, "the subscriber is too slow, missed events: %d-%d", gap.FromSeqNo, gap.ToSeqNo)
+		}
+		if err := sendEvents(srv, replay, gap); err != nil {
+			return err
+		}
+	}
+}
+
+// sendEvents sends the gap indicator (if any) and then the events.
+func sendEvents(
+	srv obs_grpc.OBS_SubscribeToEventsServer,
+	events []*obs_grpc.EventEnvelope,
+	gap *obs_grpc.EventsGap,
+) error {
+	if gap != nil {
+		if err := srv.Send(&obs_grpc.EventEnvelope{Gap: gap}); err != nil {
+			return fmt.Errorf("unable to send the gap indicator: %w", err)
+		}
+	}
+	for _, ev := range events {
+		if err := srv.Send(ev); err != nil {
+			return fmt.Errorf("unable to send event #%d: %w", ev.SeqNo, err)
+		}
+	}
+	return nil
+}
+
+func (p *ProxyAsClient) SubscribeToEvents(
+	ctx context.Context,
+	req *obs_grpc.SubscribeToEventsRequest,
+	opts ...grpc.CallOption,
+) (obs_grpc.OBS_SubscribeToEventsClient, error) {
+	stream := newLocalStream[*obs_grpc.EventEnvelope](ctx)
+	go func() {
+		defer stream.cancelFn()
+		stream.finish((*Proxy)(p).SubscribeToEvents(req, stream.serverSide()))
+	}()
+	return stream.clientSide(), nil
+}
+
+func (p *ClientAsServer) SubscribeToEvents(
+	req *obs_grpc.SubscribeToEventsRequest,
+	srv obs_grpc.OBS_SubscribeToEventsServer,
+) error {
+	stream, err := p.OBSClient.SubscribeToEvents(srv.Context(), req)
+	if err != nil {
+		return err
+	}
+	for {
+		ev, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := srv.Send(ev); err != nil {
+			return err
+		}
+	}
+}
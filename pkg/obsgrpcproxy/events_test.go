@@ -0,0 +1,171 @@
+package obsgrpcproxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreykaipov/goobs/api/events"
+	"github.com/andreykaipov/goobs/api/typedefs"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+)
+
+func TestEventGo2Protobuf(t *testing.T) {
+	ev, err := EventGo2Protobuf(&events.StreamStateChanged{
+		OutputActive: true,
+		OutputState:  "OBS_WEBSOCKET_OUTPUT_STARTED",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "StreamStateChanged", ev.EventType)
+	require.Equal(t, "outputs", ev.EventCategory)
+	require.Equal(t, obs_grpc.EventSubscription_Outputs, ev.EventSubscription)
+	require.True(t, ev.GetStreamStateChanged().OutputActive)
+	require.Equal(t, []byte("OBS_WEBSOCKET_OUTPUT_STARTED"), ev.GetStreamStateChanged().OutputState)
+
+	ev, err = EventGo2Protobuf(&events.SceneItemTransformChanged{
+		SceneItemId: 3,
+		SceneName:   "Live",
+		SceneItemTransform: &typedefs.SceneItemTransform{
+			PositionX: 1.5,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), ev.GetSceneItemTransformChanged().SceneItemID)
+	require.Equal(t, 1.5, ev.GetSceneItemTransformChanged().SceneItemTransform.Fields["positionX"].GetFloat())
+
+	ev, err = EventGo2Protobuf(&events.InputSettingsChanged{
+		InputName:     "mic",
+		InputSettings: map[string]any{"device_id": "default"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("default"), ev.GetInputSettingsChanged().InputSettings.Fields["device_id"].GetString_())
+}
+
+func TestSubscribeToEventsResume(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	proxy := &Proxy{
+		eventBus: newEventBus(2, time.Hour),
+	}
+	for i := 0; i < 3; i++ {
+		proxy.publishEvent(ctx, &events.ExitStarted{})
+	}
+
+	stream, err := (*ProxyAsClient)(proxy).SubscribeToEvents(ctx, &obs_grpc.SubscribeToEventsRequest{AfterSeqNo: ptr(uint64(2))})
+	require.NoError(t, err)
+	ev, err := stream.Recv()
+	require.NoError(t, err)
+	require.Nil(t, ev.Gap)
+	require.Equal(t, uint64(3), ev.SeqNo)
+
+	stream, err = (*ProxyAsClient)(proxy).SubscribeToEvents(ctx, &obs_grpc.SubscribeToEventsRequest{AfterSeqNo: ptr(uint64(0))})
+	require.NoError(t, err)
+	ev, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, &obs_grpc.EventsGap{FromSeqNo: 1, ToSeqNo: 1}, ev.Gap)
+	ev, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), ev.SeqNo)
+	ev, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), ev.SeqNo)
+
+	proxy.publishEvent(ctx, &events.StudioModeStateChanged{StudioModeEnabled: true})
+	ev, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), ev.SeqNo)
+	require.True(t, ev.GetStudioModeStateChanged().StudioModeEnabled)
+}
+
+func TestSubscribeToEventsInProcessCopies(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	proxy := &Proxy{
+		eventBus: newEventBus(10, time.Hour),
+	}
+	first, err := (*ProxyAsClient)(proxy).SubscribeToEvents(ctx, &obs_grpc.SubscribeToEventsRequest{})
+	require.NoError(t, err)
+	second, err := (*ProxyAsClient)(proxy).SubscribeToEvents(ctx, &obs_grpc.SubscribeToEventsRequest{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		proxy.eventBus.locker.Lock()
+		defer proxy.eventBus.locker.Unlock()
+		return len(proxy.eventBus.subscribers) == 2
+	}, 10*time.Second, time.Millisecond)
+
+	proxy.publishEvent(ctx, &events.CurrentProgramSceneChanged{SceneName: "Live"})
+
+	// a subscriber modifying its event does not affect the others
+	ev, err := first.Recv()
+	require.NoError(t, err)
+	ev.GetCurrentProgramSceneChanged().SceneName = "modified"
+	ev, err = second.Recv()
+	require.NoError(t, err)
+	require.Equal(t, "Live", ev.GetCurrentProgramSceneChanged().GetSceneName())
+
+	// nor the history
+	replay, err := (*ProxyAsClient)(proxy).SubscribeToEvents(ctx, &obs_grpc.SubscribeToEventsRequest{AfterSeqNo: ptr(uint64(0))})
+	require.NoError(t, err)
+	ev, err = replay.Recv()
+	require.NoError(t, err)
+	require.Equal(t, "Live", ev.GetCurrentProgramSceneChanged().GetSceneName())
+}
+
+func TestSubscribeToEventsSlowSubscriber(t *testing.T) {
+	const eventCount = 3 * eventSubscriberBufferSize
+	for _, tc := range []struct {
+		name        string
+		historySize int
+		expectGap   bool
+	}{
+		{name: "history_covers_missed", historySize: eventCount},
+		{name: "history_lost_missed", historySize: eventSubscriberBufferSize / 2, expectGap: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancelFn := context.WithCancel(context.Background())
+			defer cancelFn()
+
+			proxy := &Proxy{
+				eventBus: newEventBus(tc.historySize, time.Hour),
+			}
+			stream, err := (*ProxyAsClient)(proxy).SubscribeToEvents(ctx, &obs_grpc.SubscribeToEventsRequest{})
+			require.NoError(t, err)
+			require.Eventually(t, func() bool {
+				proxy.eventBus.locker.Lock()
+				defer proxy.eventBus.locker.Unlock()
+				return len(proxy.eventBus.subscribers) == 1
+			}, 10*time.Second, time.Millisecond)
+
+			// nothing is received meanwhile, so the buffer overflows
+			for i := 0; i < eventCount; i++ {
+				proxy.publishEvent(ctx, &events.ExitStarted{})
+			}
+
+			nextSeqNo := uint64(1)
+			hadGap := false
+			for nextSeqNo <= eventCount {
+				ev, err := stream.Recv()
+				require.NoError(t, err)
+				if ev.Gap != nil {
+					require.Equal(t, nextSeqNo, ev.Gap.FromSeqNo)
+					require.GreaterOrEqual(t, ev.Gap.ToSeqNo, ev.Gap.FromSeqNo)
+					nextSeqNo = ev.Gap.ToSeqNo + 1
+					hadGap = true
+					continue
+				}
+				require.Equal(t, nextSeqNo, ev.SeqNo)
+				nextSeqNo++
+			}
+			require.Equal(t, tc.expectGap, hadGap)
+
+			// and then the events are delivered as usual
+			proxy.publishEvent(ctx, &events.ExitStarted{})
+			ev, err := stream.Recv()
+			require.NoError(t, err)
+			require.Equal(t, uint64(eventCount+1), ev.SeqNo)
+		})
+	}
+}
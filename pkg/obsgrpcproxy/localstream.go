@@ -0,0 +1,132 @@
+package obsgrpcproxy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// localStream connects a server-streaming handler with its caller within
+// the same process (see ProxyAsClient).
+type localStream[T any] struct {
+	ctx      context.Context
+	cancelFn context.CancelFunc
+	ch       chan T
+	doneCh   chan struct{}
+	err      error
+	once     sync.Once
+}
+
+func newLocalStream[T any](ctx context.Context) *localStream[T] {
+	ctx, cancelFn := context.WithCancel(ctx)
+	return &localStream[T]{
+		ctx:      ctx,
+		cancelFn: cancelFn,
+		ch:       make(chan T),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+func (s *localStream[T]) finish(err error) {
+	s.once.Do(func() {
+		s.err = err
+		close(s.doneCh)
+	})
+}
+
+func (s *localStream[T]) serverSide() *localServerStream[T] {
+	return (*localServerStream[T])(s)
+}
+
+func (s *localStream[T]) clientSide() *localClientStream[T] {
+	return (*localClientStream[T])(s)
+}
+
+type localServerStream[T any] localStream[T]
+
+var _ grpc.ServerStream = (*localServerStream[any])(nil)
+
+// Send sends a copy of a protobuf message (like over the network), since
+// the same message could be sent to many streams (like the events).
+func (s *localServerStream[T]) Send(msg T) error {
+	if m, ok := any(msg).(proto.Message); ok {
+		msg = proto.Clone(m).(T)
+	}
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case s.ch <- msg:
+		return nil
+	}
+}
+
+func (s *localServerStream[T]) SetHeader(metadata.MD) error  { return nil }
+func (s *localServerStream[T]) SendHeader(metadata.MD) error { return nil }
+func (s *localServerStream[T]) SetTrailer(metadata.MD)       {}
+func (s *localServerStream[T]) Context() context.Context     { return s.ctx }
+
+func (s *localServerStream[T]) SendMsg(m any) error {
+	msg, ok := m.(T)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	return s.Send(msg)
+}
+
+func (s *localServerStream[T]) RecvMsg(m any) error {
+	return fmt.Errorf("receiving is not supported by a server-streaming RPC")
+}
+
+type localClientStream[T any] localStream[T]
+
+var _ grpc.ClientStream = (*localClientStream[any])(nil)
+
+func (s *localClientStream[T]) Recv() (T, error) {
+	select {
+	case msg := <-s.ch:
+		return msg, nil
+	case <-s.doneCh:
+		var zeroValue T
+		if s.err != nil {
+			return zeroValue, s.err
+		}
+		return zeroValue, io.EOF
+	}
+}
+
+func (s *localClientStream[T]) Header() (metadata.MD, error) { return nil, nil }
+func (s *localClientStream[T]) Trailer() metadata.MD         { return nil }
+func (s *localClientStream[T]) Context() context.Context     { return s.ctx }
+
+func (s *localClientStream[T]) CloseSend() error {
+	return nil
+}
+
+func (s *localClientStream[T]) SendMsg(m any) error {
+	return fmt.Errorf("sending is not supported by a server-streaming RPC")
+}
+
+func (s *localClientStream[T]) RecvMsg(m any) error {
+	msg, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	if dst, ok := m.(proto.Message); ok {
+		if src, ok := any(msg).(proto.Message); ok {
+			proto.Reset(dst)
+			proto.Merge(dst, src)
+			return nil
+		}
+	}
+	dst, ok := m.(*T)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	*dst = msg
+	return nil
+}
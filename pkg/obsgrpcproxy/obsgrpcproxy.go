@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"regexp"
+	"runtime/debug"
+	"strconv"
 	"sync"
 	"time"
 
@@ -12,8 +15,16 @@ import (
 	typedefs "github.com/andreykaipov/goobs/api/typedefs"
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+const tracerName = "github.com/xaionaro-go/obs-grpc-proxy/pkg/obsgrpcproxy"
+
 type GetClientFunc func(ctx context.Context) (*goobs.Client, context.CancelFunc, error)
 type QueryErrorHandler func(ctx context.Context, err error) error
 
@@ -26,6 +37,9 @@ type Proxy struct {
 	client            *goobs.Client
 	clientCancel      context.CancelFunc
 	clientLocker      sync.Mutex
+	capabilities      *obsCapabilities
+	deprecationWarned sync.Map
+	eventBus          *eventBus
 }
 
 var _ obs_grpc.OBSServer = (*Proxy)(nil)
@@ -46,9 +60,11 @@ func New(
 	getClient GetClientFunc,
 	opts ...Option,
 ) *Proxy {
+	cfg := Options(opts).config()
 	proxy := &Proxy{
 		GetClient: getClient,
-		config:    Options(opts).config(),
+		config:    cfg,
+		eventBus:  newEventBus(cfg.EventHistoryMaxCount, cfg.EventHistoryMaxAge),
 	}
 	go proxy.processEvents(ctx)
 	return proxy
@@ -68,6 +84,19 @@ func (proxy *Proxy) getClient(
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client to OBS: %w", err)
 	}
+
+	// the capabilities are kept for the whole connection, so they are not
+	// fetched with the deadline of the caller which happened to connect
+	capsCtx, cancelFn := context.WithoutCancel(ctx), context.CancelFunc(func() {})
+	if timeout := proxy.config.requestTimeout("GetVersion"); timeout > 0 {
+		capsCtx, cancelFn = context.WithTimeout(capsCtx, timeout)
+	}
+	proxy.capabilities, err = getOBSCapabilities(capsCtx, proxy.client)
+	cancelFn()
+	if err != nil {
+		logger.Warnf(ctx, "unable to get the capabilities of OBS, assuming all the requests are supported: %v", err)
+	}
+	proxy.processConnectionStateChange(ctx, true)
 	return proxy.client, nil
 }
 
@@ -111,10 +140,22 @@ func (proxy *Proxy) processEvents(ctx context.Context) {
 			}
 			proxy.client = nil
 			proxy.clientCancel = nil
+			proxy.capabilities = nil
+			proxy.processConnectionStateChange(ctx, false)
 		}()
 	}
 }
 
+func (proxy *Proxy) processConnectionStateChange(
+	ctx context.Context,
+	isConnected bool,
+) {
+	logger.Debugf(ctx, "OBS connection state changed: isConnected:%v", isConnected)
+	for _, hook := range proxy.config.ConnectionHooks {
+		hook.ProcessConnectionStateChange(ctx, isConnected)
+	}
+}
+
 func (proxy *Proxy) processEvent(
 	ctx context.Context,
 	ev any,
@@ -123,15 +164,192 @@ func (proxy *Proxy) processEvent(
 	for _, hook := range proxy.config.EventHooks {
 		hook.ProcessEvent(ctx, ev)
 	}
+	proxy.publishEvent(ctx, ev)
+}
+
+func (proxy *Proxy) beforeRequest(
+	ctx context.Context,
+	methodName string,
+	req proto.Message,
+) error {
+	req = nilIfInvalid(req)
+	for _, hook := range proxy.config.RequestHooks {
+		if err := hook.BeforeRequest(ctx, methodName, req); err != nil {
+			return fmt.Errorf("the request was rejected by a hook: %w", err)
+		}
+	}
+	return nil
+}
+
+func (proxy *Proxy) afterRequest(
+	ctx context.Context,
+	methodName string,
+	req proto.Message,
+	resp proto.Message,
+	err error,
+) error {
+	req, resp = nilIfInvalid(req), nilIfInvalid(resp)
+	for _, hook := range proxy.config.RequestHooks {
+		err = hook.AfterRequest(ctx, methodName, req, resp, err)
+	}
+	return err
+}
+
+func nilIfInvalid(msg proto.Message) proto.Message {
+	if msg == nil || !msg.ProtoReflect().IsValid() {
+		return nil
+	}
+	return msg
+}
+
+func (proxy *Proxy) query(
+	ctx context.Context,
+	requestType string,
+	fn func() error,
+) error {
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("obs.request_type", requestType))
+	if err := proxy.checkRequestAvailable(requestType); err != nil {
+		return err
+	}
+	proxy.observeDeprecatedRequest(ctx, requestType)
+	if timeout := proxy.config.requestTimeout(requestType); timeout > 0 {
+		var cancelFn context.CancelFunc
+		ctx, cancelFn = context.WithTimeout(ctx, timeout)
+		defer cancelFn()
+	}
+
+	retryPolicy := proxy.config.RetryPolicy
+	for attempt := 0; ; attempt++ {
+		err := proxy.queryAttempt(ctx, requestType, attempt, fn)
+		if err == nil || proxy.QueryErrorHandler == nil || ctx.Err() != nil {
+			return err
+		}
+		if attempt+1 >= retryPolicy.MaxAttempts {
+			return err
+		}
+		fixErr := proxy.QueryErrorHandler(ctx, err)
+		if fixErr != nil {
+			return err
+		}
+		logger.Tracef(ctx, "there was error '%s', but it was handled; attempt #%d", err, attempt+1)
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-time.After(retryPolicy.backoff(attempt)):
+		}
+	}
+}
+
+func (proxy *Proxy) queryAttempt(
+	ctx context.Context,
+	requestType string,
+	attempt int,
+	fn func() error,
+) error {
+	_, span := otel.Tracer(tracerName).Start(
+		ctx,
+		"obs-websocket/"+requestType,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("obs.request_type", requestType),
+			attribute.Int("obs.attempt", attempt),
+		),
+	)
+	defer span.End()
+
+	err := callWithContext(ctx, fn)
+	requestStatus := obs_grpc.RequestStatus_Success
+	if err != nil {
+		var ok bool
+		requestStatus, ok = RequestStatusFromError(err)
+		if !ok {
+			requestStatus = obs_grpc.RequestStatus_Unknown
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.SetAttributes(
+		attribute.Int("obs.request_status.code", int(requestStatus)),
+		attribute.String("obs.request_status", requestStatus.String()),
+	)
+	return err
+}
+
+// callWithContext returns as soon as the context is done, even if fn (which
+// is not context-aware) is still running. In this case the goroutine
+// running fn lives until goobs receives the response or times out on its
+// own (see GoOBSOptions).
+func callWithContext(
+	ctx context.Context,
+	fn func() error,
+) error {
+	errCh := make(chan error, 1)
+	go func() {
+		defer func() {
+			r := recover()
+			if r != nil {
+				errCh <- fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
+			}
+		}()
+		errCh <- fn()
+	}()
+	select {
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	case err := <-errCh:
+		return err
+	}
+}
+
+// example: request GetSceneItemId: ResourceNotFound (600): No scene items were found in the specified scene by that name or offset.
+//
+// The error could be wrapped, for example: query error: request GetSceneItemId: ResourceNotFound (600): ...
+var goobsRequestStatusRegexp = regexp.MustCompile(`(?:^|: )request \w+: \w+ \((\d+)\)`)
+
+// RequestStatusFromError extracts the RequestStatus reported by OBS from
+// an error returned by goobs (or an error wrapping it, including an error
+// received through gRPC).
+func RequestStatusFromError(err error) (obs_grpc.RequestStatus, bool) {
+	if err == nil {
+		return obs_grpc.RequestStatus_Success, true
+	}
+	match := goobsRequestStatusRegexp.FindStringSubmatch(err.Error())
+	if match == nil {
+		return obs_grpc.RequestStatus_Unknown, false
+	}
+	code, err := strconv.ParseInt(match[1], 10, 32)
+	if err != nil {
+		return obs_grpc.RequestStatus_Unknown, false
+	}
+	return obs_grpc.RequestStatus(code), true
 }
 
 func ptr[T any](in T) *T {
 	return &in
 }
 
+func nilIfEmpty(in string) *string {
+	if in == "" {
+		return nil
+	}
+	return &in
+}
+
+// AnyGo2Protobuf converts a value of the types used by encoding/json
+// (and the other numeric types). It panics on the other types.
 func AnyGo2Protobuf(in any) *obs_grpc.Any {
+	result, err := anyGo2Protobuf(in)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func anyGo2Protobuf(in any) (*obs_grpc.Any, error) {
 	var result obs_grpc.Any
 	switch in := in.(type) {
+	case nil:
 	case []byte:
 		result.Union = &obs_grpc.Any_String_{String_: in}
 	case string:
@@ -163,17 +381,44 @@ func AnyGo2Protobuf(in any) *obs_grpc.Any {
 	case bool:
 		result.Union = &obs_grpc.Any_Bool{Bool: in}
 	case map[string]any:
+		obj := &obs_grpc.AbstractObject{
+			Fields: make(map[string]*obs_grpc.Any, len(in)),
+		}
+		for k, v := range in {
+			field, err := anyGo2Protobuf(v)
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert field '%s': %w", k, err)
+			}
+			obj.Fields[k] = field
+		}
 		result.Union = &obs_grpc.Any_Object{
-			Object: ToAbstractObject(in),
+			Object: obj,
+		}
+	case []any:
+		list := &obs_grpc.AnyList{
+			Items: make([]*obs_grpc.Any, 0, len(in)),
+		}
+		for idx, v := range in {
+			item, err := anyGo2Protobuf(v)
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert item #%d: %w", idx, err)
+			}
+			list.Items = append(list.Items, item)
+		}
+		result.Union = &obs_grpc.Any_List{
+			List: list,
 		}
 	default:
-		panic(fmt.Errorf("unexpected type %T", in))
+		return nil, fmt.Errorf("unexpected type %T", in)
 	}
-	return &result
+	return &result, nil
 }
 
+// AnyProtobuf2Go converts the value to the types used by encoding/json
+// (but the integers are int64). A nil value or a value without the union
+// set is converted to nil.
 func AnyProtobuf2Go(in *obs_grpc.Any) any {
-	switch in := in.Union.(type) {
+	switch in := in.GetUnion().(type) {
 	case *obs_grpc.Any_Integer:
 		return in.Integer
 	case *obs_grpc.Any_Float:
@@ -183,14 +428,24 @@ func AnyProtobuf2Go(in *obs_grpc.Any) any {
 	case *obs_grpc.Any_Bool:
 		return in.Bool
 	case *obs_grpc.Any_Object:
-		result, err := FromAbstractObject[map[string]any](in.Object)
-		if err != nil {
-			panic(err)
+		return abstractObject2Map(in.Object)
+	case *obs_grpc.Any_List:
+		result := make([]any, 0, len(in.List.GetItems()))
+		for _, item := range in.List.GetItems() {
+			result = append(result, AnyProtobuf2Go(item))
 		}
 		return result
 	default:
-		panic(fmt.Errorf("unexpected type: %T", in))
+		return nil
+	}
+}
+
+func abstractObject2Map(in *obs_grpc.AbstractObject) map[string]any {
+	result := make(map[string]any, len(in.GetFields()))
+	for k, v := range in.GetFields() {
+		result[k] = AnyProtobuf2Go(v)
 	}
+	return result
 }
 
 func ToAbstractObjects[T any](in []T) []*obs_grpc.AbstractObject {
@@ -261,12 +516,7 @@ func fromAbstractObjectViaJSON[T any](in *obs_grpc.AbstractObject) (T, error) {
 		return result, nil
 	}
 
-	m := map[string]any{}
-	for k, f := range in.Fields {
-		m[k] = AnyProtobuf2Go(f)
-	}
-
-	b, err := json.Marshal(m)
+	b, err := json.Marshal(abstractObject2Map(in))
 	if err != nil {
 		return result, fmt.Errorf("unable to serialize to JSON: %w", err)
 	}
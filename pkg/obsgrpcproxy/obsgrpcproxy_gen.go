@@ -34,8 +34,15 @@ func (p *Proxy) GetPersistentData(ctx context.Context, req *obsgrpc.GetPersisten
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetPersistentData", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetPersistentData: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetPersistentData", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -50,17 +57,11 @@ func (p *Proxy) GetPersistentData(ctx context.Context, req *obsgrpc.GetPersisten
 	var (
 		resp *config.GetPersistentDataResponse
 	)
-	for {
+	err = p.query(ctx, "GetPersistentData", func() error {
+		var err error
 		resp, err = client.Config.GetPersistentData(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -85,8 +86,15 @@ func (p *Proxy) SetPersistentData(ctx context.Context, req *obsgrpc.SetPersisten
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetPersistentData", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetPersistentData: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetPersistentData", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -102,17 +110,11 @@ func (p *Proxy) SetPersistentData(ctx context.Context, req *obsgrpc.SetPersisten
 	var (
 		resp *config.SetPersistentDataResponse
 	)
-	for {
+	err = p.query(ctx, "SetPersistentData", func() error {
+		var err error
 		resp, err = client.Config.SetPersistentData(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -135,8 +137,15 @@ func (p *Proxy) GetSceneCollectionList(ctx context.Context, req *obsgrpc.GetScen
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSceneCollectionList", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSceneCollectionList: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSceneCollectionList", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -148,17 +157,11 @@ func (p *Proxy) GetSceneCollectionList(ctx context.Context, req *obsgrpc.GetScen
 	var (
 		resp *config.GetSceneCollectionListResponse
 	)
-	for {
+	err = p.query(ctx, "GetSceneCollectionList", func() error {
+		var err error
 		resp, err = client.Config.GetSceneCollectionList(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -184,8 +187,15 @@ func (p *Proxy) SetCurrentSceneCollection(ctx context.Context, req *obsgrpc.SetC
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetCurrentSceneCollection", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetCurrentSceneCollection: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetCurrentSceneCollection", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -199,17 +209,11 @@ func (p *Proxy) SetCurrentSceneCollection(ctx context.Context, req *obsgrpc.SetC
 	var (
 		resp *config.SetCurrentSceneCollectionResponse
 	)
-	for {
+	err = p.query(ctx, "SetCurrentSceneCollection", func() error {
+		var err error
 		resp, err = client.Config.SetCurrentSceneCollection(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -232,8 +236,15 @@ func (p *Proxy) CreateSceneCollection(ctx context.Context, req *obsgrpc.CreateSc
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "CreateSceneCollection", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/CreateSceneCollection: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "CreateSceneCollection", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -247,17 +258,11 @@ func (p *Proxy) CreateSceneCollection(ctx context.Context, req *obsgrpc.CreateSc
 	var (
 		resp *config.CreateSceneCollectionResponse
 	)
-	for {
+	err = p.query(ctx, "CreateSceneCollection", func() error {
+		var err error
 		resp, err = client.Config.CreateSceneCollection(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -280,8 +285,15 @@ func (p *Proxy) GetProfileList(ctx context.Context, req *obsgrpc.GetProfileListR
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetProfileList", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetProfileList: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetProfileList", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -293,17 +305,11 @@ func (p *Proxy) GetProfileList(ctx context.Context, req *obsgrpc.GetProfileListR
 	var (
 		resp *config.GetProfileListResponse
 	)
-	for {
+	err = p.query(ctx, "GetProfileList", func() error {
+		var err error
 		resp, err = client.Config.GetProfileList(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -329,8 +335,15 @@ func (p *Proxy) SetCurrentProfile(ctx context.Context, req *obsgrpc.SetCurrentPr
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetCurrentProfile", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetCurrentProfile: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetCurrentProfile", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -344,17 +357,11 @@ func (p *Proxy) SetCurrentProfile(ctx context.Context, req *obsgrpc.SetCurrentPr
 	var (
 		resp *config.SetCurrentProfileResponse
 	)
-	for {
+	err = p.query(ctx, "SetCurrentProfile", func() error {
+		var err error
 		resp, err = client.Config.SetCurrentProfile(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -377,8 +384,15 @@ func (p *Proxy) CreateProfile(ctx context.Context, req *obsgrpc.CreateProfileReq
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "CreateProfile", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/CreateProfile: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "CreateProfile", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -392,17 +406,11 @@ func (p *Proxy) CreateProfile(ctx context.Context, req *obsgrpc.CreateProfileReq
 	var (
 		resp *config.CreateProfileResponse
 	)
-	for {
+	err = p.query(ctx, "CreateProfile", func() error {
+		var err error
 		resp, err = client.Config.CreateProfile(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -425,8 +433,15 @@ func (p *Proxy) RemoveProfile(ctx context.Context, req *obsgrpc.RemoveProfileReq
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "RemoveProfile", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/RemoveProfile: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "RemoveProfile", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -440,17 +455,11 @@ func (p *Proxy) RemoveProfile(ctx context.Context, req *obsgrpc.RemoveProfileReq
 	var (
 		resp *config.RemoveProfileResponse
 	)
-	for {
+	err = p.query(ctx, "RemoveProfile", func() error {
+		var err error
 		resp, err = client.Config.RemoveProfile(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -473,8 +482,15 @@ func (p *Proxy) GetProfileParameter(ctx context.Context, req *obsgrpc.GetProfile
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetProfileParameter", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetProfileParameter: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetProfileParameter", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -489,17 +505,11 @@ func (p *Proxy) GetProfileParameter(ctx context.Context, req *obsgrpc.GetProfile
 	var (
 		resp *config.GetProfileParameterResponse
 	)
-	for {
+	err = p.query(ctx, "GetProfileParameter", func() error {
+		var err error
 		resp, err = client.Config.GetProfileParameter(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -525,8 +535,15 @@ func (p *Proxy) SetProfileParameter(ctx context.Context, req *obsgrpc.SetProfile
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetProfileParameter", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetProfileParameter: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetProfileParameter", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -542,17 +559,11 @@ func (p *Proxy) SetProfileParameter(ctx context.Context, req *obsgrpc.SetProfile
 	var (
 		resp *config.SetProfileParameterResponse
 	)
-	for {
+	err = p.query(ctx, "SetProfileParameter", func() error {
+		var err error
 		resp, err = client.Config.SetProfileParameter(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -575,8 +586,15 @@ func (p *Proxy) GetVideoSettings(ctx context.Context, req *obsgrpc.GetVideoSetti
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetVideoSettings", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetVideoSettings: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetVideoSettings", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -588,17 +606,11 @@ func (p *Proxy) GetVideoSettings(ctx context.Context, req *obsgrpc.GetVideoSetti
 	var (
 		resp *config.GetVideoSettingsResponse
 	)
-	for {
+	err = p.query(ctx, "GetVideoSettings", func() error {
+		var err error
 		resp, err = client.Config.GetVideoSettings(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -628,8 +640,18 @@ func (p *Proxy) SetVideoSettings(ctx context.Context, req *obsgrpc.SetVideoSetti
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetVideoSettings", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetVideoSettings: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetVideoSettings", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetVideoSettings", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -648,17 +670,11 @@ func (p *Proxy) SetVideoSettings(ctx context.Context, req *obsgrpc.SetVideoSetti
 	var (
 		resp *config.SetVideoSettingsResponse
 	)
-	for {
+	err = p.query(ctx, "SetVideoSettings", func() error {
+		var err error
 		resp, err = client.Config.SetVideoSettings(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -681,8 +697,15 @@ func (p *Proxy) GetStreamServiceSettings(ctx context.Context, req *obsgrpc.GetSt
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetStreamServiceSettings", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetStreamServiceSettings: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetStreamServiceSettings", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -694,17 +717,11 @@ func (p *Proxy) GetStreamServiceSettings(ctx context.Context, req *obsgrpc.GetSt
 	var (
 		resp *config.GetStreamServiceSettingsResponse
 	)
-	for {
+	err = p.query(ctx, "GetStreamServiceSettings", func() error {
+		var err error
 		resp, err = client.Config.GetStreamServiceSettings(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -730,8 +747,18 @@ func (p *Proxy) SetStreamServiceSettings(ctx context.Context, req *obsgrpc.SetSt
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetStreamServiceSettings", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetStreamServiceSettings: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetStreamServiceSettings", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetStreamServiceSettings", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -750,17 +777,11 @@ func (p *Proxy) SetStreamServiceSettings(ctx context.Context, req *obsgrpc.SetSt
 	var (
 		resp *config.SetStreamServiceSettingsResponse
 	)
-	for {
+	err = p.query(ctx, "SetStreamServiceSettings", func() error {
+		var err error
 		resp, err = client.Config.SetStreamServiceSettings(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -783,8 +804,15 @@ func (p *Proxy) GetRecordDirectory(ctx context.Context, req *obsgrpc.GetRecordDi
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetRecordDirectory", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetRecordDirectory: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetRecordDirectory", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -796,17 +824,11 @@ func (p *Proxy) GetRecordDirectory(ctx context.Context, req *obsgrpc.GetRecordDi
 	var (
 		resp *config.GetRecordDirectoryResponse
 	)
-	for {
+	err = p.query(ctx, "GetRecordDirectory", func() error {
+		var err error
 		resp, err = client.Config.GetRecordDirectory(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -831,8 +853,15 @@ func (p *Proxy) SetRecordDirectory(ctx context.Context, req *obsgrpc.SetRecordDi
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetRecordDirectory", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetRecordDirectory: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetRecordDirectory", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -846,17 +875,11 @@ func (p *Proxy) SetRecordDirectory(ctx context.Context, req *obsgrpc.SetRecordDi
 	var (
 		resp *config.SetRecordDirectoryResponse
 	)
-	for {
+	err = p.query(ctx, "SetRecordDirectory", func() error {
+		var err error
 		resp, err = client.Config.SetRecordDirectory(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -879,8 +902,15 @@ func (p *Proxy) GetSourceFilterKindList(ctx context.Context, req *obsgrpc.GetSou
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSourceFilterKindList", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSourceFilterKindList: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSourceFilterKindList", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -892,17 +922,11 @@ func (p *Proxy) GetSourceFilterKindList(ctx context.Context, req *obsgrpc.GetSou
 	var (
 		resp *filters.GetSourceFilterKindListResponse
 	)
-	for {
+	err = p.query(ctx, "GetSourceFilterKindList", func() error {
+		var err error
 		resp, err = client.Filters.GetSourceFilterKindList(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -927,8 +951,18 @@ func (p *Proxy) GetSourceFilterList(ctx context.Context, req *obsgrpc.GetSourceF
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSourceFilterList", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSourceFilterList: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSourceFilterList", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetSourceFilterList", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -936,24 +970,18 @@ func (p *Proxy) GetSourceFilterList(ctx context.Context, req *obsgrpc.GetSourceF
 	params := &filters.GetSourceFilterListParams{}
 	if req != nil {
 		params = &filters.GetSourceFilterListParams{
-			SourceName: req.SourceName,
-			SourceUuid: req.SourceUUID,
+			SourceName: nilIfEmpty(req.GetSourceName()),
+			SourceUuid: nilIfEmpty(req.GetSourceUUID()),
 		}
 	}
 	var (
 		resp *filters.GetSourceFilterListResponse
 	)
-	for {
+	err = p.query(ctx, "GetSourceFilterList", func() error {
+		var err error
 		resp, err = client.Filters.GetSourceFilterList(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -978,8 +1006,15 @@ func (p *Proxy) GetSourceFilterDefaultSettings(ctx context.Context, req *obsgrpc
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSourceFilterDefaultSettings", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSourceFilterDefaultSettings: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSourceFilterDefaultSettings", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -993,17 +1028,11 @@ func (p *Proxy) GetSourceFilterDefaultSettings(ctx context.Context, req *obsgrpc
 	var (
 		resp *filters.GetSourceFilterDefaultSettingsResponse
 	)
-	for {
+	err = p.query(ctx, "GetSourceFilterDefaultSettings", func() error {
+		var err error
 		resp, err = client.Filters.GetSourceFilterDefaultSettings(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1028,8 +1057,18 @@ func (p *Proxy) CreateSourceFilter(ctx context.Context, req *obsgrpc.CreateSourc
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "CreateSourceFilter", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/CreateSourceFilter: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "CreateSourceFilter", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("CreateSourceFilter", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1041,8 +1080,8 @@ func (p *Proxy) CreateSourceFilter(ctx context.Context, req *obsgrpc.CreateSourc
 			return nil, fmt.Errorf("unable to convert field %s: %w", "FilterSettings", err)
 		}
 		params = &filters.CreateSourceFilterParams{
-			SourceName:     req.SourceName,
-			SourceUuid:     req.SourceUUID,
+			SourceName:     nilIfEmpty(req.GetSourceName()),
+			SourceUuid:     nilIfEmpty(req.GetSourceUUID()),
 			FilterName:     ptr(req.FilterName),
 			FilterKind:     ptr(req.FilterKind),
 			FilterSettings: filterSettings,
@@ -1051,17 +1090,11 @@ func (p *Proxy) CreateSourceFilter(ctx context.Context, req *obsgrpc.CreateSourc
 	var (
 		resp *filters.CreateSourceFilterResponse
 	)
-	for {
+	err = p.query(ctx, "CreateSourceFilter", func() error {
+		var err error
 		resp, err = client.Filters.CreateSourceFilter(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1084,8 +1117,18 @@ func (p *Proxy) RemoveSourceFilter(ctx context.Context, req *obsgrpc.RemoveSourc
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "RemoveSourceFilter", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/RemoveSourceFilter: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "RemoveSourceFilter", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("RemoveSourceFilter", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1093,25 +1136,19 @@ func (p *Proxy) RemoveSourceFilter(ctx context.Context, req *obsgrpc.RemoveSourc
 	params := &filters.RemoveSourceFilterParams{}
 	if req != nil {
 		params = &filters.RemoveSourceFilterParams{
-			SourceName: req.SourceName,
-			SourceUuid: req.SourceUUID,
+			SourceName: nilIfEmpty(req.GetSourceName()),
+			SourceUuid: nilIfEmpty(req.GetSourceUUID()),
 			FilterName: ptr(req.FilterName),
 		}
 	}
 	var (
 		resp *filters.RemoveSourceFilterResponse
 	)
-	for {
+	err = p.query(ctx, "RemoveSourceFilter", func() error {
+		var err error
 		resp, err = client.Filters.RemoveSourceFilter(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1134,8 +1171,18 @@ func (p *Proxy) SetSourceFilterName(ctx context.Context, req *obsgrpc.SetSourceF
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetSourceFilterName", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetSourceFilterName: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetSourceFilterName", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetSourceFilterName", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1143,8 +1190,8 @@ func (p *Proxy) SetSourceFilterName(ctx context.Context, req *obsgrpc.SetSourceF
 	params := &filters.SetSourceFilterNameParams{}
 	if req != nil {
 		params = &filters.SetSourceFilterNameParams{
-			SourceName:    req.SourceName,
-			SourceUuid:    req.SourceUUID,
+			SourceName:    nilIfEmpty(req.GetSourceName()),
+			SourceUuid:    nilIfEmpty(req.GetSourceUUID()),
 			FilterName:    ptr(req.FilterName),
 			NewFilterName: ptr(req.NewFilterName),
 		}
@@ -1152,17 +1199,11 @@ func (p *Proxy) SetSourceFilterName(ctx context.Context, req *obsgrpc.SetSourceF
 	var (
 		resp *filters.SetSourceFilterNameResponse
 	)
-	for {
+	err = p.query(ctx, "SetSourceFilterName", func() error {
+		var err error
 		resp, err = client.Filters.SetSourceFilterName(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1185,8 +1226,18 @@ func (p *Proxy) GetSourceFilter(ctx context.Context, req *obsgrpc.GetSourceFilte
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSourceFilter", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSourceFilter: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSourceFilter", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetSourceFilter", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1194,25 +1245,19 @@ func (p *Proxy) GetSourceFilter(ctx context.Context, req *obsgrpc.GetSourceFilte
 	params := &filters.GetSourceFilterParams{}
 	if req != nil {
 		params = &filters.GetSourceFilterParams{
-			SourceName: req.SourceName,
-			SourceUuid: req.SourceUUID,
+			SourceName: nilIfEmpty(req.GetSourceName()),
+			SourceUuid: nilIfEmpty(req.GetSourceUUID()),
 			FilterName: ptr(req.FilterName),
 		}
 	}
 	var (
 		resp *filters.GetSourceFilterResponse
 	)
-	for {
+	err = p.query(ctx, "GetSourceFilter", func() error {
+		var err error
 		resp, err = client.Filters.GetSourceFilter(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1240,8 +1285,18 @@ func (p *Proxy) SetSourceFilterIndex(ctx context.Context, req *obsgrpc.SetSource
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetSourceFilterIndex", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetSourceFilterIndex: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetSourceFilterIndex", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetSourceFilterIndex", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1249,8 +1304,8 @@ func (p *Proxy) SetSourceFilterIndex(ctx context.Context, req *obsgrpc.SetSource
 	params := &filters.SetSourceFilterIndexParams{}
 	if req != nil {
 		params = &filters.SetSourceFilterIndexParams{
-			SourceName:  req.SourceName,
-			SourceUuid:  req.SourceUUID,
+			SourceName:  nilIfEmpty(req.GetSourceName()),
+			SourceUuid:  nilIfEmpty(req.GetSourceUUID()),
 			FilterName:  ptr(req.FilterName),
 			FilterIndex: ptr((int)(req.FilterIndex)),
 		}
@@ -1258,17 +1313,11 @@ func (p *Proxy) SetSourceFilterIndex(ctx context.Context, req *obsgrpc.SetSource
 	var (
 		resp *filters.SetSourceFilterIndexResponse
 	)
-	for {
+	err = p.query(ctx, "SetSourceFilterIndex", func() error {
+		var err error
 		resp, err = client.Filters.SetSourceFilterIndex(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1291,8 +1340,18 @@ func (p *Proxy) SetSourceFilterSettings(ctx context.Context, req *obsgrpc.SetSou
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetSourceFilterSettings", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetSourceFilterSettings: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetSourceFilterSettings", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetSourceFilterSettings", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1304,8 +1363,8 @@ func (p *Proxy) SetSourceFilterSettings(ctx context.Context, req *obsgrpc.SetSou
 			return nil, fmt.Errorf("unable to convert field %s: %w", "FilterSettings", err)
 		}
 		params = &filters.SetSourceFilterSettingsParams{
-			SourceName:     req.SourceName,
-			SourceUuid:     req.SourceUUID,
+			SourceName:     nilIfEmpty(req.GetSourceName()),
+			SourceUuid:     nilIfEmpty(req.GetSourceUUID()),
 			FilterName:     ptr(req.FilterName),
 			FilterSettings: filterSettings,
 			Overlay:        req.Overlay,
@@ -1314,17 +1373,11 @@ func (p *Proxy) SetSourceFilterSettings(ctx context.Context, req *obsgrpc.SetSou
 	var (
 		resp *filters.SetSourceFilterSettingsResponse
 	)
-	for {
+	err = p.query(ctx, "SetSourceFilterSettings", func() error {
+		var err error
 		resp, err = client.Filters.SetSourceFilterSettings(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1347,8 +1400,18 @@ func (p *Proxy) SetSourceFilterEnabled(ctx context.Context, req *obsgrpc.SetSour
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetSourceFilterEnabled", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetSourceFilterEnabled: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetSourceFilterEnabled", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetSourceFilterEnabled", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1356,8 +1419,8 @@ func (p *Proxy) SetSourceFilterEnabled(ctx context.Context, req *obsgrpc.SetSour
 	params := &filters.SetSourceFilterEnabledParams{}
 	if req != nil {
 		params = &filters.SetSourceFilterEnabledParams{
-			SourceName:    req.SourceName,
-			SourceUuid:    req.SourceUUID,
+			SourceName:    nilIfEmpty(req.GetSourceName()),
+			SourceUuid:    nilIfEmpty(req.GetSourceUUID()),
 			FilterName:    ptr(req.FilterName),
 			FilterEnabled: ptr(req.FilterEnabled),
 		}
@@ -1365,17 +1428,11 @@ func (p *Proxy) SetSourceFilterEnabled(ctx context.Context, req *obsgrpc.SetSour
 	var (
 		resp *filters.SetSourceFilterEnabledResponse
 	)
-	for {
+	err = p.query(ctx, "SetSourceFilterEnabled", func() error {
+		var err error
 		resp, err = client.Filters.SetSourceFilterEnabled(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1398,8 +1455,15 @@ func (p *Proxy) GetVersion(ctx context.Context, req *obsgrpc.GetVersionRequest)
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetVersion", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetVersion: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetVersion", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1411,17 +1475,11 @@ func (p *Proxy) GetVersion(ctx context.Context, req *obsgrpc.GetVersionRequest)
 	var (
 		resp *general.GetVersionResponse
 	)
-	for {
+	err = p.query(ctx, "GetVersion", func() error {
+		var err error
 		resp, err = client.General.GetVersion(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1452,8 +1510,15 @@ func (p *Proxy) GetStats(ctx context.Context, req *obsgrpc.GetStatsRequest) (_re
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetStats", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetStats: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetStats", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1465,17 +1530,11 @@ func (p *Proxy) GetStats(ctx context.Context, req *obsgrpc.GetStatsRequest) (_re
 	var (
 		resp *general.GetStatsResponse
 	)
-	for {
+	err = p.query(ctx, "GetStats", func() error {
+		var err error
 		resp, err = client.General.GetStats(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1510,8 +1569,18 @@ func (p *Proxy) BroadcastCustomEvent(ctx context.Context, req *obsgrpc.Broadcast
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "BroadcastCustomEvent", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/BroadcastCustomEvent: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "BroadcastCustomEvent", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("BroadcastCustomEvent", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1529,17 +1598,11 @@ func (p *Proxy) BroadcastCustomEvent(ctx context.Context, req *obsgrpc.Broadcast
 	var (
 		resp *general.BroadcastCustomEventResponse
 	)
-	for {
+	err = p.query(ctx, "BroadcastCustomEvent", func() error {
+		var err error
 		resp, err = client.General.BroadcastCustomEvent(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1562,8 +1625,15 @@ func (p *Proxy) CallVendorRequest(ctx context.Context, req *obsgrpc.CallVendorRe
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "CallVendorRequest", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/CallVendorRequest: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "CallVendorRequest", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1583,17 +1653,11 @@ func (p *Proxy) CallVendorRequest(ctx context.Context, req *obsgrpc.CallVendorRe
 	var (
 		resp *general.CallVendorRequestResponse
 	)
-	for {
+	err = p.query(ctx, "CallVendorRequest", func() error {
+		var err error
 		resp, err = client.General.CallVendorRequest(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1620,8 +1684,15 @@ func (p *Proxy) GetHotkeyList(ctx context.Context, req *obsgrpc.GetHotkeyListReq
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetHotkeyList", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetHotkeyList: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetHotkeyList", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1633,17 +1704,11 @@ func (p *Proxy) GetHotkeyList(ctx context.Context, req *obsgrpc.GetHotkeyListReq
 	var (
 		resp *general.GetHotkeyListResponse
 	)
-	for {
+	err = p.query(ctx, "GetHotkeyList", func() error {
+		var err error
 		resp, err = client.General.GetHotkeyList(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1668,8 +1733,15 @@ func (p *Proxy) TriggerHotkeyByName(ctx context.Context, req *obsgrpc.TriggerHot
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "TriggerHotkeyByName", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/TriggerHotkeyByName: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "TriggerHotkeyByName", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1684,17 +1756,11 @@ func (p *Proxy) TriggerHotkeyByName(ctx context.Context, req *obsgrpc.TriggerHot
 	var (
 		resp *general.TriggerHotkeyByNameResponse
 	)
-	for {
+	err = p.query(ctx, "TriggerHotkeyByName", func() error {
+		var err error
 		resp, err = client.General.TriggerHotkeyByName(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1717,8 +1783,15 @@ func (p *Proxy) TriggerHotkeyByKeySequence(ctx context.Context, req *obsgrpc.Tri
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "TriggerHotkeyByKeySequence", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/TriggerHotkeyByKeySequence: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "TriggerHotkeyByKeySequence", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1737,17 +1810,11 @@ func (p *Proxy) TriggerHotkeyByKeySequence(ctx context.Context, req *obsgrpc.Tri
 	var (
 		resp *general.TriggerHotkeyByKeySequenceResponse
 	)
-	for {
+	err = p.query(ctx, "TriggerHotkeyByKeySequence", func() error {
+		var err error
 		resp, err = client.General.TriggerHotkeyByKeySequence(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1770,8 +1837,18 @@ func (p *Proxy) Sleep(ctx context.Context, req *obsgrpc.SleepRequest) (_ret *obs
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "Sleep", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/Sleep: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "Sleep", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("Sleep", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1786,17 +1863,11 @@ func (p *Proxy) Sleep(ctx context.Context, req *obsgrpc.SleepRequest) (_ret *obs
 	var (
 		resp *general.SleepResponse
 	)
-	for {
+	err = p.query(ctx, "Sleep", func() error {
+		var err error
 		resp, err = client.General.Sleep(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1819,8 +1890,15 @@ func (p *Proxy) GetInputList(ctx context.Context, req *obsgrpc.GetInputListReque
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetInputList", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetInputList: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetInputList", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1834,17 +1912,11 @@ func (p *Proxy) GetInputList(ctx context.Context, req *obsgrpc.GetInputListReque
 	var (
 		resp *inputs.GetInputListResponse
 	)
-	for {
+	err = p.query(ctx, "GetInputList", func() error {
+		var err error
 		resp, err = client.Inputs.GetInputList(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1869,8 +1941,15 @@ func (p *Proxy) GetInputKindList(ctx context.Context, req *obsgrpc.GetInputKindL
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetInputKindList", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetInputKindList: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetInputKindList", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1884,17 +1963,11 @@ func (p *Proxy) GetInputKindList(ctx context.Context, req *obsgrpc.GetInputKindL
 	var (
 		resp *inputs.GetInputKindListResponse
 	)
-	for {
+	err = p.query(ctx, "GetInputKindList", func() error {
+		var err error
 		resp, err = client.Inputs.GetInputKindList(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1919,8 +1992,15 @@ func (p *Proxy) GetSpecialInputs(ctx context.Context, req *obsgrpc.GetSpecialInp
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSpecialInputs", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSpecialInputs: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSpecialInputs", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1932,17 +2012,11 @@ func (p *Proxy) GetSpecialInputs(ctx context.Context, req *obsgrpc.GetSpecialInp
 	var (
 		resp *inputs.GetSpecialInputsResponse
 	)
-	for {
+	err = p.query(ctx, "GetSpecialInputs", func() error {
+		var err error
 		resp, err = client.Inputs.GetSpecialInputs(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -1972,8 +2046,18 @@ func (p *Proxy) CreateInput(ctx context.Context, req *obsgrpc.CreateInputRequest
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "CreateInput", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/CreateInput: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "CreateInput", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("CreateInput", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -1985,8 +2069,8 @@ func (p *Proxy) CreateInput(ctx context.Context, req *obsgrpc.CreateInputRequest
 			return nil, fmt.Errorf("unable to convert field %s: %w", "InputSettings", err)
 		}
 		params = &inputs.CreateInputParams{
-			SceneName:        req.SceneName,
-			SceneUuid:        req.SceneUUID,
+			SceneName:        nilIfEmpty(req.GetSceneName()),
+			SceneUuid:        nilIfEmpty(req.GetSceneUUID()),
 			InputName:        ptr(req.InputName),
 			InputKind:        ptr(req.InputKind),
 			InputSettings:    inputSettings,
@@ -1996,17 +2080,11 @@ func (p *Proxy) CreateInput(ctx context.Context, req *obsgrpc.CreateInputRequest
 	var (
 		resp *inputs.CreateInputResponse
 	)
-	for {
+	err = p.query(ctx, "CreateInput", func() error {
+		var err error
 		resp, err = client.Inputs.CreateInput(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2032,8 +2110,18 @@ func (p *Proxy) RemoveInput(ctx context.Context, req *obsgrpc.RemoveInputRequest
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "RemoveInput", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/RemoveInput: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "RemoveInput", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("RemoveInput", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2041,24 +2129,18 @@ func (p *Proxy) RemoveInput(ctx context.Context, req *obsgrpc.RemoveInputRequest
 	params := &inputs.RemoveInputParams{}
 	if req != nil {
 		params = &inputs.RemoveInputParams{
-			InputName: req.InputName,
-			InputUuid: req.InputUUID,
+			InputName: nilIfEmpty(req.GetInputName()),
+			InputUuid: nilIfEmpty(req.GetInputUUID()),
 		}
 	}
 	var (
 		resp *inputs.RemoveInputResponse
 	)
-	for {
+	err = p.query(ctx, "RemoveInput", func() error {
+		var err error
 		resp, err = client.Inputs.RemoveInput(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2081,8 +2163,18 @@ func (p *Proxy) SetInputName(ctx context.Context, req *obsgrpc.SetInputNameReque
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetInputName", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetInputName: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetInputName", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetInputName", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2090,25 +2182,19 @@ func (p *Proxy) SetInputName(ctx context.Context, req *obsgrpc.SetInputNameReque
 	params := &inputs.SetInputNameParams{}
 	if req != nil {
 		params = &inputs.SetInputNameParams{
-			InputName:    req.InputName,
-			InputUuid:    req.InputUUID,
+			InputName:    nilIfEmpty(req.GetInputName()),
+			InputUuid:    nilIfEmpty(req.GetInputUUID()),
 			NewInputName: ptr(req.NewInputName),
 		}
 	}
 	var (
 		resp *inputs.SetInputNameResponse
 	)
-	for {
+	err = p.query(ctx, "SetInputName", func() error {
+		var err error
 		resp, err = client.Inputs.SetInputName(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2131,8 +2217,15 @@ func (p *Proxy) GetInputDefaultSettings(ctx context.Context, req *obsgrpc.GetInp
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetInputDefaultSettings", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetInputDefaultSettings: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetInputDefaultSettings", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2146,17 +2239,11 @@ func (p *Proxy) GetInputDefaultSettings(ctx context.Context, req *obsgrpc.GetInp
 	var (
 		resp *inputs.GetInputDefaultSettingsResponse
 	)
-	for {
+	err = p.query(ctx, "GetInputDefaultSettings", func() error {
+		var err error
 		resp, err = client.Inputs.GetInputDefaultSettings(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2181,8 +2268,18 @@ func (p *Proxy) GetInputSettings(ctx context.Context, req *obsgrpc.GetInputSetti
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetInputSettings", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetInputSettings: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetInputSettings", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetInputSettings", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2190,24 +2287,18 @@ func (p *Proxy) GetInputSettings(ctx context.Context, req *obsgrpc.GetInputSetti
 	params := &inputs.GetInputSettingsParams{}
 	if req != nil {
 		params = &inputs.GetInputSettingsParams{
-			InputName: req.InputName,
-			InputUuid: req.InputUUID,
+			InputName: nilIfEmpty(req.GetInputName()),
+			InputUuid: nilIfEmpty(req.GetInputUUID()),
 		}
 	}
 	var (
 		resp *inputs.GetInputSettingsResponse
 	)
-	for {
+	err = p.query(ctx, "GetInputSettings", func() error {
+		var err error
 		resp, err = client.Inputs.GetInputSettings(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2233,8 +2324,18 @@ func (p *Proxy) SetInputSettings(ctx context.Context, req *obsgrpc.SetInputSetti
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetInputSettings", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetInputSettings: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetInputSettings", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetInputSettings", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2246,8 +2347,8 @@ func (p *Proxy) SetInputSettings(ctx context.Context, req *obsgrpc.SetInputSetti
 			return nil, fmt.Errorf("unable to convert field %s: %w", "InputSettings", err)
 		}
 		params = &inputs.SetInputSettingsParams{
-			InputName:     req.InputName,
-			InputUuid:     req.InputUUID,
+			InputName:     nilIfEmpty(req.GetInputName()),
+			InputUuid:     nilIfEmpty(req.GetInputUUID()),
 			InputSettings: inputSettings,
 			Overlay:       req.Overlay,
 		}
@@ -2255,17 +2356,11 @@ func (p *Proxy) SetInputSettings(ctx context.Context, req *obsgrpc.SetInputSetti
 	var (
 		resp *inputs.SetInputSettingsResponse
 	)
-	for {
+	err = p.query(ctx, "SetInputSettings", func() error {
+		var err error
 		resp, err = client.Inputs.SetInputSettings(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2288,8 +2383,18 @@ func (p *Proxy) GetInputMute(ctx context.Context, req *obsgrpc.GetInputMuteReque
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetInputMute", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetInputMute: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetInputMute", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetInputMute", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2297,24 +2402,18 @@ func (p *Proxy) GetInputMute(ctx context.Context, req *obsgrpc.GetInputMuteReque
 	params := &inputs.GetInputMuteParams{}
 	if req != nil {
 		params = &inputs.GetInputMuteParams{
-			InputName: req.InputName,
-			InputUuid: req.InputUUID,
+			InputName: nilIfEmpty(req.GetInputName()),
+			InputUuid: nilIfEmpty(req.GetInputUUID()),
 		}
 	}
 	var (
 		resp *inputs.GetInputMuteResponse
 	)
-	for {
+	err = p.query(ctx, "GetInputMute", func() error {
+		var err error
 		resp, err = client.Inputs.GetInputMute(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2339,8 +2438,18 @@ func (p *Proxy) SetInputMute(ctx context.Context, req *obsgrpc.SetInputMuteReque
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetInputMute", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetInputMute: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetInputMute", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetInputMute", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2348,25 +2457,19 @@ func (p *Proxy) SetInputMute(ctx context.Context, req *obsgrpc.SetInputMuteReque
 	params := &inputs.SetInputMuteParams{}
 	if req != nil {
 		params = &inputs.SetInputMuteParams{
-			InputName:  req.InputName,
-			InputUuid:  req.InputUUID,
+			InputName:  nilIfEmpty(req.GetInputName()),
+			InputUuid:  nilIfEmpty(req.GetInputUUID()),
 			InputMuted: ptr(req.InputMuted),
 		}
 	}
 	var (
 		resp *inputs.SetInputMuteResponse
 	)
-	for {
+	err = p.query(ctx, "SetInputMute", func() error {
+		var err error
 		resp, err = client.Inputs.SetInputMute(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2389,8 +2492,18 @@ func (p *Proxy) ToggleInputMute(ctx context.Context, req *obsgrpc.ToggleInputMut
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "ToggleInputMute", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/ToggleInputMute: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "ToggleInputMute", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("ToggleInputMute", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2398,24 +2511,18 @@ func (p *Proxy) ToggleInputMute(ctx context.Context, req *obsgrpc.ToggleInputMut
 	params := &inputs.ToggleInputMuteParams{}
 	if req != nil {
 		params = &inputs.ToggleInputMuteParams{
-			InputName: req.InputName,
-			InputUuid: req.InputUUID,
+			InputName: nilIfEmpty(req.GetInputName()),
+			InputUuid: nilIfEmpty(req.GetInputUUID()),
 		}
 	}
 	var (
 		resp *inputs.ToggleInputMuteResponse
 	)
-	for {
+	err = p.query(ctx, "ToggleInputMute", func() error {
+		var err error
 		resp, err = client.Inputs.ToggleInputMute(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2440,8 +2547,18 @@ func (p *Proxy) GetInputVolume(ctx context.Context, req *obsgrpc.GetInputVolumeR
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetInputVolume", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetInputVolume: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetInputVolume", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetInputVolume", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2449,24 +2566,18 @@ func (p *Proxy) GetInputVolume(ctx context.Context, req *obsgrpc.GetInputVolumeR
 	params := &inputs.GetInputVolumeParams{}
 	if req != nil {
 		params = &inputs.GetInputVolumeParams{
-			InputName: req.InputName,
-			InputUuid: req.InputUUID,
+			InputName: nilIfEmpty(req.GetInputName()),
+			InputUuid: nilIfEmpty(req.GetInputUUID()),
 		}
 	}
 	var (
 		resp *inputs.GetInputVolumeResponse
 	)
-	for {
+	err = p.query(ctx, "GetInputVolume", func() error {
+		var err error
 		resp, err = client.Inputs.GetInputVolume(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2474,8 +2585,8 @@ func (p *Proxy) GetInputVolume(ctx context.Context, req *obsgrpc.GetInputVolumeR
 		return nil, fmt.Errorf("internal error: resp is nil")
 	}
 	result := &obsgrpc.GetInputVolumeResponse{
-		InputVolumeMul: (int64)(resp.InputVolumeMul),
-		InputVolumeDb:  (int64)(resp.InputVolumeDb),
+		InputVolumeMul: resp.InputVolumeMul,
+		InputVolumeDb:  resp.InputVolumeDb,
 	}
 	return result, nil
 }
@@ -2492,8 +2603,18 @@ func (p *Proxy) SetInputVolume(ctx context.Context, req *obsgrpc.SetInputVolumeR
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetInputVolume", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetInputVolume: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetInputVolume", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetInputVolume", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2501,26 +2622,20 @@ func (p *Proxy) SetInputVolume(ctx context.Context, req *obsgrpc.SetInputVolumeR
 	params := &inputs.SetInputVolumeParams{}
 	if req != nil {
 		params = &inputs.SetInputVolumeParams{
-			InputName:      req.InputName,
-			InputUuid:      req.InputUUID,
-			InputVolumeMul: ptrInt64ToFloat64(req.InputVolumeMul),
-			InputVolumeDb:  ptrInt64ToFloat64(req.InputVolumeDb),
+			InputName:      nilIfEmpty(req.GetInputName()),
+			InputUuid:      nilIfEmpty(req.GetInputUUID()),
+			InputVolumeMul: req.InputVolumeMul,
+			InputVolumeDb:  req.InputVolumeDb,
 		}
 	}
 	var (
 		resp *inputs.SetInputVolumeResponse
 	)
-	for {
+	err = p.query(ctx, "SetInputVolume", func() error {
+		var err error
 		resp, err = client.Inputs.SetInputVolume(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2543,8 +2658,18 @@ func (p *Proxy) GetInputAudioBalance(ctx context.Context, req *obsgrpc.GetInputA
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetInputAudioBalance", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetInputAudioBalance: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetInputAudioBalance", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetInputAudioBalance", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2552,24 +2677,18 @@ func (p *Proxy) GetInputAudioBalance(ctx context.Context, req *obsgrpc.GetInputA
 	params := &inputs.GetInputAudioBalanceParams{}
 	if req != nil {
 		params = &inputs.GetInputAudioBalanceParams{
-			InputName: req.InputName,
-			InputUuid: req.InputUUID,
+			InputName: nilIfEmpty(req.GetInputName()),
+			InputUuid: nilIfEmpty(req.GetInputUUID()),
 		}
 	}
 	var (
 		resp *inputs.GetInputAudioBalanceResponse
 	)
-	for {
+	err = p.query(ctx, "GetInputAudioBalance", func() error {
+		var err error
 		resp, err = client.Inputs.GetInputAudioBalance(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2594,8 +2713,18 @@ func (p *Proxy) SetInputAudioBalance(ctx context.Context, req *obsgrpc.SetInputA
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetInputAudioBalance", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetInputAudioBalance: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetInputAudioBalance", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetInputAudioBalance", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2603,25 +2732,19 @@ func (p *Proxy) SetInputAudioBalance(ctx context.Context, req *obsgrpc.SetInputA
 	params := &inputs.SetInputAudioBalanceParams{}
 	if req != nil {
 		params = &inputs.SetInputAudioBalanceParams{
-			InputName:         req.InputName,
-			InputUuid:         req.InputUUID,
+			InputName:         nilIfEmpty(req.GetInputName()),
+			InputUuid:         nilIfEmpty(req.GetInputUUID()),
 			InputAudioBalance: ptr(req.InputAudioBalance),
 		}
 	}
 	var (
 		resp *inputs.SetInputAudioBalanceResponse
 	)
-	for {
+	err = p.query(ctx, "SetInputAudioBalance", func() error {
+		var err error
 		resp, err = client.Inputs.SetInputAudioBalance(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2644,8 +2767,18 @@ func (p *Proxy) GetInputAudioSyncOffset(ctx context.Context, req *obsgrpc.GetInp
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetInputAudioSyncOffset", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetInputAudioSyncOffset: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetInputAudioSyncOffset", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetInputAudioSyncOffset", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2653,24 +2786,18 @@ func (p *Proxy) GetInputAudioSyncOffset(ctx context.Context, req *obsgrpc.GetInp
 	params := &inputs.GetInputAudioSyncOffsetParams{}
 	if req != nil {
 		params = &inputs.GetInputAudioSyncOffsetParams{
-			InputName: req.InputName,
-			InputUuid: req.InputUUID,
+			InputName: nilIfEmpty(req.GetInputName()),
+			InputUuid: nilIfEmpty(req.GetInputUUID()),
 		}
 	}
 	var (
 		resp *inputs.GetInputAudioSyncOffsetResponse
 	)
-	for {
+	err = p.query(ctx, "GetInputAudioSyncOffset", func() error {
+		var err error
 		resp, err = client.Inputs.GetInputAudioSyncOffset(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2695,8 +2822,18 @@ func (p *Proxy) SetInputAudioSyncOffset(ctx context.Context, req *obsgrpc.SetInp
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetInputAudioSyncOffset", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetInputAudioSyncOffset: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetInputAudioSyncOffset", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetInputAudioSyncOffset", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2704,25 +2841,19 @@ func (p *Proxy) SetInputAudioSyncOffset(ctx context.Context, req *obsgrpc.SetInp
 	params := &inputs.SetInputAudioSyncOffsetParams{}
 	if req != nil {
 		params = &inputs.SetInputAudioSyncOffsetParams{
-			InputName:            req.InputName,
-			InputUuid:            req.InputUUID,
+			InputName:            nilIfEmpty(req.GetInputName()),
+			InputUuid:            nilIfEmpty(req.GetInputUUID()),
 			InputAudioSyncOffset: ptr((float64)(req.InputAudioSyncOffset)),
 		}
 	}
 	var (
 		resp *inputs.SetInputAudioSyncOffsetResponse
 	)
-	for {
+	err = p.query(ctx, "SetInputAudioSyncOffset", func() error {
+		var err error
 		resp, err = client.Inputs.SetInputAudioSyncOffset(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2745,8 +2876,18 @@ func (p *Proxy) GetInputAudioMonitorType(ctx context.Context, req *obsgrpc.GetIn
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetInputAudioMonitorType", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetInputAudioMonitorType: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetInputAudioMonitorType", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetInputAudioMonitorType", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2754,24 +2895,18 @@ func (p *Proxy) GetInputAudioMonitorType(ctx context.Context, req *obsgrpc.GetIn
 	params := &inputs.GetInputAudioMonitorTypeParams{}
 	if req != nil {
 		params = &inputs.GetInputAudioMonitorTypeParams{
-			InputName: req.InputName,
-			InputUuid: req.InputUUID,
+			InputName: nilIfEmpty(req.GetInputName()),
+			InputUuid: nilIfEmpty(req.GetInputUUID()),
 		}
 	}
 	var (
 		resp *inputs.GetInputAudioMonitorTypeResponse
 	)
-	for {
+	err = p.query(ctx, "GetInputAudioMonitorType", func() error {
+		var err error
 		resp, err = client.Inputs.GetInputAudioMonitorType(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2796,8 +2931,18 @@ func (p *Proxy) SetInputAudioMonitorType(ctx context.Context, req *obsgrpc.SetIn
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetInputAudioMonitorType", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetInputAudioMonitorType: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetInputAudioMonitorType", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetInputAudioMonitorType", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2805,25 +2950,19 @@ func (p *Proxy) SetInputAudioMonitorType(ctx context.Context, req *obsgrpc.SetIn
 	params := &inputs.SetInputAudioMonitorTypeParams{}
 	if req != nil {
 		params = &inputs.SetInputAudioMonitorTypeParams{
-			InputName:   req.InputName,
-			InputUuid:   req.InputUUID,
+			InputName:   nilIfEmpty(req.GetInputName()),
+			InputUuid:   nilIfEmpty(req.GetInputUUID()),
 			MonitorType: ptr((string)(req.MonitorType)),
 		}
 	}
 	var (
 		resp *inputs.SetInputAudioMonitorTypeResponse
 	)
-	for {
+	err = p.query(ctx, "SetInputAudioMonitorType", func() error {
+		var err error
 		resp, err = client.Inputs.SetInputAudioMonitorType(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2846,8 +2985,18 @@ func (p *Proxy) GetInputAudioTracks(ctx context.Context, req *obsgrpc.GetInputAu
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetInputAudioTracks", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetInputAudioTracks: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetInputAudioTracks", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetInputAudioTracks", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2855,24 +3004,18 @@ func (p *Proxy) GetInputAudioTracks(ctx context.Context, req *obsgrpc.GetInputAu
 	params := &inputs.GetInputAudioTracksParams{}
 	if req != nil {
 		params = &inputs.GetInputAudioTracksParams{
-			InputName: req.InputName,
-			InputUuid: req.InputUUID,
+			InputName: nilIfEmpty(req.GetInputName()),
+			InputUuid: nilIfEmpty(req.GetInputUUID()),
 		}
 	}
 	var (
 		resp *inputs.GetInputAudioTracksResponse
 	)
-	for {
+	err = p.query(ctx, "GetInputAudioTracks", func() error {
+		var err error
 		resp, err = client.Inputs.GetInputAudioTracks(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2897,8 +3040,18 @@ func (p *Proxy) SetInputAudioTracks(ctx context.Context, req *obsgrpc.SetInputAu
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetInputAudioTracks", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetInputAudioTracks: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetInputAudioTracks", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetInputAudioTracks", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2910,25 +3063,19 @@ func (p *Proxy) SetInputAudioTracks(ctx context.Context, req *obsgrpc.SetInputAu
 			return nil, fmt.Errorf("unable to convert field %s: %w", "InputAudioTracks", err)
 		}
 		params = &inputs.SetInputAudioTracksParams{
-			InputName:        req.InputName,
-			InputUuid:        req.InputUUID,
+			InputName:        nilIfEmpty(req.GetInputName()),
+			InputUuid:        nilIfEmpty(req.GetInputUUID()),
 			InputAudioTracks: inputAudioTracks,
 		}
 	}
 	var (
 		resp *inputs.SetInputAudioTracksResponse
 	)
-	for {
+	err = p.query(ctx, "SetInputAudioTracks", func() error {
+		var err error
 		resp, err = client.Inputs.SetInputAudioTracks(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -2951,8 +3098,18 @@ func (p *Proxy) GetInputPropertiesListPropertyItems(ctx context.Context, req *ob
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetInputPropertiesListPropertyItems", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetInputPropertiesListPropertyItems: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetInputPropertiesListPropertyItems", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetInputPropertiesListPropertyItems", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -2960,25 +3117,19 @@ func (p *Proxy) GetInputPropertiesListPropertyItems(ctx context.Context, req *ob
 	params := &inputs.GetInputPropertiesListPropertyItemsParams{}
 	if req != nil {
 		params = &inputs.GetInputPropertiesListPropertyItemsParams{
-			InputName:    req.InputName,
-			InputUuid:    req.InputUUID,
+			InputName:    nilIfEmpty(req.GetInputName()),
+			InputUuid:    nilIfEmpty(req.GetInputUUID()),
 			PropertyName: ptr(req.PropertyName),
 		}
 	}
 	var (
 		resp *inputs.GetInputPropertiesListPropertyItemsResponse
 	)
-	for {
+	err = p.query(ctx, "GetInputPropertiesListPropertyItems", func() error {
+		var err error
 		resp, err = client.Inputs.GetInputPropertiesListPropertyItems(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3003,8 +3154,18 @@ func (p *Proxy) PressInputPropertiesButton(ctx context.Context, req *obsgrpc.Pre
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "PressInputPropertiesButton", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/PressInputPropertiesButton: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "PressInputPropertiesButton", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("PressInputPropertiesButton", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3012,25 +3173,19 @@ func (p *Proxy) PressInputPropertiesButton(ctx context.Context, req *obsgrpc.Pre
 	params := &inputs.PressInputPropertiesButtonParams{}
 	if req != nil {
 		params = &inputs.PressInputPropertiesButtonParams{
-			InputName:    req.InputName,
-			InputUuid:    req.InputUUID,
+			InputName:    nilIfEmpty(req.GetInputName()),
+			InputUuid:    nilIfEmpty(req.GetInputUUID()),
 			PropertyName: ptr(req.PropertyName),
 		}
 	}
 	var (
 		resp *inputs.PressInputPropertiesButtonResponse
 	)
-	for {
+	err = p.query(ctx, "PressInputPropertiesButton", func() error {
+		var err error
 		resp, err = client.Inputs.PressInputPropertiesButton(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3053,8 +3208,18 @@ func (p *Proxy) GetMediaInputStatus(ctx context.Context, req *obsgrpc.GetMediaIn
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetMediaInputStatus", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetMediaInputStatus: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetMediaInputStatus", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetMediaInputStatus", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3062,24 +3227,18 @@ func (p *Proxy) GetMediaInputStatus(ctx context.Context, req *obsgrpc.GetMediaIn
 	params := &mediainputs.GetMediaInputStatusParams{}
 	if req != nil {
 		params = &mediainputs.GetMediaInputStatusParams{
-			InputName: req.InputName,
-			InputUuid: req.InputUUID,
+			InputName: nilIfEmpty(req.GetInputName()),
+			InputUuid: nilIfEmpty(req.GetInputUUID()),
 		}
 	}
 	var (
 		resp *mediainputs.GetMediaInputStatusResponse
 	)
-	for {
+	err = p.query(ctx, "GetMediaInputStatus", func() error {
+		var err error
 		resp, err = client.MediaInputs.GetMediaInputStatus(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3106,8 +3265,18 @@ func (p *Proxy) SetMediaInputCursor(ctx context.Context, req *obsgrpc.SetMediaIn
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetMediaInputCursor", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetMediaInputCursor: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetMediaInputCursor", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetMediaInputCursor", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3115,25 +3284,19 @@ func (p *Proxy) SetMediaInputCursor(ctx context.Context, req *obsgrpc.SetMediaIn
 	params := &mediainputs.SetMediaInputCursorParams{}
 	if req != nil {
 		params = &mediainputs.SetMediaInputCursorParams{
-			InputName:   req.InputName,
-			InputUuid:   req.InputUUID,
+			InputName:   nilIfEmpty(req.GetInputName()),
+			InputUuid:   nilIfEmpty(req.GetInputUUID()),
 			MediaCursor: ptr((float64)(req.MediaCursor)),
 		}
 	}
 	var (
 		resp *mediainputs.SetMediaInputCursorResponse
 	)
-	for {
+	err = p.query(ctx, "SetMediaInputCursor", func() error {
+		var err error
 		resp, err = client.MediaInputs.SetMediaInputCursor(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3156,8 +3319,18 @@ func (p *Proxy) OffsetMediaInputCursor(ctx context.Context, req *obsgrpc.OffsetM
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "OffsetMediaInputCursor", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/OffsetMediaInputCursor: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "OffsetMediaInputCursor", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("OffsetMediaInputCursor", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3165,25 +3338,19 @@ func (p *Proxy) OffsetMediaInputCursor(ctx context.Context, req *obsgrpc.OffsetM
 	params := &mediainputs.OffsetMediaInputCursorParams{}
 	if req != nil {
 		params = &mediainputs.OffsetMediaInputCursorParams{
-			InputName:         req.InputName,
-			InputUuid:         req.InputUUID,
+			InputName:         nilIfEmpty(req.GetInputName()),
+			InputUuid:         nilIfEmpty(req.GetInputUUID()),
 			MediaCursorOffset: ptr((float64)(req.MediaCursorOffset)),
 		}
 	}
 	var (
 		resp *mediainputs.OffsetMediaInputCursorResponse
 	)
-	for {
+	err = p.query(ctx, "OffsetMediaInputCursor", func() error {
+		var err error
 		resp, err = client.MediaInputs.OffsetMediaInputCursor(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3206,8 +3373,18 @@ func (p *Proxy) TriggerMediaInputAction(ctx context.Context, req *obsgrpc.Trigge
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "TriggerMediaInputAction", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/TriggerMediaInputAction: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "TriggerMediaInputAction", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("TriggerMediaInputAction", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3215,25 +3392,19 @@ func (p *Proxy) TriggerMediaInputAction(ctx context.Context, req *obsgrpc.Trigge
 	params := &mediainputs.TriggerMediaInputActionParams{}
 	if req != nil {
 		params = &mediainputs.TriggerMediaInputActionParams{
-			InputName:   req.InputName,
-			InputUuid:   req.InputUUID,
+			InputName:   nilIfEmpty(req.GetInputName()),
+			InputUuid:   nilIfEmpty(req.GetInputUUID()),
 			MediaAction: ptr(req.MediaAction),
 		}
 	}
 	var (
 		resp *mediainputs.TriggerMediaInputActionResponse
 	)
-	for {
+	err = p.query(ctx, "TriggerMediaInputAction", func() error {
+		var err error
 		resp, err = client.MediaInputs.TriggerMediaInputAction(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3256,8 +3427,15 @@ func (p *Proxy) GetVirtualCamStatus(ctx context.Context, req *obsgrpc.GetVirtual
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetVirtualCamStatus", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetVirtualCamStatus: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetVirtualCamStatus", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3269,17 +3447,11 @@ func (p *Proxy) GetVirtualCamStatus(ctx context.Context, req *obsgrpc.GetVirtual
 	var (
 		resp *outputs.GetVirtualCamStatusResponse
 	)
-	for {
+	err = p.query(ctx, "GetVirtualCamStatus", func() error {
+		var err error
 		resp, err = client.Outputs.GetVirtualCamStatus(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3304,8 +3476,15 @@ func (p *Proxy) ToggleVirtualCam(ctx context.Context, req *obsgrpc.ToggleVirtual
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "ToggleVirtualCam", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/ToggleVirtualCam: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "ToggleVirtualCam", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3317,17 +3496,11 @@ func (p *Proxy) ToggleVirtualCam(ctx context.Context, req *obsgrpc.ToggleVirtual
 	var (
 		resp *outputs.ToggleVirtualCamResponse
 	)
-	for {
+	err = p.query(ctx, "ToggleVirtualCam", func() error {
+		var err error
 		resp, err = client.Outputs.ToggleVirtualCam(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3352,8 +3525,15 @@ func (p *Proxy) StartVirtualCam(ctx context.Context, req *obsgrpc.StartVirtualCa
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "StartVirtualCam", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/StartVirtualCam: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "StartVirtualCam", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3365,17 +3545,11 @@ func (p *Proxy) StartVirtualCam(ctx context.Context, req *obsgrpc.StartVirtualCa
 	var (
 		resp *outputs.StartVirtualCamResponse
 	)
-	for {
+	err = p.query(ctx, "StartVirtualCam", func() error {
+		var err error
 		resp, err = client.Outputs.StartVirtualCam(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3398,8 +3572,15 @@ func (p *Proxy) StopVirtualCam(ctx context.Context, req *obsgrpc.StopVirtualCamR
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "StopVirtualCam", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/StopVirtualCam: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "StopVirtualCam", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3411,17 +3592,11 @@ func (p *Proxy) StopVirtualCam(ctx context.Context, req *obsgrpc.StopVirtualCamR
 	var (
 		resp *outputs.StopVirtualCamResponse
 	)
-	for {
+	err = p.query(ctx, "StopVirtualCam", func() error {
+		var err error
 		resp, err = client.Outputs.StopVirtualCam(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3444,8 +3619,15 @@ func (p *Proxy) GetReplayBufferStatus(ctx context.Context, req *obsgrpc.GetRepla
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetReplayBufferStatus", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetReplayBufferStatus: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetReplayBufferStatus", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3457,17 +3639,11 @@ func (p *Proxy) GetReplayBufferStatus(ctx context.Context, req *obsgrpc.GetRepla
 	var (
 		resp *outputs.GetReplayBufferStatusResponse
 	)
-	for {
+	err = p.query(ctx, "GetReplayBufferStatus", func() error {
+		var err error
 		resp, err = client.Outputs.GetReplayBufferStatus(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3492,8 +3668,15 @@ func (p *Proxy) ToggleReplayBuffer(ctx context.Context, req *obsgrpc.ToggleRepla
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "ToggleReplayBuffer", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/ToggleReplayBuffer: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "ToggleReplayBuffer", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3505,17 +3688,11 @@ func (p *Proxy) ToggleReplayBuffer(ctx context.Context, req *obsgrpc.ToggleRepla
 	var (
 		resp *outputs.ToggleReplayBufferResponse
 	)
-	for {
+	err = p.query(ctx, "ToggleReplayBuffer", func() error {
+		var err error
 		resp, err = client.Outputs.ToggleReplayBuffer(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3540,8 +3717,15 @@ func (p *Proxy) StartReplayBuffer(ctx context.Context, req *obsgrpc.StartReplayB
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "StartReplayBuffer", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/StartReplayBuffer: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "StartReplayBuffer", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3553,17 +3737,11 @@ func (p *Proxy) StartReplayBuffer(ctx context.Context, req *obsgrpc.StartReplayB
 	var (
 		resp *outputs.StartReplayBufferResponse
 	)
-	for {
+	err = p.query(ctx, "StartReplayBuffer", func() error {
+		var err error
 		resp, err = client.Outputs.StartReplayBuffer(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3586,8 +3764,15 @@ func (p *Proxy) StopReplayBuffer(ctx context.Context, req *obsgrpc.StopReplayBuf
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "StopReplayBuffer", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/StopReplayBuffer: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "StopReplayBuffer", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3599,17 +3784,11 @@ func (p *Proxy) StopReplayBuffer(ctx context.Context, req *obsgrpc.StopReplayBuf
 	var (
 		resp *outputs.StopReplayBufferResponse
 	)
-	for {
+	err = p.query(ctx, "StopReplayBuffer", func() error {
+		var err error
 		resp, err = client.Outputs.StopReplayBuffer(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3632,8 +3811,15 @@ func (p *Proxy) SaveReplayBuffer(ctx context.Context, req *obsgrpc.SaveReplayBuf
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SaveReplayBuffer", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SaveReplayBuffer: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SaveReplayBuffer", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3645,17 +3831,11 @@ func (p *Proxy) SaveReplayBuffer(ctx context.Context, req *obsgrpc.SaveReplayBuf
 	var (
 		resp *outputs.SaveReplayBufferResponse
 	)
-	for {
+	err = p.query(ctx, "SaveReplayBuffer", func() error {
+		var err error
 		resp, err = client.Outputs.SaveReplayBuffer(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3678,8 +3858,15 @@ func (p *Proxy) GetLastReplayBufferReplay(ctx context.Context, req *obsgrpc.GetL
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetLastReplayBufferReplay", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetLastReplayBufferReplay: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetLastReplayBufferReplay", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3691,17 +3878,11 @@ func (p *Proxy) GetLastReplayBufferReplay(ctx context.Context, req *obsgrpc.GetL
 	var (
 		resp *outputs.GetLastReplayBufferReplayResponse
 	)
-	for {
+	err = p.query(ctx, "GetLastReplayBufferReplay", func() error {
+		var err error
 		resp, err = client.Outputs.GetLastReplayBufferReplay(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3726,8 +3907,15 @@ func (p *Proxy) GetOutputList(ctx context.Context, req *obsgrpc.GetOutputListReq
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetOutputList", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetOutputList: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetOutputList", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3739,17 +3927,11 @@ func (p *Proxy) GetOutputList(ctx context.Context, req *obsgrpc.GetOutputListReq
 	var (
 		resp *outputs.GetOutputListResponse
 	)
-	for {
+	err = p.query(ctx, "GetOutputList", func() error {
+		var err error
 		resp, err = client.Outputs.GetOutputList(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3774,8 +3956,15 @@ func (p *Proxy) GetOutputStatus(ctx context.Context, req *obsgrpc.GetOutputStatu
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetOutputStatus", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetOutputStatus: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetOutputStatus", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3789,17 +3978,11 @@ func (p *Proxy) GetOutputStatus(ctx context.Context, req *obsgrpc.GetOutputStatu
 	var (
 		resp *outputs.GetOutputStatusResponse
 	)
-	for {
+	err = p.query(ctx, "GetOutputStatus", func() error {
+		var err error
 		resp, err = client.Outputs.GetOutputStatus(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3831,8 +4014,15 @@ func (p *Proxy) ToggleOutput(ctx context.Context, req *obsgrpc.ToggleOutputReque
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "ToggleOutput", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/ToggleOutput: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "ToggleOutput", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3846,17 +4036,11 @@ func (p *Proxy) ToggleOutput(ctx context.Context, req *obsgrpc.ToggleOutputReque
 	var (
 		resp *outputs.ToggleOutputResponse
 	)
-	for {
+	err = p.query(ctx, "ToggleOutput", func() error {
+		var err error
 		resp, err = client.Outputs.ToggleOutput(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3881,8 +4065,15 @@ func (p *Proxy) StartOutput(ctx context.Context, req *obsgrpc.StartOutputRequest
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "StartOutput", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/StartOutput: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "StartOutput", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3896,17 +4087,11 @@ func (p *Proxy) StartOutput(ctx context.Context, req *obsgrpc.StartOutputRequest
 	var (
 		resp *outputs.StartOutputResponse
 	)
-	for {
+	err = p.query(ctx, "StartOutput", func() error {
+		var err error
 		resp, err = client.Outputs.StartOutput(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3929,8 +4114,15 @@ func (p *Proxy) StopOutput(ctx context.Context, req *obsgrpc.StopOutputRequest)
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "StopOutput", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/StopOutput: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "StopOutput", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3944,17 +4136,11 @@ func (p *Proxy) StopOutput(ctx context.Context, req *obsgrpc.StopOutputRequest)
 	var (
 		resp *outputs.StopOutputResponse
 	)
-	for {
+	err = p.query(ctx, "StopOutput", func() error {
+		var err error
 		resp, err = client.Outputs.StopOutput(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -3977,8 +4163,15 @@ func (p *Proxy) GetOutputSettings(ctx context.Context, req *obsgrpc.GetOutputSet
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetOutputSettings", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetOutputSettings: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetOutputSettings", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -3992,17 +4185,11 @@ func (p *Proxy) GetOutputSettings(ctx context.Context, req *obsgrpc.GetOutputSet
 	var (
 		resp *outputs.GetOutputSettingsResponse
 	)
-	for {
+	err = p.query(ctx, "GetOutputSettings", func() error {
+		var err error
 		resp, err = client.Outputs.GetOutputSettings(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4027,8 +4214,18 @@ func (p *Proxy) SetOutputSettings(ctx context.Context, req *obsgrpc.SetOutputSet
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetOutputSettings", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetOutputSettings: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetOutputSettings", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetOutputSettings", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4047,17 +4244,11 @@ func (p *Proxy) SetOutputSettings(ctx context.Context, req *obsgrpc.SetOutputSet
 	var (
 		resp *outputs.SetOutputSettingsResponse
 	)
-	for {
+	err = p.query(ctx, "SetOutputSettings", func() error {
+		var err error
 		resp, err = client.Outputs.SetOutputSettings(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4080,8 +4271,15 @@ func (p *Proxy) GetRecordStatus(ctx context.Context, req *obsgrpc.GetRecordStatu
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetRecordStatus", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetRecordStatus: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetRecordStatus", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4093,17 +4291,11 @@ func (p *Proxy) GetRecordStatus(ctx context.Context, req *obsgrpc.GetRecordStatu
 	var (
 		resp *record.GetRecordStatusResponse
 	)
-	for {
+	err = p.query(ctx, "GetRecordStatus", func() error {
+		var err error
 		resp, err = client.Record.GetRecordStatus(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4132,8 +4324,15 @@ func (p *Proxy) ToggleRecord(ctx context.Context, req *obsgrpc.ToggleRecordReque
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "ToggleRecord", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/ToggleRecord: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "ToggleRecord", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4145,17 +4344,11 @@ func (p *Proxy) ToggleRecord(ctx context.Context, req *obsgrpc.ToggleRecordReque
 	var (
 		resp *record.ToggleRecordResponse
 	)
-	for {
+	err = p.query(ctx, "ToggleRecord", func() error {
+		var err error
 		resp, err = client.Record.ToggleRecord(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4180,8 +4373,15 @@ func (p *Proxy) StartRecord(ctx context.Context, req *obsgrpc.StartRecordRequest
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "StartRecord", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/StartRecord: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "StartRecord", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4193,17 +4393,11 @@ func (p *Proxy) StartRecord(ctx context.Context, req *obsgrpc.StartRecordRequest
 	var (
 		resp *record.StartRecordResponse
 	)
-	for {
+	err = p.query(ctx, "StartRecord", func() error {
+		var err error
 		resp, err = client.Record.StartRecord(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4226,8 +4420,15 @@ func (p *Proxy) StopRecord(ctx context.Context, req *obsgrpc.StopRecordRequest)
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "StopRecord", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/StopRecord: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "StopRecord", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4239,17 +4440,11 @@ func (p *Proxy) StopRecord(ctx context.Context, req *obsgrpc.StopRecordRequest)
 	var (
 		resp *record.StopRecordResponse
 	)
-	for {
+	err = p.query(ctx, "StopRecord", func() error {
+		var err error
 		resp, err = client.Record.StopRecord(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4274,8 +4469,15 @@ func (p *Proxy) ToggleRecordPause(ctx context.Context, req *obsgrpc.ToggleRecord
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "ToggleRecordPause", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/ToggleRecordPause: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "ToggleRecordPause", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4287,17 +4489,11 @@ func (p *Proxy) ToggleRecordPause(ctx context.Context, req *obsgrpc.ToggleRecord
 	var (
 		resp *record.ToggleRecordPauseResponse
 	)
-	for {
+	err = p.query(ctx, "ToggleRecordPause", func() error {
+		var err error
 		resp, err = client.Record.ToggleRecordPause(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4320,8 +4516,15 @@ func (p *Proxy) PauseRecord(ctx context.Context, req *obsgrpc.PauseRecordRequest
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "PauseRecord", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/PauseRecord: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "PauseRecord", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4333,17 +4536,11 @@ func (p *Proxy) PauseRecord(ctx context.Context, req *obsgrpc.PauseRecordRequest
 	var (
 		resp *record.PauseRecordResponse
 	)
-	for {
+	err = p.query(ctx, "PauseRecord", func() error {
+		var err error
 		resp, err = client.Record.PauseRecord(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4366,8 +4563,15 @@ func (p *Proxy) ResumeRecord(ctx context.Context, req *obsgrpc.ResumeRecordReque
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "ResumeRecord", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/ResumeRecord: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "ResumeRecord", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4379,17 +4583,11 @@ func (p *Proxy) ResumeRecord(ctx context.Context, req *obsgrpc.ResumeRecordReque
 	var (
 		resp *record.ResumeRecordResponse
 	)
-	for {
+	err = p.query(ctx, "ResumeRecord", func() error {
+		var err error
 		resp, err = client.Record.ResumeRecord(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4412,8 +4610,15 @@ func (p *Proxy) SplitRecordFile(ctx context.Context, req *obsgrpc.SplitRecordFil
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SplitRecordFile", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SplitRecordFile: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SplitRecordFile", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4425,17 +4630,11 @@ func (p *Proxy) SplitRecordFile(ctx context.Context, req *obsgrpc.SplitRecordFil
 	var (
 		resp *record.SplitRecordFileResponse
 	)
-	for {
+	err = p.query(ctx, "SplitRecordFile", func() error {
+		var err error
 		resp, err = client.Record.SplitRecordFile(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4458,8 +4657,15 @@ func (p *Proxy) CreateRecordChapter(ctx context.Context, req *obsgrpc.CreateReco
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "CreateRecordChapter", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/CreateRecordChapter: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "CreateRecordChapter", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4473,17 +4679,11 @@ func (p *Proxy) CreateRecordChapter(ctx context.Context, req *obsgrpc.CreateReco
 	var (
 		resp *record.CreateRecordChapterResponse
 	)
-	for {
+	err = p.query(ctx, "CreateRecordChapter", func() error {
+		var err error
 		resp, err = client.Record.CreateRecordChapter(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4506,8 +4706,18 @@ func (p *Proxy) GetSceneItemList(ctx context.Context, req *obsgrpc.GetSceneItemL
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSceneItemList", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSceneItemList: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSceneItemList", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetSceneItemList", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4515,24 +4725,18 @@ func (p *Proxy) GetSceneItemList(ctx context.Context, req *obsgrpc.GetSceneItemL
 	params := &sceneitems.GetSceneItemListParams{}
 	if req != nil {
 		params = &sceneitems.GetSceneItemListParams{
-			SceneName: req.SceneName,
-			SceneUuid: req.SceneUUID,
+			SceneName: nilIfEmpty(req.GetSceneName()),
+			SceneUuid: nilIfEmpty(req.GetSceneUUID()),
 		}
 	}
 	var (
 		resp *sceneitems.GetSceneItemListResponse
 	)
-	for {
+	err = p.query(ctx, "GetSceneItemList", func() error {
+		var err error
 		resp, err = client.SceneItems.GetSceneItemList(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4557,8 +4761,18 @@ func (p *Proxy) GetGroupSceneItemList(ctx context.Context, req *obsgrpc.GetGroup
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetGroupSceneItemList", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetGroupSceneItemList: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetGroupSceneItemList", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetGroupSceneItemList", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4566,24 +4780,18 @@ func (p *Proxy) GetGroupSceneItemList(ctx context.Context, req *obsgrpc.GetGroup
 	params := &sceneitems.GetGroupSceneItemListParams{}
 	if req != nil {
 		params = &sceneitems.GetGroupSceneItemListParams{
-			SceneName: req.SceneName,
-			SceneUuid: req.SceneUUID,
+			SceneName: nilIfEmpty(req.GetSceneName()),
+			SceneUuid: nilIfEmpty(req.GetSceneUUID()),
 		}
 	}
 	var (
 		resp *sceneitems.GetGroupSceneItemListResponse
 	)
-	for {
+	err = p.query(ctx, "GetGroupSceneItemList", func() error {
+		var err error
 		resp, err = client.SceneItems.GetGroupSceneItemList(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4608,8 +4816,18 @@ func (p *Proxy) GetSceneItemId(ctx context.Context, req *obsgrpc.GetSceneItemIdR
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSceneItemId", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSceneItemId: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSceneItemId", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetSceneItemId", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4617,8 +4835,8 @@ func (p *Proxy) GetSceneItemId(ctx context.Context, req *obsgrpc.GetSceneItemIdR
 	params := &sceneitems.GetSceneItemIdParams{}
 	if req != nil {
 		params = &sceneitems.GetSceneItemIdParams{
-			SceneName:    req.SceneName,
-			SceneUuid:    req.SceneUUID,
+			SceneName:    nilIfEmpty(req.GetSceneName()),
+			SceneUuid:    nilIfEmpty(req.GetSceneUUID()),
 			SourceName:   ptr(req.SourceName),
 			SearchOffset: ptrInt64ToFloat64(req.SearchOffset),
 		}
@@ -4626,17 +4844,11 @@ func (p *Proxy) GetSceneItemId(ctx context.Context, req *obsgrpc.GetSceneItemIdR
 	var (
 		resp *sceneitems.GetSceneItemIdResponse
 	)
-	for {
+	err = p.query(ctx, "GetSceneItemId", func() error {
+		var err error
 		resp, err = client.SceneItems.GetSceneItemId(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4661,8 +4873,18 @@ func (p *Proxy) GetSceneItemSource(ctx context.Context, req *obsgrpc.GetSceneIte
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSceneItemSource", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSceneItemSource: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSceneItemSource", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetSceneItemSource", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4670,25 +4892,19 @@ func (p *Proxy) GetSceneItemSource(ctx context.Context, req *obsgrpc.GetSceneIte
 	params := &sceneitems.GetSceneItemSourceParams{}
 	if req != nil {
 		params = &sceneitems.GetSceneItemSourceParams{
-			SceneName:   req.SceneName,
-			SceneUuid:   req.SceneUUID,
+			SceneName:   nilIfEmpty(req.GetSceneName()),
+			SceneUuid:   nilIfEmpty(req.GetSceneUUID()),
 			SceneItemId: ptr((int)(req.SceneItemID)),
 		}
 	}
 	var (
 		resp *sceneitems.GetSceneItemSourceResponse
 	)
-	for {
+	err = p.query(ctx, "GetSceneItemSource", func() error {
+		var err error
 		resp, err = client.SceneItems.GetSceneItemSource(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4714,8 +4930,18 @@ func (p *Proxy) CreateSceneItem(ctx context.Context, req *obsgrpc.CreateSceneIte
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "CreateSceneItem", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/CreateSceneItem: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "CreateSceneItem", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("CreateSceneItem", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4723,27 +4949,21 @@ func (p *Proxy) CreateSceneItem(ctx context.Context, req *obsgrpc.CreateSceneIte
 	params := &sceneitems.CreateSceneItemParams{}
 	if req != nil {
 		params = &sceneitems.CreateSceneItemParams{
-			SceneName:        req.SceneName,
-			SceneUuid:        req.SceneUUID,
-			SourceName:       req.SourceName,
-			SourceUuid:       req.SourceUUID,
+			SceneName:        nilIfEmpty(req.GetSceneName()),
+			SceneUuid:        nilIfEmpty(req.GetSceneUUID()),
+			SourceName:       nilIfEmpty(req.GetSourceName()),
+			SourceUuid:       nilIfEmpty(req.GetSourceUUID()),
 			SceneItemEnabled: req.SceneItemEnabled,
 		}
 	}
 	var (
 		resp *sceneitems.CreateSceneItemResponse
 	)
-	for {
+	err = p.query(ctx, "CreateSceneItem", func() error {
+		var err error
 		resp, err = client.SceneItems.CreateSceneItem(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4768,8 +4988,18 @@ func (p *Proxy) RemoveSceneItem(ctx context.Context, req *obsgrpc.RemoveSceneIte
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "RemoveSceneItem", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/RemoveSceneItem: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "RemoveSceneItem", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("RemoveSceneItem", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4777,25 +5007,19 @@ func (p *Proxy) RemoveSceneItem(ctx context.Context, req *obsgrpc.RemoveSceneIte
 	params := &sceneitems.RemoveSceneItemParams{}
 	if req != nil {
 		params = &sceneitems.RemoveSceneItemParams{
-			SceneName:   req.SceneName,
-			SceneUuid:   req.SceneUUID,
+			SceneName:   nilIfEmpty(req.GetSceneName()),
+			SceneUuid:   nilIfEmpty(req.GetSceneUUID()),
 			SceneItemId: ptr((int)(req.SceneItemID)),
 		}
 	}
 	var (
 		resp *sceneitems.RemoveSceneItemResponse
 	)
-	for {
+	err = p.query(ctx, "RemoveSceneItem", func() error {
+		var err error
 		resp, err = client.SceneItems.RemoveSceneItem(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4818,8 +5042,18 @@ func (p *Proxy) DuplicateSceneItem(ctx context.Context, req *obsgrpc.DuplicateSc
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "DuplicateSceneItem", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/DuplicateSceneItem: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "DuplicateSceneItem", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("DuplicateSceneItem", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4827,27 +5061,21 @@ func (p *Proxy) DuplicateSceneItem(ctx context.Context, req *obsgrpc.DuplicateSc
 	params := &sceneitems.DuplicateSceneItemParams{}
 	if req != nil {
 		params = &sceneitems.DuplicateSceneItemParams{
-			SceneName:            req.SceneName,
-			SceneUuid:            req.SceneUUID,
+			SceneName:            nilIfEmpty(req.GetSceneName()),
+			SceneUuid:            nilIfEmpty(req.GetSceneUUID()),
 			SceneItemId:          ptr((int)(req.SceneItemID)),
-			DestinationSceneName: req.DestinationSceneName,
-			DestinationSceneUuid: req.DestinationSceneUUID,
+			DestinationSceneName: nilIfEmpty(req.GetDestinationSceneName()),
+			DestinationSceneUuid: nilIfEmpty(req.GetDestinationSceneUUID()),
 		}
 	}
 	var (
 		resp *sceneitems.DuplicateSceneItemResponse
 	)
-	for {
+	err = p.query(ctx, "DuplicateSceneItem", func() error {
+		var err error
 		resp, err = client.SceneItems.DuplicateSceneItem(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4872,8 +5100,18 @@ func (p *Proxy) GetSceneItemTransform(ctx context.Context, req *obsgrpc.GetScene
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSceneItemTransform", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSceneItemTransform: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSceneItemTransform", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetSceneItemTransform", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4881,25 +5119,19 @@ func (p *Proxy) GetSceneItemTransform(ctx context.Context, req *obsgrpc.GetScene
 	params := &sceneitems.GetSceneItemTransformParams{}
 	if req != nil {
 		params = &sceneitems.GetSceneItemTransformParams{
-			SceneName:   req.SceneName,
-			SceneUuid:   req.SceneUUID,
+			SceneName:   nilIfEmpty(req.GetSceneName()),
+			SceneUuid:   nilIfEmpty(req.GetSceneUUID()),
 			SceneItemId: ptr((int)(req.SceneItemID)),
 		}
 	}
 	var (
 		resp *sceneitems.GetSceneItemTransformResponse
 	)
-	for {
+	err = p.query(ctx, "GetSceneItemTransform", func() error {
+		var err error
 		resp, err = client.SceneItems.GetSceneItemTransform(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4924,8 +5156,18 @@ func (p *Proxy) SetSceneItemTransform(ctx context.Context, req *obsgrpc.SetScene
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetSceneItemTransform", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetSceneItemTransform: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetSceneItemTransform", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetSceneItemTransform", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4937,8 +5179,8 @@ func (p *Proxy) SetSceneItemTransform(ctx context.Context, req *obsgrpc.SetScene
 			return nil, fmt.Errorf("unable to convert field %s: %w", "SceneItemTransform", err)
 		}
 		params = &sceneitems.SetSceneItemTransformParams{
-			SceneName:          req.SceneName,
-			SceneUuid:          req.SceneUUID,
+			SceneName:          nilIfEmpty(req.GetSceneName()),
+			SceneUuid:          nilIfEmpty(req.GetSceneUUID()),
 			SceneItemId:        ptr((int)(req.SceneItemID)),
 			SceneItemTransform: sceneItemTransform,
 		}
@@ -4946,17 +5188,11 @@ func (p *Proxy) SetSceneItemTransform(ctx context.Context, req *obsgrpc.SetScene
 	var (
 		resp *sceneitems.SetSceneItemTransformResponse
 	)
-	for {
+	err = p.query(ctx, "SetSceneItemTransform", func() error {
+		var err error
 		resp, err = client.SceneItems.SetSceneItemTransform(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -4979,8 +5215,18 @@ func (p *Proxy) GetSceneItemEnabled(ctx context.Context, req *obsgrpc.GetSceneIt
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSceneItemEnabled", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSceneItemEnabled: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSceneItemEnabled", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetSceneItemEnabled", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -4988,25 +5234,19 @@ func (p *Proxy) GetSceneItemEnabled(ctx context.Context, req *obsgrpc.GetSceneIt
 	params := &sceneitems.GetSceneItemEnabledParams{}
 	if req != nil {
 		params = &sceneitems.GetSceneItemEnabledParams{
-			SceneName:   req.SceneName,
-			SceneUuid:   req.SceneUUID,
+			SceneName:   nilIfEmpty(req.GetSceneName()),
+			SceneUuid:   nilIfEmpty(req.GetSceneUUID()),
 			SceneItemId: ptr((int)(req.SceneItemID)),
 		}
 	}
 	var (
 		resp *sceneitems.GetSceneItemEnabledResponse
 	)
-	for {
+	err = p.query(ctx, "GetSceneItemEnabled", func() error {
+		var err error
 		resp, err = client.SceneItems.GetSceneItemEnabled(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5031,8 +5271,18 @@ func (p *Proxy) SetSceneItemEnabled(ctx context.Context, req *obsgrpc.SetSceneIt
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetSceneItemEnabled", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetSceneItemEnabled: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetSceneItemEnabled", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetSceneItemEnabled", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5040,8 +5290,8 @@ func (p *Proxy) SetSceneItemEnabled(ctx context.Context, req *obsgrpc.SetSceneIt
 	params := &sceneitems.SetSceneItemEnabledParams{}
 	if req != nil {
 		params = &sceneitems.SetSceneItemEnabledParams{
-			SceneName:        req.SceneName,
-			SceneUuid:        req.SceneUUID,
+			SceneName:        nilIfEmpty(req.GetSceneName()),
+			SceneUuid:        nilIfEmpty(req.GetSceneUUID()),
 			SceneItemId:      ptr((int)(req.SceneItemID)),
 			SceneItemEnabled: ptr(req.SceneItemEnabled),
 		}
@@ -5049,17 +5299,11 @@ func (p *Proxy) SetSceneItemEnabled(ctx context.Context, req *obsgrpc.SetSceneIt
 	var (
 		resp *sceneitems.SetSceneItemEnabledResponse
 	)
-	for {
+	err = p.query(ctx, "SetSceneItemEnabled", func() error {
+		var err error
 		resp, err = client.SceneItems.SetSceneItemEnabled(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5082,8 +5326,18 @@ func (p *Proxy) GetSceneItemLocked(ctx context.Context, req *obsgrpc.GetSceneIte
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSceneItemLocked", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSceneItemLocked: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSceneItemLocked", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetSceneItemLocked", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5091,25 +5345,19 @@ func (p *Proxy) GetSceneItemLocked(ctx context.Context, req *obsgrpc.GetSceneIte
 	params := &sceneitems.GetSceneItemLockedParams{}
 	if req != nil {
 		params = &sceneitems.GetSceneItemLockedParams{
-			SceneName:   req.SceneName,
-			SceneUuid:   req.SceneUUID,
+			SceneName:   nilIfEmpty(req.GetSceneName()),
+			SceneUuid:   nilIfEmpty(req.GetSceneUUID()),
 			SceneItemId: ptr((int)(req.SceneItemID)),
 		}
 	}
 	var (
 		resp *sceneitems.GetSceneItemLockedResponse
 	)
-	for {
+	err = p.query(ctx, "GetSceneItemLocked", func() error {
+		var err error
 		resp, err = client.SceneItems.GetSceneItemLocked(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5134,8 +5382,18 @@ func (p *Proxy) SetSceneItemLocked(ctx context.Context, req *obsgrpc.SetSceneIte
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetSceneItemLocked", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetSceneItemLocked: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetSceneItemLocked", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetSceneItemLocked", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5143,8 +5401,8 @@ func (p *Proxy) SetSceneItemLocked(ctx context.Context, req *obsgrpc.SetSceneIte
 	params := &sceneitems.SetSceneItemLockedParams{}
 	if req != nil {
 		params = &sceneitems.SetSceneItemLockedParams{
-			SceneName:       req.SceneName,
-			SceneUuid:       req.SceneUUID,
+			SceneName:       nilIfEmpty(req.GetSceneName()),
+			SceneUuid:       nilIfEmpty(req.GetSceneUUID()),
 			SceneItemId:     ptr((int)(req.SceneItemID)),
 			SceneItemLocked: ptr(req.SceneItemLocked),
 		}
@@ -5152,17 +5410,11 @@ func (p *Proxy) SetSceneItemLocked(ctx context.Context, req *obsgrpc.SetSceneIte
 	var (
 		resp *sceneitems.SetSceneItemLockedResponse
 	)
-	for {
+	err = p.query(ctx, "SetSceneItemLocked", func() error {
+		var err error
 		resp, err = client.SceneItems.SetSceneItemLocked(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5185,8 +5437,18 @@ func (p *Proxy) GetSceneItemIndex(ctx context.Context, req *obsgrpc.GetSceneItem
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSceneItemIndex", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSceneItemIndex: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSceneItemIndex", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetSceneItemIndex", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5194,25 +5456,19 @@ func (p *Proxy) GetSceneItemIndex(ctx context.Context, req *obsgrpc.GetSceneItem
 	params := &sceneitems.GetSceneItemIndexParams{}
 	if req != nil {
 		params = &sceneitems.GetSceneItemIndexParams{
-			SceneName:   req.SceneName,
-			SceneUuid:   req.SceneUUID,
+			SceneName:   nilIfEmpty(req.GetSceneName()),
+			SceneUuid:   nilIfEmpty(req.GetSceneUUID()),
 			SceneItemId: ptr((int)(req.SceneItemID)),
 		}
 	}
 	var (
 		resp *sceneitems.GetSceneItemIndexResponse
 	)
-	for {
+	err = p.query(ctx, "GetSceneItemIndex", func() error {
+		var err error
 		resp, err = client.SceneItems.GetSceneItemIndex(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5237,8 +5493,18 @@ func (p *Proxy) SetSceneItemIndex(ctx context.Context, req *obsgrpc.SetSceneItem
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetSceneItemIndex", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetSceneItemIndex: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetSceneItemIndex", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetSceneItemIndex", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5246,8 +5512,8 @@ func (p *Proxy) SetSceneItemIndex(ctx context.Context, req *obsgrpc.SetSceneItem
 	params := &sceneitems.SetSceneItemIndexParams{}
 	if req != nil {
 		params = &sceneitems.SetSceneItemIndexParams{
-			SceneName:      req.SceneName,
-			SceneUuid:      req.SceneUUID,
+			SceneName:      nilIfEmpty(req.GetSceneName()),
+			SceneUuid:      nilIfEmpty(req.GetSceneUUID()),
 			SceneItemId:    ptr((int)(req.SceneItemID)),
 			SceneItemIndex: ptr((int)(req.SceneItemIndex)),
 		}
@@ -5255,17 +5521,11 @@ func (p *Proxy) SetSceneItemIndex(ctx context.Context, req *obsgrpc.SetSceneItem
 	var (
 		resp *sceneitems.SetSceneItemIndexResponse
 	)
-	for {
+	err = p.query(ctx, "SetSceneItemIndex", func() error {
+		var err error
 		resp, err = client.SceneItems.SetSceneItemIndex(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5288,8 +5548,18 @@ func (p *Proxy) GetSceneItemBlendMode(ctx context.Context, req *obsgrpc.GetScene
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSceneItemBlendMode", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSceneItemBlendMode: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSceneItemBlendMode", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetSceneItemBlendMode", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5297,25 +5567,19 @@ func (p *Proxy) GetSceneItemBlendMode(ctx context.Context, req *obsgrpc.GetScene
 	params := &sceneitems.GetSceneItemBlendModeParams{}
 	if req != nil {
 		params = &sceneitems.GetSceneItemBlendModeParams{
-			SceneName:   req.SceneName,
-			SceneUuid:   req.SceneUUID,
+			SceneName:   nilIfEmpty(req.GetSceneName()),
+			SceneUuid:   nilIfEmpty(req.GetSceneUUID()),
 			SceneItemId: ptr((int)(req.SceneItemID)),
 		}
 	}
 	var (
 		resp *sceneitems.GetSceneItemBlendModeResponse
 	)
-	for {
+	err = p.query(ctx, "GetSceneItemBlendMode", func() error {
+		var err error
 		resp, err = client.SceneItems.GetSceneItemBlendMode(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5340,8 +5604,18 @@ func (p *Proxy) SetSceneItemBlendMode(ctx context.Context, req *obsgrpc.SetScene
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetSceneItemBlendMode", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetSceneItemBlendMode: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetSceneItemBlendMode", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetSceneItemBlendMode", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5349,8 +5623,8 @@ func (p *Proxy) SetSceneItemBlendMode(ctx context.Context, req *obsgrpc.SetScene
 	params := &sceneitems.SetSceneItemBlendModeParams{}
 	if req != nil {
 		params = &sceneitems.SetSceneItemBlendModeParams{
-			SceneName:          req.SceneName,
-			SceneUuid:          req.SceneUUID,
+			SceneName:          nilIfEmpty(req.GetSceneName()),
+			SceneUuid:          nilIfEmpty(req.GetSceneUUID()),
 			SceneItemId:        ptr((int)(req.SceneItemID)),
 			SceneItemBlendMode: ptr((string)(req.SceneItemBlendMode)),
 		}
@@ -5358,17 +5632,11 @@ func (p *Proxy) SetSceneItemBlendMode(ctx context.Context, req *obsgrpc.SetScene
 	var (
 		resp *sceneitems.SetSceneItemBlendModeResponse
 	)
-	for {
+	err = p.query(ctx, "SetSceneItemBlendMode", func() error {
+		var err error
 		resp, err = client.SceneItems.SetSceneItemBlendMode(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5391,8 +5659,15 @@ func (p *Proxy) GetSceneList(ctx context.Context, req *obsgrpc.GetSceneListReque
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSceneList", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSceneList: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSceneList", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5404,17 +5679,11 @@ func (p *Proxy) GetSceneList(ctx context.Context, req *obsgrpc.GetSceneListReque
 	var (
 		resp *scenes.GetSceneListResponse
 	)
-	for {
+	err = p.query(ctx, "GetSceneList", func() error {
+		var err error
 		resp, err = client.Scenes.GetSceneList(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5443,8 +5712,15 @@ func (p *Proxy) GetGroupList(ctx context.Context, req *obsgrpc.GetGroupListReque
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetGroupList", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetGroupList: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetGroupList", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5456,17 +5732,11 @@ func (p *Proxy) GetGroupList(ctx context.Context, req *obsgrpc.GetGroupListReque
 	var (
 		resp *scenes.GetGroupListResponse
 	)
-	for {
+	err = p.query(ctx, "GetGroupList", func() error {
+		var err error
 		resp, err = client.Scenes.GetGroupList(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5491,8 +5761,15 @@ func (p *Proxy) GetCurrentProgramScene(ctx context.Context, req *obsgrpc.GetCurr
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetCurrentProgramScene", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetCurrentProgramScene: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetCurrentProgramScene", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5504,17 +5781,11 @@ func (p *Proxy) GetCurrentProgramScene(ctx context.Context, req *obsgrpc.GetCurr
 	var (
 		resp *scenes.GetCurrentProgramSceneResponse
 	)
-	for {
+	err = p.query(ctx, "GetCurrentProgramScene", func() error {
+		var err error
 		resp, err = client.Scenes.GetCurrentProgramScene(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5542,8 +5813,18 @@ func (p *Proxy) SetCurrentProgramScene(ctx context.Context, req *obsgrpc.SetCurr
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetCurrentProgramScene", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetCurrentProgramScene: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetCurrentProgramScene", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetCurrentProgramScene", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5551,24 +5832,18 @@ func (p *Proxy) SetCurrentProgramScene(ctx context.Context, req *obsgrpc.SetCurr
 	params := &scenes.SetCurrentProgramSceneParams{}
 	if req != nil {
 		params = &scenes.SetCurrentProgramSceneParams{
-			SceneName: req.SceneName,
-			SceneUuid: req.SceneUUID,
+			SceneName: nilIfEmpty(req.GetSceneName()),
+			SceneUuid: nilIfEmpty(req.GetSceneUUID()),
 		}
 	}
 	var (
 		resp *scenes.SetCurrentProgramSceneResponse
 	)
-	for {
+	err = p.query(ctx, "SetCurrentProgramScene", func() error {
+		var err error
 		resp, err = client.Scenes.SetCurrentProgramScene(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5591,8 +5866,15 @@ func (p *Proxy) GetCurrentPreviewScene(ctx context.Context, req *obsgrpc.GetCurr
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetCurrentPreviewScene", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetCurrentPreviewScene: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetCurrentPreviewScene", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5604,17 +5886,11 @@ func (p *Proxy) GetCurrentPreviewScene(ctx context.Context, req *obsgrpc.GetCurr
 	var (
 		resp *scenes.GetCurrentPreviewSceneResponse
 	)
-	for {
+	err = p.query(ctx, "GetCurrentPreviewScene", func() error {
+		var err error
 		resp, err = client.Scenes.GetCurrentPreviewScene(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5642,8 +5918,18 @@ func (p *Proxy) SetCurrentPreviewScene(ctx context.Context, req *obsgrpc.SetCurr
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetCurrentPreviewScene", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetCurrentPreviewScene: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetCurrentPreviewScene", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetCurrentPreviewScene", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5651,24 +5937,18 @@ func (p *Proxy) SetCurrentPreviewScene(ctx context.Context, req *obsgrpc.SetCurr
 	params := &scenes.SetCurrentPreviewSceneParams{}
 	if req != nil {
 		params = &scenes.SetCurrentPreviewSceneParams{
-			SceneName: req.SceneName,
-			SceneUuid: req.SceneUUID,
+			SceneName: nilIfEmpty(req.GetSceneName()),
+			SceneUuid: nilIfEmpty(req.GetSceneUUID()),
 		}
 	}
 	var (
 		resp *scenes.SetCurrentPreviewSceneResponse
 	)
-	for {
+	err = p.query(ctx, "SetCurrentPreviewScene", func() error {
+		var err error
 		resp, err = client.Scenes.SetCurrentPreviewScene(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5691,8 +5971,15 @@ func (p *Proxy) CreateScene(ctx context.Context, req *obsgrpc.CreateSceneRequest
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "CreateScene", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/CreateScene: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "CreateScene", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5706,17 +5993,11 @@ func (p *Proxy) CreateScene(ctx context.Context, req *obsgrpc.CreateSceneRequest
 	var (
 		resp *scenes.CreateSceneResponse
 	)
-	for {
+	err = p.query(ctx, "CreateScene", func() error {
+		var err error
 		resp, err = client.Scenes.CreateScene(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5741,8 +6022,18 @@ func (p *Proxy) RemoveScene(ctx context.Context, req *obsgrpc.RemoveSceneRequest
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "RemoveScene", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/RemoveScene: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "RemoveScene", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("RemoveScene", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5750,24 +6041,18 @@ func (p *Proxy) RemoveScene(ctx context.Context, req *obsgrpc.RemoveSceneRequest
 	params := &scenes.RemoveSceneParams{}
 	if req != nil {
 		params = &scenes.RemoveSceneParams{
-			SceneName: req.SceneName,
-			SceneUuid: req.SceneUUID,
+			SceneName: nilIfEmpty(req.GetSceneName()),
+			SceneUuid: nilIfEmpty(req.GetSceneUUID()),
 		}
 	}
 	var (
 		resp *scenes.RemoveSceneResponse
 	)
-	for {
+	err = p.query(ctx, "RemoveScene", func() error {
+		var err error
 		resp, err = client.Scenes.RemoveScene(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5790,8 +6075,18 @@ func (p *Proxy) SetSceneName(ctx context.Context, req *obsgrpc.SetSceneNameReque
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetSceneName", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetSceneName: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetSceneName", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetSceneName", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5799,25 +6094,19 @@ func (p *Proxy) SetSceneName(ctx context.Context, req *obsgrpc.SetSceneNameReque
 	params := &scenes.SetSceneNameParams{}
 	if req != nil {
 		params = &scenes.SetSceneNameParams{
-			SceneName:    req.SceneName,
-			SceneUuid:    req.SceneUUID,
+			SceneName:    nilIfEmpty(req.GetSceneName()),
+			SceneUuid:    nilIfEmpty(req.GetSceneUUID()),
 			NewSceneName: ptr(req.NewSceneName),
 		}
 	}
 	var (
 		resp *scenes.SetSceneNameResponse
 	)
-	for {
+	err = p.query(ctx, "SetSceneName", func() error {
+		var err error
 		resp, err = client.Scenes.SetSceneName(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5840,8 +6129,18 @@ func (p *Proxy) GetSceneSceneTransitionOverride(ctx context.Context, req *obsgrp
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSceneSceneTransitionOverride", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSceneSceneTransitionOverride: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSceneSceneTransitionOverride", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetSceneSceneTransitionOverride", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5849,24 +6148,18 @@ func (p *Proxy) GetSceneSceneTransitionOverride(ctx context.Context, req *obsgrp
 	params := &scenes.GetSceneSceneTransitionOverrideParams{}
 	if req != nil {
 		params = &scenes.GetSceneSceneTransitionOverrideParams{
-			SceneName: req.SceneName,
-			SceneUuid: req.SceneUUID,
+			SceneName: nilIfEmpty(req.GetSceneName()),
+			SceneUuid: nilIfEmpty(req.GetSceneUUID()),
 		}
 	}
 	var (
 		resp *scenes.GetSceneSceneTransitionOverrideResponse
 	)
-	for {
+	err = p.query(ctx, "GetSceneSceneTransitionOverride", func() error {
+		var err error
 		resp, err = client.Scenes.GetSceneSceneTransitionOverride(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5892,8 +6185,18 @@ func (p *Proxy) SetSceneSceneTransitionOverride(ctx context.Context, req *obsgrp
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetSceneSceneTransitionOverride", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetSceneSceneTransitionOverride: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetSceneSceneTransitionOverride", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetSceneSceneTransitionOverride", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5901,8 +6204,8 @@ func (p *Proxy) SetSceneSceneTransitionOverride(ctx context.Context, req *obsgrp
 	params := &scenes.SetSceneSceneTransitionOverrideParams{}
 	if req != nil {
 		params = &scenes.SetSceneSceneTransitionOverrideParams{
-			SceneName:          req.SceneName,
-			SceneUuid:          req.SceneUUID,
+			SceneName:          nilIfEmpty(req.GetSceneName()),
+			SceneUuid:          nilIfEmpty(req.GetSceneUUID()),
 			TransitionName:     req.TransitionName,
 			TransitionDuration: ptrInt64ToFloat64(req.TransitionDuration),
 		}
@@ -5910,17 +6213,11 @@ func (p *Proxy) SetSceneSceneTransitionOverride(ctx context.Context, req *obsgrp
 	var (
 		resp *scenes.SetSceneSceneTransitionOverrideResponse
 	)
-	for {
+	err = p.query(ctx, "SetSceneSceneTransitionOverride", func() error {
+		var err error
 		resp, err = client.Scenes.SetSceneSceneTransitionOverride(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5943,8 +6240,18 @@ func (p *Proxy) GetSourceActive(ctx context.Context, req *obsgrpc.GetSourceActiv
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSourceActive", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSourceActive: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSourceActive", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetSourceActive", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -5952,24 +6259,18 @@ func (p *Proxy) GetSourceActive(ctx context.Context, req *obsgrpc.GetSourceActiv
 	params := &sources.GetSourceActiveParams{}
 	if req != nil {
 		params = &sources.GetSourceActiveParams{
-			SourceName: req.SourceName,
-			SourceUuid: req.SourceUUID,
+			SourceName: nilIfEmpty(req.GetSourceName()),
+			SourceUuid: nilIfEmpty(req.GetSourceUUID()),
 		}
 	}
 	var (
 		resp *sources.GetSourceActiveResponse
 	)
-	for {
+	err = p.query(ctx, "GetSourceActive", func() error {
+		var err error
 		resp, err = client.Sources.GetSourceActive(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -5995,8 +6296,18 @@ func (p *Proxy) GetSourceScreenshot(ctx context.Context, req *obsgrpc.GetSourceS
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSourceScreenshot", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSourceScreenshot: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSourceScreenshot", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("GetSourceScreenshot", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6004,8 +6315,8 @@ func (p *Proxy) GetSourceScreenshot(ctx context.Context, req *obsgrpc.GetSourceS
 	params := &sources.GetSourceScreenshotParams{}
 	if req != nil {
 		params = &sources.GetSourceScreenshotParams{
-			SourceName:              req.SourceName,
-			SourceUuid:              req.SourceUUID,
+			SourceName:              nilIfEmpty(req.GetSourceName()),
+			SourceUuid:              nilIfEmpty(req.GetSourceUUID()),
 			ImageFormat:             ptr((string)(req.ImageFormat)),
 			ImageWidth:              ptrInt64ToFloat64(req.ImageWidth),
 			ImageHeight:             ptrInt64ToFloat64(req.ImageHeight),
@@ -6015,17 +6326,11 @@ func (p *Proxy) GetSourceScreenshot(ctx context.Context, req *obsgrpc.GetSourceS
 	var (
 		resp *sources.GetSourceScreenshotResponse
 	)
-	for {
+	err = p.query(ctx, "GetSourceScreenshot", func() error {
+		var err error
 		resp, err = client.Sources.GetSourceScreenshot(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6050,8 +6355,18 @@ func (p *Proxy) SaveSourceScreenshot(ctx context.Context, req *obsgrpc.SaveSourc
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SaveSourceScreenshot", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SaveSourceScreenshot: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SaveSourceScreenshot", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SaveSourceScreenshot", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6059,8 +6374,8 @@ func (p *Proxy) SaveSourceScreenshot(ctx context.Context, req *obsgrpc.SaveSourc
 	params := &sources.SaveSourceScreenshotParams{}
 	if req != nil {
 		params = &sources.SaveSourceScreenshotParams{
-			SourceName:              req.SourceName,
-			SourceUuid:              req.SourceUUID,
+			SourceName:              nilIfEmpty(req.GetSourceName()),
+			SourceUuid:              nilIfEmpty(req.GetSourceUUID()),
 			ImageFormat:             ptr((string)(req.ImageFormat)),
 			ImageFilePath:           ptr(req.ImageFilePath),
 			ImageWidth:              ptrInt64ToFloat64(req.ImageWidth),
@@ -6071,17 +6386,11 @@ func (p *Proxy) SaveSourceScreenshot(ctx context.Context, req *obsgrpc.SaveSourc
 	var (
 		resp *sources.SaveSourceScreenshotResponse
 	)
-	for {
+	err = p.query(ctx, "SaveSourceScreenshot", func() error {
+		var err error
 		resp, err = client.Sources.SaveSourceScreenshot(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6104,8 +6413,15 @@ func (p *Proxy) GetStreamStatus(ctx context.Context, req *obsgrpc.GetStreamStatu
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetStreamStatus", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetStreamStatus: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetStreamStatus", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6117,17 +6433,11 @@ func (p *Proxy) GetStreamStatus(ctx context.Context, req *obsgrpc.GetStreamStatu
 	var (
 		resp *stream.GetStreamStatusResponse
 	)
-	for {
+	err = p.query(ctx, "GetStreamStatus", func() error {
+		var err error
 		resp, err = client.Stream.GetStreamStatus(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6159,8 +6469,15 @@ func (p *Proxy) ToggleStream(ctx context.Context, req *obsgrpc.ToggleStreamReque
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "ToggleStream", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/ToggleStream: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "ToggleStream", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6172,17 +6489,11 @@ func (p *Proxy) ToggleStream(ctx context.Context, req *obsgrpc.ToggleStreamReque
 	var (
 		resp *stream.ToggleStreamResponse
 	)
-	for {
+	err = p.query(ctx, "ToggleStream", func() error {
+		var err error
 		resp, err = client.Stream.ToggleStream(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6207,8 +6518,15 @@ func (p *Proxy) StartStream(ctx context.Context, req *obsgrpc.StartStreamRequest
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "StartStream", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/StartStream: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "StartStream", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6220,17 +6538,11 @@ func (p *Proxy) StartStream(ctx context.Context, req *obsgrpc.StartStreamRequest
 	var (
 		resp *stream.StartStreamResponse
 	)
-	for {
+	err = p.query(ctx, "StartStream", func() error {
+		var err error
 		resp, err = client.Stream.StartStream(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6253,8 +6565,15 @@ func (p *Proxy) StopStream(ctx context.Context, req *obsgrpc.StopStreamRequest)
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "StopStream", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/StopStream: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "StopStream", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6266,17 +6585,11 @@ func (p *Proxy) StopStream(ctx context.Context, req *obsgrpc.StopStreamRequest)
 	var (
 		resp *stream.StopStreamResponse
 	)
-	for {
+	err = p.query(ctx, "StopStream", func() error {
+		var err error
 		resp, err = client.Stream.StopStream(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6299,8 +6612,15 @@ func (p *Proxy) SendStreamCaption(ctx context.Context, req *obsgrpc.SendStreamCa
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SendStreamCaption", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SendStreamCaption: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SendStreamCaption", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6314,17 +6634,11 @@ func (p *Proxy) SendStreamCaption(ctx context.Context, req *obsgrpc.SendStreamCa
 	var (
 		resp *stream.SendStreamCaptionResponse
 	)
-	for {
+	err = p.query(ctx, "SendStreamCaption", func() error {
+		var err error
 		resp, err = client.Stream.SendStreamCaption(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6347,8 +6661,15 @@ func (p *Proxy) GetTransitionKindList(ctx context.Context, req *obsgrpc.GetTrans
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetTransitionKindList", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetTransitionKindList: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetTransitionKindList", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6360,17 +6681,11 @@ func (p *Proxy) GetTransitionKindList(ctx context.Context, req *obsgrpc.GetTrans
 	var (
 		resp *transitions.GetTransitionKindListResponse
 	)
-	for {
+	err = p.query(ctx, "GetTransitionKindList", func() error {
+		var err error
 		resp, err = client.Transitions.GetTransitionKindList(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6395,8 +6710,15 @@ func (p *Proxy) GetSceneTransitionList(ctx context.Context, req *obsgrpc.GetScen
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetSceneTransitionList", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetSceneTransitionList: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetSceneTransitionList", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6408,17 +6730,11 @@ func (p *Proxy) GetSceneTransitionList(ctx context.Context, req *obsgrpc.GetScen
 	var (
 		resp *transitions.GetSceneTransitionListResponse
 	)
-	for {
+	err = p.query(ctx, "GetSceneTransitionList", func() error {
+		var err error
 		resp, err = client.Transitions.GetSceneTransitionList(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6446,8 +6762,15 @@ func (p *Proxy) GetCurrentSceneTransition(ctx context.Context, req *obsgrpc.GetC
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetCurrentSceneTransition", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetCurrentSceneTransition: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetCurrentSceneTransition", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6459,17 +6782,11 @@ func (p *Proxy) GetCurrentSceneTransition(ctx context.Context, req *obsgrpc.GetC
 	var (
 		resp *transitions.GetCurrentSceneTransitionResponse
 	)
-	for {
+	err = p.query(ctx, "GetCurrentSceneTransition", func() error {
+		var err error
 		resp, err = client.Transitions.GetCurrentSceneTransition(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6500,8 +6817,15 @@ func (p *Proxy) SetCurrentSceneTransition(ctx context.Context, req *obsgrpc.SetC
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetCurrentSceneTransition", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetCurrentSceneTransition: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetCurrentSceneTransition", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6515,17 +6839,11 @@ func (p *Proxy) SetCurrentSceneTransition(ctx context.Context, req *obsgrpc.SetC
 	var (
 		resp *transitions.SetCurrentSceneTransitionResponse
 	)
-	for {
+	err = p.query(ctx, "SetCurrentSceneTransition", func() error {
+		var err error
 		resp, err = client.Transitions.SetCurrentSceneTransition(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6548,8 +6866,18 @@ func (p *Proxy) SetCurrentSceneTransitionDuration(ctx context.Context, req *obsg
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetCurrentSceneTransitionDuration", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetCurrentSceneTransitionDuration: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetCurrentSceneTransitionDuration", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetCurrentSceneTransitionDuration", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6563,17 +6891,11 @@ func (p *Proxy) SetCurrentSceneTransitionDuration(ctx context.Context, req *obsg
 	var (
 		resp *transitions.SetCurrentSceneTransitionDurationResponse
 	)
-	for {
+	err = p.query(ctx, "SetCurrentSceneTransitionDuration", func() error {
+		var err error
 		resp, err = client.Transitions.SetCurrentSceneTransitionDuration(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6596,8 +6918,18 @@ func (p *Proxy) SetCurrentSceneTransitionSettings(ctx context.Context, req *obsg
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetCurrentSceneTransitionSettings", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetCurrentSceneTransitionSettings: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetCurrentSceneTransitionSettings", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetCurrentSceneTransitionSettings", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6616,17 +6948,11 @@ func (p *Proxy) SetCurrentSceneTransitionSettings(ctx context.Context, req *obsg
 	var (
 		resp *transitions.SetCurrentSceneTransitionSettingsResponse
 	)
-	for {
+	err = p.query(ctx, "SetCurrentSceneTransitionSettings", func() error {
+		var err error
 		resp, err = client.Transitions.SetCurrentSceneTransitionSettings(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6649,8 +6975,15 @@ func (p *Proxy) GetCurrentSceneTransitionCursor(ctx context.Context, req *obsgrp
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetCurrentSceneTransitionCursor", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetCurrentSceneTransitionCursor: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetCurrentSceneTransitionCursor", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6662,17 +6995,11 @@ func (p *Proxy) GetCurrentSceneTransitionCursor(ctx context.Context, req *obsgrp
 	var (
 		resp *transitions.GetCurrentSceneTransitionCursorResponse
 	)
-	for {
+	err = p.query(ctx, "GetCurrentSceneTransitionCursor", func() error {
+		var err error
 		resp, err = client.Transitions.GetCurrentSceneTransitionCursor(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6680,7 +7007,7 @@ func (p *Proxy) GetCurrentSceneTransitionCursor(ctx context.Context, req *obsgrp
 		return nil, fmt.Errorf("internal error: resp is nil")
 	}
 	result := &obsgrpc.GetCurrentSceneTransitionCursorResponse{
-		TransitionCursor: (int64)(resp.TransitionCursor),
+		TransitionCursor: resp.TransitionCursor,
 	}
 	return result, nil
 }
@@ -6697,8 +7024,15 @@ func (p *Proxy) TriggerStudioModeTransition(ctx context.Context, req *obsgrpc.Tr
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "TriggerStudioModeTransition", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/TriggerStudioModeTransition: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "TriggerStudioModeTransition", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6710,17 +7044,11 @@ func (p *Proxy) TriggerStudioModeTransition(ctx context.Context, req *obsgrpc.Tr
 	var (
 		resp *transitions.TriggerStudioModeTransitionResponse
 	)
-	for {
+	err = p.query(ctx, "TriggerStudioModeTransition", func() error {
+		var err error
 		resp, err = client.Transitions.TriggerStudioModeTransition(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6743,8 +7071,18 @@ func (p *Proxy) SetTBarPosition(ctx context.Context, req *obsgrpc.SetTBarPositio
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetTBarPosition", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetTBarPosition: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetTBarPosition", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("SetTBarPosition", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6759,17 +7097,11 @@ func (p *Proxy) SetTBarPosition(ctx context.Context, req *obsgrpc.SetTBarPositio
 	var (
 		resp *transitions.SetTBarPositionResponse
 	)
-	for {
+	err = p.query(ctx, "SetTBarPosition", func() error {
+		var err error
 		resp, err = client.Transitions.SetTBarPosition(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6792,8 +7124,15 @@ func (p *Proxy) GetStudioModeEnabled(ctx context.Context, req *obsgrpc.GetStudio
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetStudioModeEnabled", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetStudioModeEnabled: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetStudioModeEnabled", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6805,17 +7144,11 @@ func (p *Proxy) GetStudioModeEnabled(ctx context.Context, req *obsgrpc.GetStudio
 	var (
 		resp *ui.GetStudioModeEnabledResponse
 	)
-	for {
+	err = p.query(ctx, "GetStudioModeEnabled", func() error {
+		var err error
 		resp, err = client.Ui.GetStudioModeEnabled(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6840,8 +7173,15 @@ func (p *Proxy) SetStudioModeEnabled(ctx context.Context, req *obsgrpc.SetStudio
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "SetStudioModeEnabled", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/SetStudioModeEnabled: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "SetStudioModeEnabled", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6855,17 +7195,11 @@ func (p *Proxy) SetStudioModeEnabled(ctx context.Context, req *obsgrpc.SetStudio
 	var (
 		resp *ui.SetStudioModeEnabledResponse
 	)
-	for {
+	err = p.query(ctx, "SetStudioModeEnabled", func() error {
+		var err error
 		resp, err = client.Ui.SetStudioModeEnabled(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6888,8 +7222,18 @@ func (p *Proxy) OpenInputPropertiesDialog(ctx context.Context, req *obsgrpc.Open
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "OpenInputPropertiesDialog", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/OpenInputPropertiesDialog: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "OpenInputPropertiesDialog", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("OpenInputPropertiesDialog", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6897,24 +7241,18 @@ func (p *Proxy) OpenInputPropertiesDialog(ctx context.Context, req *obsgrpc.Open
 	params := &ui.OpenInputPropertiesDialogParams{}
 	if req != nil {
 		params = &ui.OpenInputPropertiesDialogParams{
-			InputName: req.InputName,
-			InputUuid: req.InputUUID,
+			InputName: nilIfEmpty(req.GetInputName()),
+			InputUuid: nilIfEmpty(req.GetInputUUID()),
 		}
 	}
 	var (
 		resp *ui.OpenInputPropertiesDialogResponse
 	)
-	for {
+	err = p.query(ctx, "OpenInputPropertiesDialog", func() error {
+		var err error
 		resp, err = client.Ui.OpenInputPropertiesDialog(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6937,8 +7275,18 @@ func (p *Proxy) OpenInputFiltersDialog(ctx context.Context, req *obsgrpc.OpenInp
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "OpenInputFiltersDialog", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/OpenInputFiltersDialog: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "OpenInputFiltersDialog", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("OpenInputFiltersDialog", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6946,24 +7294,18 @@ func (p *Proxy) OpenInputFiltersDialog(ctx context.Context, req *obsgrpc.OpenInp
 	params := &ui.OpenInputFiltersDialogParams{}
 	if req != nil {
 		params = &ui.OpenInputFiltersDialogParams{
-			InputName: req.InputName,
-			InputUuid: req.InputUUID,
+			InputName: nilIfEmpty(req.GetInputName()),
+			InputUuid: nilIfEmpty(req.GetInputUUID()),
 		}
 	}
 	var (
 		resp *ui.OpenInputFiltersDialogResponse
 	)
-	for {
+	err = p.query(ctx, "OpenInputFiltersDialog", func() error {
+		var err error
 		resp, err = client.Ui.OpenInputFiltersDialog(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -6986,8 +7328,18 @@ func (p *Proxy) OpenInputInteractDialog(ctx context.Context, req *obsgrpc.OpenIn
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "OpenInputInteractDialog", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/OpenInputInteractDialog: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "OpenInputInteractDialog", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("OpenInputInteractDialog", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -6995,24 +7347,18 @@ func (p *Proxy) OpenInputInteractDialog(ctx context.Context, req *obsgrpc.OpenIn
 	params := &ui.OpenInputInteractDialogParams{}
 	if req != nil {
 		params = &ui.OpenInputInteractDialogParams{
-			InputName: req.InputName,
-			InputUuid: req.InputUUID,
+			InputName: nilIfEmpty(req.GetInputName()),
+			InputUuid: nilIfEmpty(req.GetInputUUID()),
 		}
 	}
 	var (
 		resp *ui.OpenInputInteractDialogResponse
 	)
-	for {
+	err = p.query(ctx, "OpenInputInteractDialog", func() error {
+		var err error
 		resp, err = client.Ui.OpenInputInteractDialog(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -7035,8 +7381,15 @@ func (p *Proxy) GetMonitorList(ctx context.Context, req *obsgrpc.GetMonitorListR
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "GetMonitorList", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/GetMonitorList: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "GetMonitorList", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -7048,17 +7401,11 @@ func (p *Proxy) GetMonitorList(ctx context.Context, req *obsgrpc.GetMonitorListR
 	var (
 		resp *ui.GetMonitorListResponse
 	)
-	for {
+	err = p.query(ctx, "GetMonitorList", func() error {
+		var err error
 		resp, err = client.Ui.GetMonitorList(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -7083,8 +7430,15 @@ func (p *Proxy) OpenVideoMixProjector(ctx context.Context, req *obsgrpc.OpenVide
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "OpenVideoMixProjector", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/OpenVideoMixProjector: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "OpenVideoMixProjector", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -7100,17 +7454,11 @@ func (p *Proxy) OpenVideoMixProjector(ctx context.Context, req *obsgrpc.OpenVide
 	var (
 		resp *ui.OpenVideoMixProjectorResponse
 	)
-	for {
+	err = p.query(ctx, "OpenVideoMixProjector", func() error {
+		var err error
 		resp, err = client.Ui.OpenVideoMixProjector(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -7133,8 +7481,18 @@ func (p *Proxy) OpenSourceProjector(ctx context.Context, req *obsgrpc.OpenSource
 		if r != nil {
 			_err = fmt.Errorf("got panic: %v\n\n%s", r, debug.Stack())
 		}
+		_err = p.afterRequest(ctx, "OpenSourceProjector", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
 		logger.Tracef(ctx, "/OpenSourceProjector: %v", _err)
 	}()
+	if err := p.beforeRequest(ctx, "OpenSourceProjector", req); err != nil {
+		return nil, err
+	}
+	if err := validateRequest("OpenSourceProjector", req); err != nil {
+		return nil, err
+	}
 	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get a client: %w", err)
@@ -7142,8 +7500,8 @@ func (p *Proxy) OpenSourceProjector(ctx context.Context, req *obsgrpc.OpenSource
 	params := &ui.OpenSourceProjectorParams{}
 	if req != nil {
 		params = &ui.OpenSourceProjectorParams{
-			SourceName:        req.SourceName,
-			SourceUuid:        req.SourceUUID,
+			SourceName:        nilIfEmpty(req.GetSourceName()),
+			SourceUuid:        nilIfEmpty(req.GetSourceUUID()),
 			MonitorIndex:      ptrInt64ToInt(req.MonitorIndex),
 			ProjectorGeometry: ptr((string)(req.ProjectorGeometry)),
 		}
@@ -7151,17 +7509,11 @@ func (p *Proxy) OpenSourceProjector(ctx context.Context, req *obsgrpc.OpenSource
 	var (
 		resp *ui.OpenSourceProjectorResponse
 	)
-	for {
+	err = p.query(ctx, "OpenSourceProjector", func() error {
+		var err error
 		resp, err = client.Ui.OpenSourceProjector(params)
-		if err != nil && p.QueryErrorHandler != nil {
-			fixErr := p.QueryErrorHandler(ctx, err)
-			if fixErr == nil {
-				logger.Tracef(ctx, "there was error '%s', but it was handled", err)
-				continue
-			}
-		}
-		break
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -7177,3 +7529,1364 @@ func (p *ProxyAsClient) OpenSourceProjector(ctx context.Context, req *obsgrpc.Op
 func (p *ClientAsServer) OpenSourceProjector(ctx context.Context, req *obsgrpc.OpenSourceProjectorRequest) (*obsgrpc.OpenSourceProjectorResponse, error) {
 	return p.OBSClient.OpenSourceProjector(ctx, req)
 }
+
+var requestMetadata = map[string]requestMetadataT{
+	"BroadcastCustomEvent": {
+		Category:       "general",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"CallVendorRequest": {
+		Category:       "general",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"CreateInput": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"CreateProfile": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"CreateRecordChapter": {
+		Category:       "record",
+		InitialVersion: "5.5.0",
+		RPCVersion:     1,
+	},
+	"CreateScene": {
+		Category:       "scenes",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"CreateSceneCollection": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"CreateSceneItem": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"CreateSourceFilter": {
+		Category:       "filters",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"DuplicateSceneItem": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetCurrentPreviewScene": {
+		Category:       "scenes",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetCurrentProgramScene": {
+		Category:       "scenes",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetCurrentSceneTransition": {
+		Category:       "transitions",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetCurrentSceneTransitionCursor": {
+		Category:       "transitions",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetGroupList": {
+		Category:       "scenes",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetGroupSceneItemList": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetHotkeyList": {
+		Category:       "general",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetInputAudioBalance": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetInputAudioMonitorType": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetInputAudioSyncOffset": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetInputAudioTracks": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetInputDefaultSettings": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetInputKindList": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetInputList": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetInputMute": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetInputPropertiesListPropertyItems": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetInputSettings": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetInputVolume": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetLastReplayBufferReplay": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetMediaInputStatus": {
+		Category:       "media inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetMonitorList": {
+		Category:       "ui",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetOutputList": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetOutputSettings": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetOutputStatus": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetPersistentData": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetProfileList": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetProfileParameter": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetRecordDirectory": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetRecordStatus": {
+		Category:       "record",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetReplayBufferStatus": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSceneCollectionList": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSceneItemBlendMode": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSceneItemEnabled": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSceneItemId": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSceneItemIndex": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSceneItemList": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSceneItemLocked": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSceneItemSource": {
+		Category:       "scene items",
+		InitialVersion: "5.4.0",
+		RPCVersion:     1,
+	},
+	"GetSceneItemTransform": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSceneList": {
+		Category:       "scenes",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSceneSceneTransitionOverride": {
+		Category:       "scenes",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSceneTransitionList": {
+		Category:       "transitions",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSourceActive": {
+		Category:       "sources",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSourceFilter": {
+		Category:       "filters",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSourceFilterDefaultSettings": {
+		Category:       "filters",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSourceFilterKindList": {
+		Category:       "filters",
+		InitialVersion: "5.4.0",
+		RPCVersion:     1,
+	},
+	"GetSourceFilterList": {
+		Category:       "filters",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSourceScreenshot": {
+		Category:       "sources",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetSpecialInputs": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetStats": {
+		Category:       "general",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetStreamServiceSettings": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetStreamStatus": {
+		Category:       "stream",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetStudioModeEnabled": {
+		Category:       "ui",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetTransitionKindList": {
+		Category:       "transitions",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetVersion": {
+		Category:       "general",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetVideoSettings": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"GetVirtualCamStatus": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"OffsetMediaInputCursor": {
+		Category:       "media inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"OpenInputFiltersDialog": {
+		Category:       "ui",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"OpenInputInteractDialog": {
+		Category:       "ui",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"OpenInputPropertiesDialog": {
+		Category:       "ui",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"OpenSourceProjector": {
+		Category:       "ui",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"OpenVideoMixProjector": {
+		Category:       "ui",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"PauseRecord": {
+		Category:       "record",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"PressInputPropertiesButton": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"RemoveInput": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"RemoveProfile": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"RemoveScene": {
+		Category:       "scenes",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"RemoveSceneItem": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"RemoveSourceFilter": {
+		Category:       "filters",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"ResumeRecord": {
+		Category:       "record",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SaveReplayBuffer": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SaveSourceScreenshot": {
+		Category:       "sources",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SendStreamCaption": {
+		Category:       "stream",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetCurrentPreviewScene": {
+		Category:       "scenes",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetCurrentProfile": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetCurrentProgramScene": {
+		Category:       "scenes",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetCurrentSceneCollection": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetCurrentSceneTransition": {
+		Category:       "transitions",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetCurrentSceneTransitionDuration": {
+		Category:       "transitions",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetCurrentSceneTransitionSettings": {
+		Category:       "transitions",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetInputAudioBalance": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetInputAudioMonitorType": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetInputAudioSyncOffset": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetInputAudioTracks": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetInputMute": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetInputName": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetInputSettings": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetInputVolume": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetMediaInputCursor": {
+		Category:       "media inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetOutputSettings": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetPersistentData": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetProfileParameter": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetRecordDirectory": {
+		Category:       "config",
+		InitialVersion: "5.3.0",
+		RPCVersion:     1,
+	},
+	"SetSceneItemBlendMode": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetSceneItemEnabled": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetSceneItemIndex": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetSceneItemLocked": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetSceneItemTransform": {
+		Category:       "scene items",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetSceneName": {
+		Category:       "scenes",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetSceneSceneTransitionOverride": {
+		Category:       "scenes",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetSourceFilterEnabled": {
+		Category:       "filters",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetSourceFilterIndex": {
+		Category:       "filters",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetSourceFilterName": {
+		Category:       "filters",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetSourceFilterSettings": {
+		Category:       "filters",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetStreamServiceSettings": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetStudioModeEnabled": {
+		Category:       "ui",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetTBarPosition": {
+		Category:       "transitions",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SetVideoSettings": {
+		Category:       "config",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"Sleep": {
+		Category:       "general",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"SplitRecordFile": {
+		Category:       "record",
+		InitialVersion: "5.5.0",
+		RPCVersion:     1,
+	},
+	"StartOutput": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"StartRecord": {
+		Category:       "record",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"StartReplayBuffer": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"StartStream": {
+		Category:       "stream",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"StartVirtualCam": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"StopOutput": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"StopRecord": {
+		Category:       "record",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"StopReplayBuffer": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"StopStream": {
+		Category:       "stream",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"StopVirtualCam": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"ToggleInputMute": {
+		Category:       "inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"ToggleOutput": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"ToggleRecord": {
+		Category:       "record",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"ToggleRecordPause": {
+		Category:       "record",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"ToggleReplayBuffer": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"ToggleStream": {
+		Category:       "stream",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"ToggleVirtualCam": {
+		Category:       "outputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"TriggerHotkeyByKeySequence": {
+		Category:       "general",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"TriggerHotkeyByName": {
+		Category:       "general",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"TriggerMediaInputAction": {
+		Category:       "media inputs",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+	"TriggerStudioModeTransition": {
+		Category:       "transitions",
+		InitialVersion: "5.0.0",
+		RPCVersion:     1,
+	},
+}
+var requestFieldRules = map[string][]fieldRule{
+	"BroadcastCustomEvent": {{
+		Field:    "eventData",
+		Required: true,
+	}},
+	"CreateInput": {{
+		Field:    "scene",
+		Required: true,
+	}},
+	"CreateSceneItem": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field:    "source",
+		Required: true,
+	}},
+	"CreateSourceFilter": {{
+		Field:    "source",
+		Required: true,
+	}},
+	"DuplicateSceneItem": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field: "sceneItemID",
+		Min:   ptr(0.0),
+	}},
+	"GetGroupSceneItemList": {{
+		Field:    "scene",
+		Required: true,
+	}},
+	"GetInputAudioBalance": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"GetInputAudioMonitorType": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"GetInputAudioSyncOffset": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"GetInputAudioTracks": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"GetInputMute": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"GetInputPropertiesListPropertyItems": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"GetInputSettings": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"GetInputVolume": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"GetMediaInputStatus": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"GetSceneItemBlendMode": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field: "sceneItemID",
+		Min:   ptr(0.0),
+	}},
+	"GetSceneItemEnabled": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field: "sceneItemID",
+		Min:   ptr(0.0),
+	}},
+	"GetSceneItemId": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field: "searchOffset",
+		Min:   ptr(-1.0),
+	}},
+	"GetSceneItemIndex": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field: "sceneItemID",
+		Min:   ptr(0.0),
+	}},
+	"GetSceneItemList": {{
+		Field:    "scene",
+		Required: true,
+	}},
+	"GetSceneItemLocked": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field: "sceneItemID",
+		Min:   ptr(0.0),
+	}},
+	"GetSceneItemSource": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field: "sceneItemID",
+		Min:   ptr(0.0),
+	}},
+	"GetSceneItemTransform": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field: "sceneItemID",
+		Min:   ptr(0.0),
+	}},
+	"GetSceneSceneTransitionOverride": {{
+		Field:    "scene",
+		Required: true,
+	}},
+	"GetSourceActive": {{
+		Field:    "source",
+		Required: true,
+	}},
+	"GetSourceFilter": {{
+		Field:    "source",
+		Required: true,
+	}},
+	"GetSourceFilterList": {{
+		Field:    "source",
+		Required: true,
+	}},
+	"GetSourceScreenshot": {{
+		Field:    "source",
+		Required: true,
+	}, {
+		Field: "imageWidth",
+		Max:   ptr(4096.0),
+		Min:   ptr(8.0),
+	}, {
+		Field: "imageHeight",
+		Max:   ptr(4096.0),
+		Min:   ptr(8.0),
+	}, {
+		Field: "imageCompressionQuality",
+		Max:   ptr(100.0),
+		Min:   ptr(-1.0),
+	}},
+	"OffsetMediaInputCursor": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"OpenInputFiltersDialog": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"OpenInputInteractDialog": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"OpenInputPropertiesDialog": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"OpenSourceProjector": {{
+		Field:    "source",
+		Required: true,
+	}},
+	"PressInputPropertiesButton": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"RemoveInput": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"RemoveScene": {{
+		Field:    "scene",
+		Required: true,
+	}},
+	"RemoveSceneItem": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field: "sceneItemID",
+		Min:   ptr(0.0),
+	}},
+	"RemoveSourceFilter": {{
+		Field:    "source",
+		Required: true,
+	}},
+	"SaveSourceScreenshot": {{
+		Field:    "source",
+		Required: true,
+	}, {
+		Field: "imageWidth",
+		Max:   ptr(4096.0),
+		Min:   ptr(8.0),
+	}, {
+		Field: "imageHeight",
+		Max:   ptr(4096.0),
+		Min:   ptr(8.0),
+	}, {
+		Field: "imageCompressionQuality",
+		Max:   ptr(100.0),
+		Min:   ptr(-1.0),
+	}},
+	"SetCurrentPreviewScene": {{
+		Field:    "scene",
+		Required: true,
+	}},
+	"SetCurrentProgramScene": {{
+		Field:    "scene",
+		Required: true,
+	}},
+	"SetCurrentSceneTransitionDuration": {{
+		Field: "transitionDuration",
+		Max:   ptr(20000.0),
+		Min:   ptr(50.0),
+	}},
+	"SetCurrentSceneTransitionSettings": {{
+		Field:    "transitionSettings",
+		Required: true,
+	}},
+	"SetInputAudioBalance": {{
+		Field:    "input",
+		Required: true,
+	}, {
+		Field: "inputAudioBalance",
+		Max:   ptr(1.0),
+		Min:   ptr(0.0),
+	}},
+	"SetInputAudioMonitorType": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"SetInputAudioSyncOffset": {{
+		Field:    "input",
+		Required: true,
+	}, {
+		Field: "inputAudioSyncOffset",
+		Max:   ptr(20000.0),
+		Min:   ptr(-950.0),
+	}},
+	"SetInputAudioTracks": {{
+		Field:    "input",
+		Required: true,
+	}, {
+		Field:    "inputAudioTracks",
+		Required: true,
+	}},
+	"SetInputMute": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"SetInputName": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"SetInputSettings": {{
+		Field:    "input",
+		Required: true,
+	}, {
+		Field:    "inputSettings",
+		Required: true,
+	}},
+	"SetInputVolume": {{
+		Field:    "input",
+		Required: true,
+	}, {
+		Field: "inputVolumeMul",
+		Max:   ptr(20.0),
+		Min:   ptr(0.0),
+	}, {
+		Field: "inputVolumeDb",
+		Max:   ptr(26.0),
+		Min:   ptr(-100.0),
+	}},
+	"SetMediaInputCursor": {{
+		Field:    "input",
+		Required: true,
+	}, {
+		Field: "mediaCursor",
+		Min:   ptr(0.0),
+	}},
+	"SetOutputSettings": {{
+		Field:    "outputSettings",
+		Required: true,
+	}},
+	"SetSceneItemBlendMode": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field: "sceneItemID",
+		Min:   ptr(0.0),
+	}},
+	"SetSceneItemEnabled": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field: "sceneItemID",
+		Min:   ptr(0.0),
+	}},
+	"SetSceneItemIndex": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field: "sceneItemID",
+		Min:   ptr(0.0),
+	}, {
+		Field: "sceneItemIndex",
+		Min:   ptr(0.0),
+	}},
+	"SetSceneItemLocked": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field: "sceneItemID",
+		Min:   ptr(0.0),
+	}},
+	"SetSceneItemTransform": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field: "sceneItemID",
+		Min:   ptr(0.0),
+	}, {
+		Field:    "sceneItemTransform",
+		Required: true,
+	}},
+	"SetSceneName": {{
+		Field:    "scene",
+		Required: true,
+	}},
+	"SetSceneSceneTransitionOverride": {{
+		Field:    "scene",
+		Required: true,
+	}, {
+		Field: "transitionDuration",
+		Max:   ptr(20000.0),
+		Min:   ptr(50.0),
+	}},
+	"SetSourceFilterEnabled": {{
+		Field:    "source",
+		Required: true,
+	}},
+	"SetSourceFilterIndex": {{
+		Field:    "source",
+		Required: true,
+	}, {
+		Field: "filterIndex",
+		Min:   ptr(0.0),
+	}},
+	"SetSourceFilterName": {{
+		Field:    "source",
+		Required: true,
+	}},
+	"SetSourceFilterSettings": {{
+		Field:    "source",
+		Required: true,
+	}, {
+		Field:    "filterSettings",
+		Required: true,
+	}},
+	"SetStreamServiceSettings": {{
+		Field:    "streamServiceSettings",
+		Required: true,
+	}},
+	"SetTBarPosition": {{
+		Field: "position",
+		Max:   ptr(1.0),
+		Min:   ptr(0.0),
+	}},
+	"SetVideoSettings": {{
+		Field: "fpsNumerator",
+		Min:   ptr(1.0),
+	}, {
+		Field: "fpsDenominator",
+		Min:   ptr(1.0),
+	}, {
+		Field: "baseWidth",
+		Max:   ptr(4096.0),
+		Min:   ptr(1.0),
+	}, {
+		Field: "baseHeight",
+		Max:   ptr(4096.0),
+		Min:   ptr(1.0),
+	}, {
+		Field: "outputWidth",
+		Max:   ptr(4096.0),
+		Min:   ptr(1.0),
+	}, {
+		Field: "outputHeight",
+		Max:   ptr(4096.0),
+		Min:   ptr(1.0),
+	}},
+	"Sleep": {{
+		Field: "sleepMillis",
+		Max:   ptr(50000.0),
+		Min:   ptr(0.0),
+	}, {
+		Field: "sleepFrames",
+		Max:   ptr(10000.0),
+		Min:   ptr(0.0),
+	}},
+	"ToggleInputMute": {{
+		Field:    "input",
+		Required: true,
+	}},
+	"TriggerMediaInputAction": {{
+		Field:    "input",
+		Required: true,
+	}},
+}
+var eventMetadata = map[string]eventMetadataT{
+	"CurrentPreviewSceneChanged": {
+		Category:     "scenes",
+		Subscription: obsgrpc.EventSubscription_Scenes,
+	},
+	"CurrentProfileChanged": {
+		Category:     "config",
+		Subscription: obsgrpc.EventSubscription_Config,
+	},
+	"CurrentProfileChanging": {
+		Category:     "config",
+		Subscription: obsgrpc.EventSubscription_Config,
+	},
+	"CurrentProgramSceneChanged": {
+		Category:     "scenes",
+		Subscription: obsgrpc.EventSubscription_Scenes,
+	},
+	"CurrentSceneCollectionChanged": {
+		Category:     "config",
+		Subscription: obsgrpc.EventSubscription_Config,
+	},
+	"CurrentSceneCollectionChanging": {
+		Category:     "config",
+		Subscription: obsgrpc.EventSubscription_Config,
+	},
+	"CurrentSceneTransitionChanged": {
+		Category:     "transitions",
+		Subscription: obsgrpc.EventSubscription_Transitions,
+	},
+	"CurrentSceneTransitionDurationChanged": {
+		Category:     "transitions",
+		Subscription: obsgrpc.EventSubscription_Transitions,
+	},
+	"CustomEvent": {
+		Category:     "general",
+		Subscription: obsgrpc.EventSubscription_General,
+	},
+	"ExitStarted": {
+		Category:     "general",
+		Subscription: obsgrpc.EventSubscription_General,
+	},
+	"InputActiveStateChanged": {
+		Category:     "inputs",
+		Subscription: obsgrpc.EventSubscription_InputActiveStateChanged,
+	},
+	"InputAudioBalanceChanged": {
+		Category:     "inputs",
+		Subscription: obsgrpc.EventSubscription_Inputs,
+	},
+	"InputAudioMonitorTypeChanged": {
+		Category:     "inputs",
+		Subscription: obsgrpc.EventSubscription_Inputs,
+	},
+	"InputAudioSyncOffsetChanged": {
+		Category:     "inputs",
+		Subscription: obsgrpc.EventSubscription_Inputs,
+	},
+	"InputAudioTracksChanged": {
+		Category:     "inputs",
+		Subscription: obsgrpc.EventSubscription_Inputs,
+	},
+	"InputCreated": {
+		Category:     "inputs",
+		Subscription: obsgrpc.EventSubscription_Inputs,
+	},
+	"InputMuteStateChanged": {
+		Category:     "inputs",
+		Subscription: obsgrpc.EventSubscription_Inputs,
+	},
+	"InputNameChanged": {
+		Category:     "inputs",
+		Subscription: obsgrpc.EventSubscription_Inputs,
+	},
+	"InputRemoved": {
+		Category:     "inputs",
+		Subscription: obsgrpc.EventSubscription_Inputs,
+	},
+	"InputSettingsChanged": {
+		Category:     "inputs",
+		Subscription: obsgrpc.EventSubscription_Inputs,
+	},
+	"InputShowStateChanged": {
+		Category:     "inputs",
+		Subscription: obsgrpc.EventSubscription_InputShowStateChanged,
+	},
+	"InputVolumeChanged": {
+		Category:     "inputs",
+		Subscription: obsgrpc.EventSubscription_Inputs,
+	},
+	"InputVolumeMeters": {
+		Category:     "inputs",
+		Subscription: obsgrpc.EventSubscription_InputVolumeMeters,
+	},
+	"MediaInputActionTriggered": {
+		Category:     "media inputs",
+		Subscription: obsgrpc.EventSubscription_MediaInputs,
+	},
+	"MediaInputPlaybackEnded": {
+		Category:     "media inputs",
+		Subscription: obsgrpc.EventSubscription_MediaInputs,
+	},
+	"MediaInputPlaybackStarted": {
+		Category:     "media inputs",
+		Subscription: obsgrpc.EventSubscription_MediaInputs,
+	},
+	"ProfileListChanged": {
+		Category:     "config",
+		Subscription: obsgrpc.EventSubscription_Config,
+	},
+	"RecordFileChanged": {
+		Category:     "outputs",
+		Subscription: obsgrpc.EventSubscription_Outputs,
+	},
+	"RecordStateChanged": {
+		Category:     "outputs",
+		Subscription: obsgrpc.EventSubscription_Outputs,
+	},
+	"ReplayBufferSaved": {
+		Category:     "outputs",
+		Subscription: obsgrpc.EventSubscription_Outputs,
+	},
+	"ReplayBufferStateChanged": {
+		Category:     "outputs",
+		Subscription: obsgrpc.EventSubscription_Outputs,
+	},
+	"SceneCollectionListChanged": {
+		Category:     "config",
+		Subscription: obsgrpc.EventSubscription_Config,
+	},
+	"SceneCreated": {
+		Category:     "scenes",
+		Subscription: obsgrpc.EventSubscription_Scenes,
+	},
+	"SceneItemCreated": {
+		Category:     "scene items",
+		Subscription: obsgrpc.EventSubscription_SceneItems,
+	},
+	"SceneItemEnableStateChanged": {
+		Category:     "scene items",
+		Subscription: obsgrpc.EventSubscription_SceneItems,
+	},
+	"SceneItemListReindexed": {
+		Category:     "scene items",
+		Subscription: obsgrpc.EventSubscription_SceneItems,
+	},
+	"SceneItemLockStateChanged": {
+		Category:     "scene items",
+		Subscription: obsgrpc.EventSubscription_SceneItems,
+	},
+	"SceneItemRemoved": {
+		Category:     "scene items",
+		Subscription: obsgrpc.EventSubscription_SceneItems,
+	},
+	"SceneItemSelected": {
+		Category:     "scene items",
+		Subscription: obsgrpc.EventSubscription_SceneItems,
+	},
+	"SceneItemTransformChanged": {
+		Category:     "scene items",
+		Subscription: obsgrpc.EventSubscription_SceneItemTransformChanged,
+	},
+	"SceneListChanged": {
+		Category:     "scenes",
+		Subscription: obsgrpc.EventSubscription_Scenes,
+	},
+	"SceneNameChanged": {
+		Category:     "scenes",
+		Subscription: obsgrpc.EventSubscription_Scenes,
+	},
+	"SceneRemoved": {
+		Category:     "scenes",
+		Subscription: obsgrpc.EventSubscription_Scenes,
+	},
+	"SceneTransitionEnded": {
+		Category:     "transitions",
+		Subscription: obsgrpc.EventSubscription_Transitions,
+	},
+	"SceneTransitionStarted": {
+		Category:     "transitions",
+		Subscription: obsgrpc.EventSubscription_Transitions,
+	},
+	"SceneTransitionVideoEnded": {
+		Category:     "transitions",
+		Subscription: obsgrpc.EventSubscription_Transitions,
+	},
+	"ScreenshotSaved": {
+		Category:     "ui",
+		Subscription: obsgrpc.EventSubscription_Ui,
+	},
+	"SourceFilterCreated": {
+		Category:     "filters",
+		Subscription: obsgrpc.EventSubscription_Filters,
+	},
+	"SourceFilterEnableStateChanged": {
+		Category:     "filters",
+		Subscription: obsgrpc.EventSubscription_Filters,
+	},
+	"SourceFilterListReindexed": {
+		Category:     "filters",
+		Subscription: obsgrpc.EventSubscription_Filters,
+	},
+	"SourceFilterNameChanged": {
+		Category:     "filters",
+		Subscription: obsgrpc.EventSubscription_Filters,
+	},
+	"SourceFilterRemoved": {
+		Category:     "filters",
+		Subscription: obsgrpc.EventSubscription_Filters,
+	},
+	"SourceFilterSettingsChanged": {
+		Category:     "filters",
+		Subscription: obsgrpc.EventSubscription_Filters,
+	},
+	"StreamStateChanged": {
+		Category:     "outputs",
+		Subscription: obsgrpc.EventSubscription_Outputs,
+	},
+	"StudioModeStateChanged": {
+		Category:     "ui",
+		Subscription: obsgrpc.EventSubscription_Ui,
+	},
+	"VendorEvent": {
+		Category:     "general",
+		Subscription: obsgrpc.EventSubscription_Vendors,
+	},
+	"VirtualcamStateChanged": {
+		Category:     "outputs",
+		Subscription: obsgrpc.EventSubscription_Outputs,
+	},
+}
@@ -0,0 +1,2137 @@
+// This file was automatically generated by github.com/xaionaro-go/obs-grpc-proxy/scripts/generate
+
+package obsgrpcproxy_test
+
+import "github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+
+var conformanceTests = []conformanceTest{
+	{
+		Request: &obs_grpc.GetPersistentDataRequest{
+			Realm:    []byte("realm"),
+			SlotName: "slotName",
+		},
+		RequestData: map[string]any{
+			"realm":    "realm",
+			"slotName": "slotName",
+		},
+		RequestType:  "GetPersistentData",
+		Response:     &obs_grpc.GetPersistentDataResponse{SlotValue: &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}}},
+		ResponseData: map[string]any{"slotValue": "value"},
+	},
+	{
+		KnownIssue: "slotValue is sent as the protobuf structure of Any instead of the value",
+		Request: &obs_grpc.SetPersistentDataRequest{
+			Realm:     []byte("realm"),
+			SlotName:  "slotName",
+			SlotValue: &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+		},
+		RequestData: map[string]any{
+			"realm":     "realm",
+			"slotName":  "slotName",
+			"slotValue": "value",
+		},
+		RequestType:  "SetPersistentData",
+		Response:     &obs_grpc.SetPersistentDataResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetSceneCollectionListRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetSceneCollectionList",
+		Response: &obs_grpc.GetSceneCollectionListResponse{
+			CurrentSceneCollectionName: "currentSceneCollectionName",
+			SceneCollections:           [][]byte{[]byte("sceneCollections")},
+		},
+		ResponseData: map[string]any{
+			"currentSceneCollectionName": "currentSceneCollectionName",
+			"sceneCollections":           []any{"sceneCollections"},
+		},
+	},
+	{
+		Request:      &obs_grpc.SetCurrentSceneCollectionRequest{SceneCollectionName: "sceneCollectionName"},
+		RequestData:  map[string]any{"sceneCollectionName": "sceneCollectionName"},
+		RequestType:  "SetCurrentSceneCollection",
+		Response:     &obs_grpc.SetCurrentSceneCollectionResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.CreateSceneCollectionRequest{SceneCollectionName: "sceneCollectionName"},
+		RequestData:  map[string]any{"sceneCollectionName": "sceneCollectionName"},
+		RequestType:  "CreateSceneCollection",
+		Response:     &obs_grpc.CreateSceneCollectionResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetProfileListRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetProfileList",
+		Response: &obs_grpc.GetProfileListResponse{
+			CurrentProfileName: "currentProfileName",
+			Profiles:           [][]byte{[]byte("profiles")},
+		},
+		ResponseData: map[string]any{
+			"currentProfileName": "currentProfileName",
+			"profiles":           []any{"profiles"},
+		},
+	},
+	{
+		Request:      &obs_grpc.SetCurrentProfileRequest{ProfileName: "profileName"},
+		RequestData:  map[string]any{"profileName": "profileName"},
+		RequestType:  "SetCurrentProfile",
+		Response:     &obs_grpc.SetCurrentProfileResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.CreateProfileRequest{ProfileName: "profileName"},
+		RequestData:  map[string]any{"profileName": "profileName"},
+		RequestType:  "CreateProfile",
+		Response:     &obs_grpc.CreateProfileResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.RemoveProfileRequest{ProfileName: "profileName"},
+		RequestData:  map[string]any{"profileName": "profileName"},
+		RequestType:  "RemoveProfile",
+		Response:     &obs_grpc.RemoveProfileResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.GetProfileParameterRequest{
+			ParameterCategory: []byte("parameterCategory"),
+			ParameterName:     "parameterName",
+		},
+		RequestData: map[string]any{
+			"parameterCategory": "parameterCategory",
+			"parameterName":     "parameterName",
+		},
+		RequestType: "GetProfileParameter",
+		Response: &obs_grpc.GetProfileParameterResponse{
+			DefaultParameterValue: []byte("defaultParameterValue"),
+			ParameterValue:        []byte("parameterValue"),
+		},
+		ResponseData: map[string]any{
+			"defaultParameterValue": "defaultParameterValue",
+			"parameterValue":        "parameterValue",
+		},
+	},
+	{
+		Request: &obs_grpc.SetProfileParameterRequest{
+			ParameterCategory: []byte("parameterCategory"),
+			ParameterName:     "parameterName",
+			ParameterValue:    []byte("parameterValue"),
+		},
+		RequestData: map[string]any{
+			"parameterCategory": "parameterCategory",
+			"parameterName":     "parameterName",
+			"parameterValue":    "parameterValue",
+		},
+		RequestType:  "SetProfileParameter",
+		Response:     &obs_grpc.SetProfileParameterResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetVideoSettingsRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetVideoSettings",
+		Response: &obs_grpc.GetVideoSettingsResponse{
+			BaseHeight:     4,
+			BaseWidth:      3,
+			FpsDenominator: 2,
+			FpsNumerator:   1,
+			OutputHeight:   6,
+			OutputWidth:    5,
+		},
+		ResponseData: map[string]any{
+			"baseHeight":     4.0,
+			"baseWidth":      3.0,
+			"fpsDenominator": 2.0,
+			"fpsNumerator":   1.0,
+			"outputHeight":   6.0,
+			"outputWidth":    5.0,
+		},
+	},
+	{
+		Request: &obs_grpc.SetVideoSettingsRequest{
+			BaseHeight:     ptr(int64(4)),
+			BaseWidth:      ptr(int64(3)),
+			FpsDenominator: ptr(int64(2)),
+			FpsNumerator:   ptr(int64(1)),
+			OutputHeight:   ptr(int64(6)),
+			OutputWidth:    ptr(int64(5)),
+		},
+		RequestData: map[string]any{
+			"baseHeight":     4.0,
+			"baseWidth":      3.0,
+			"fpsDenominator": 2.0,
+			"fpsNumerator":   1.0,
+			"outputHeight":   6.0,
+			"outputWidth":    5.0,
+		},
+		RequestType:  "SetVideoSettings",
+		Response:     &obs_grpc.SetVideoSettingsResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		KnownIssue:  "StreamServiceSettings.UseAuth is lost: goobs names it 'use_auth'",
+		Request:     &obs_grpc.GetStreamServiceSettingsRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetStreamServiceSettings",
+		Response: &obs_grpc.GetStreamServiceSettingsResponse{
+			StreamServiceSettings: &obs_grpc.StreamServiceSettings{
+				Bwtest:   true,
+				Key:      "key",
+				Password: "password",
+				Server:   "server",
+				UseAuth:  true,
+				Username: "username",
+			},
+			StreamServiceType: []byte("streamServiceType"),
+		},
+		ResponseData: map[string]any{
+			"streamServiceSettings": map[string]any{
+				"bwtest":   true,
+				"key":      "key",
+				"password": "password",
+				"server":   "server",
+				"useAuth":  true,
+				"username": "username",
+			},
+			"streamServiceType": "streamServiceType",
+		},
+	},
+	{
+		KnownIssue: "StreamServiceSettings.UseAuth is lost: goobs names it 'use_auth'",
+		Request: &obs_grpc.SetStreamServiceSettingsRequest{
+			StreamServiceSettings: &obs_grpc.StreamServiceSettings{
+				Bwtest:   true,
+				Key:      "key",
+				Password: "password",
+				Server:   "server",
+				UseAuth:  true,
+				Username: "username",
+			},
+			StreamServiceType: []byte("streamServiceType"),
+		},
+		RequestData: map[string]any{
+			"streamServiceSettings": map[string]any{
+				"bwtest":   true,
+				"key":      "key",
+				"password": "password",
+				"server":   "server",
+				"useAuth":  true,
+				"username": "username",
+			},
+			"streamServiceType": "streamServiceType",
+		},
+		RequestType:  "SetStreamServiceSettings",
+		Response:     &obs_grpc.SetStreamServiceSettingsResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.GetRecordDirectoryRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "GetRecordDirectory",
+		Response:     &obs_grpc.GetRecordDirectoryResponse{RecordDirectory: []byte("recordDirectory")},
+		ResponseData: map[string]any{"recordDirectory": "recordDirectory"},
+	},
+	{
+		Request:      &obs_grpc.SetRecordDirectoryRequest{RecordDirectory: []byte("recordDirectory")},
+		RequestData:  map[string]any{"recordDirectory": "recordDirectory"},
+		RequestType:  "SetRecordDirectory",
+		Response:     &obs_grpc.SetRecordDirectoryResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.GetSourceFilterKindListRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "GetSourceFilterKindList",
+		Response:     &obs_grpc.GetSourceFilterKindListResponse{SourceFilterKinds: []string{"sourceFilterKinds"}},
+		ResponseData: map[string]any{"sourceFilterKinds": []any{"sourceFilterKinds"}},
+	},
+	{
+		KnownIssue:  "Filter.FilterSettings is lost: it is converted as an object with field 'fields'",
+		Request:     &obs_grpc.GetSourceFilterListRequest{Source: &obs_grpc.GetSourceFilterListRequest_SourceName{SourceName: "sourceName"}},
+		RequestData: map[string]any{"sourceName": "sourceName"},
+		RequestType: "GetSourceFilterList",
+		Response: &obs_grpc.GetSourceFilterListResponse{Filters: []*obs_grpc.Filter{{
+			FilterEnabled: true,
+			FilterIndex:   1,
+			FilterKind:    "filterKind",
+			FilterName:    "filterName",
+			FilterSettings: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+				"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+				"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+				"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+			}},
+		}}},
+		ResponseData: map[string]any{"filters": []any{map[string]any{
+			"filterEnabled": true,
+			"filterIndex":   1.0,
+			"filterKind":    "filterKind",
+			"filterName":    "filterName",
+			"filterSettings": map[string]any{
+				"bool":   true,
+				"number": 0.5,
+				"string": "value",
+			},
+		}}},
+	},
+	{
+		Request:     &obs_grpc.GetSourceFilterDefaultSettingsRequest{FilterKind: "filterKind"},
+		RequestData: map[string]any{"filterKind": "filterKind"},
+		RequestType: "GetSourceFilterDefaultSettings",
+		Response: &obs_grpc.GetSourceFilterDefaultSettingsResponse{DefaultFilterSettings: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+			"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+			"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+			"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+		}}},
+		ResponseData: map[string]any{"defaultFilterSettings": map[string]any{
+			"bool":   true,
+			"number": 0.5,
+			"string": "value",
+		}},
+	},
+	{
+		Request: &obs_grpc.CreateSourceFilterRequest{
+			FilterKind: "filterKind",
+			FilterName: "filterName",
+			FilterSettings: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+				"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+				"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+				"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+			}},
+			Source: &obs_grpc.CreateSourceFilterRequest_SourceName{SourceName: "sourceName"},
+		},
+		RequestData: map[string]any{
+			"filterKind": "filterKind",
+			"filterName": "filterName",
+			"filterSettings": map[string]any{
+				"bool":   true,
+				"number": 0.5,
+				"string": "value",
+			},
+			"sourceName": "sourceName",
+		},
+		RequestType:  "CreateSourceFilter",
+		Response:     &obs_grpc.CreateSourceFilterResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.RemoveSourceFilterRequest{
+			FilterName: "filterName",
+			Source:     &obs_grpc.RemoveSourceFilterRequest_SourceName{SourceName: "sourceName"},
+		},
+		RequestData: map[string]any{
+			"filterName": "filterName",
+			"sourceName": "sourceName",
+		},
+		RequestType:  "RemoveSourceFilter",
+		Response:     &obs_grpc.RemoveSourceFilterResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.SetSourceFilterNameRequest{
+			FilterName:    "filterName",
+			NewFilterName: "newFilterName",
+			Source:        &obs_grpc.SetSourceFilterNameRequest_SourceName{SourceName: "sourceName"},
+		},
+		RequestData: map[string]any{
+			"filterName":    "filterName",
+			"newFilterName": "newFilterName",
+			"sourceName":    "sourceName",
+		},
+		RequestType:  "SetSourceFilterName",
+		Response:     &obs_grpc.SetSourceFilterNameResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.GetSourceFilterRequest{
+			FilterName: "filterName",
+			Source:     &obs_grpc.GetSourceFilterRequest_SourceName{SourceName: "sourceName"},
+		},
+		RequestData: map[string]any{
+			"filterName": "filterName",
+			"sourceName": "sourceName",
+		},
+		RequestType: "GetSourceFilter",
+		Response: &obs_grpc.GetSourceFilterResponse{
+			FilterEnabled: true,
+			FilterIndex:   1,
+			FilterKind:    "filterKind",
+			FilterSettings: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+				"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+				"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+				"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+			}},
+		},
+		ResponseData: map[string]any{
+			"filterEnabled": true,
+			"filterIndex":   1.0,
+			"filterKind":    "filterKind",
+			"filterSettings": map[string]any{
+				"bool":   true,
+				"number": 0.5,
+				"string": "value",
+			},
+		},
+	},
+	{
+		Request: &obs_grpc.SetSourceFilterIndexRequest{
+			FilterIndex: 1,
+			FilterName:  "filterName",
+			Source:      &obs_grpc.SetSourceFilterIndexRequest_SourceName{SourceName: "sourceName"},
+		},
+		RequestData: map[string]any{
+			"filterIndex": 1.0,
+			"filterName":  "filterName",
+			"sourceName":  "sourceName",
+		},
+		RequestType:  "SetSourceFilterIndex",
+		Response:     &obs_grpc.SetSourceFilterIndexResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.SetSourceFilterSettingsRequest{
+			FilterName: "filterName",
+			FilterSettings: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+				"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+				"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+				"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+			}},
+			Overlay: ptr(true),
+			Source:  &obs_grpc.SetSourceFilterSettingsRequest_SourceName{SourceName: "sourceName"},
+		},
+		RequestData: map[string]any{
+			"filterName": "filterName",
+			"filterSettings": map[string]any{
+				"bool":   true,
+				"number": 0.5,
+				"string": "value",
+			},
+			"overlay":    true,
+			"sourceName": "sourceName",
+		},
+		RequestType:  "SetSourceFilterSettings",
+		Response:     &obs_grpc.SetSourceFilterSettingsResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.SetSourceFilterEnabledRequest{
+			FilterEnabled: true,
+			FilterName:    "filterName",
+			Source:        &obs_grpc.SetSourceFilterEnabledRequest_SourceName{SourceName: "sourceName"},
+		},
+		RequestData: map[string]any{
+			"filterEnabled": true,
+			"filterName":    "filterName",
+			"sourceName":    "sourceName",
+		},
+		RequestType:  "SetSourceFilterEnabled",
+		Response:     &obs_grpc.SetSourceFilterEnabledResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetVersionRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetVersion",
+		Response: &obs_grpc.GetVersionResponse{
+			AvailableRequests:     [][]byte{[]byte("availableRequests")},
+			ObsVersion:            []byte("obsVersion"),
+			ObsWebSocketVersion:   []byte("obsWebSocketVersion"),
+			Platform:              []byte("platform"),
+			PlatformDescription:   []byte("platformDescription"),
+			RpcVersion:            1,
+			SupportedImageFormats: [][]byte{[]byte("supportedImageFormats")},
+		},
+		ResponseData: map[string]any{
+			"availableRequests":     []any{"availableRequests"},
+			"obsVersion":            "obsVersion",
+			"obsWebSocketVersion":   "obsWebSocketVersion",
+			"platform":              "platform",
+			"platformDescription":   "platformDescription",
+			"rpcVersion":            1.0,
+			"supportedImageFormats": []any{"supportedImageFormats"},
+		},
+	},
+	{
+		Request:     &obs_grpc.GetStatsRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetStats",
+		Response: &obs_grpc.GetStatsResponse{
+			ActiveFps:                        4,
+			AvailableDiskSpace:               3,
+			AverageFrameRenderTime:           5,
+			CpuUsage:                         1,
+			MemoryUsage:                      2,
+			OutputSkippedFrames:              8,
+			OutputTotalFrames:                9,
+			RenderSkippedFrames:              6,
+			RenderTotalFrames:                7,
+			WebSocketSessionIncomingMessages: 10,
+			WebSocketSessionOutgoingMessages: 11,
+		},
+		ResponseData: map[string]any{
+			"activeFps":                        4.0,
+			"availableDiskSpace":               3.0,
+			"averageFrameRenderTime":           5.0,
+			"cpuUsage":                         1.0,
+			"memoryUsage":                      2.0,
+			"outputSkippedFrames":              8.0,
+			"outputTotalFrames":                9.0,
+			"renderSkippedFrames":              6.0,
+			"renderTotalFrames":                7.0,
+			"webSocketSessionIncomingMessages": 10.0,
+			"webSocketSessionOutgoingMessages": 11.0,
+		},
+	},
+	{
+		Request: &obs_grpc.BroadcastCustomEventRequest{EventData: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+			"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+			"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+			"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+		}}},
+		RequestData: map[string]any{"eventData": map[string]any{
+			"bool":   true,
+			"number": 0.5,
+			"string": "value",
+		}},
+		RequestType:  "BroadcastCustomEvent",
+		Response:     &obs_grpc.BroadcastCustomEventResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.CallVendorRequestRequest{
+			RequestData: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+				"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+				"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+				"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+			}},
+			RequestType: []byte("requestType"),
+			VendorName:  "vendorName",
+		},
+		RequestData: map[string]any{
+			"requestData": map[string]any{
+				"bool":   true,
+				"number": 0.5,
+				"string": "value",
+			},
+			"requestType": "requestType",
+			"vendorName":  "vendorName",
+		},
+		RequestType: "CallVendorRequest",
+		Response: &obs_grpc.CallVendorRequestResponse{
+			RequestType: []byte("requestType"),
+			ResponseData: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+				"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+				"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+				"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+			}},
+			VendorName: "vendorName",
+		},
+		ResponseData: map[string]any{
+			"requestType": "requestType",
+			"responseData": map[string]any{
+				"bool":   true,
+				"number": 0.5,
+				"string": "value",
+			},
+			"vendorName": "vendorName",
+		},
+	},
+	{
+		Request:      &obs_grpc.GetHotkeyListRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "GetHotkeyList",
+		Response:     &obs_grpc.GetHotkeyListResponse{Hotkeys: [][]byte{[]byte("hotkeys")}},
+		ResponseData: map[string]any{"hotkeys": []any{"hotkeys"}},
+	},
+	{
+		Request: &obs_grpc.TriggerHotkeyByNameRequest{
+			ContextName: ptr("contextName"),
+			HotkeyName:  "hotkeyName",
+		},
+		RequestData: map[string]any{
+			"contextName": "contextName",
+			"hotkeyName":  "hotkeyName",
+		},
+		RequestType:  "TriggerHotkeyByName",
+		Response:     &obs_grpc.TriggerHotkeyByNameResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		KnownIssue: "keyModifiers.* are not forwarded (and goobs has wrong JSON names of KeyModifiers)",
+		Request: &obs_grpc.TriggerHotkeyByKeySequenceRequest{
+			KeyID:               ptr("keyId"),
+			KeyModifiersAlt:     ptr(true),
+			KeyModifiersCommand: ptr(true),
+			KeyModifiersControl: ptr(true),
+			KeyModifiersShift:   ptr(true),
+		},
+		RequestData: map[string]any{
+			"keyId": "keyId",
+			"keyModifiers": map[string]any{
+				"alt":     true,
+				"command": true,
+				"control": true,
+				"shift":   true,
+			},
+		},
+		RequestType:  "TriggerHotkeyByKeySequence",
+		Response:     &obs_grpc.TriggerHotkeyByKeySequenceResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.SleepRequest{
+			SleepFrames: ptr(int64(2)),
+			SleepMillis: ptr(int64(1)),
+		},
+		RequestData: map[string]any{
+			"sleepFrames": 2.0,
+			"sleepMillis": 1.0,
+		},
+		RequestType:  "Sleep",
+		Response:     &obs_grpc.SleepResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetInputListRequest{InputKind: ptr("inputKind")},
+		RequestData: map[string]any{"inputKind": "inputKind"},
+		RequestType: "GetInputList",
+		Response: &obs_grpc.GetInputListResponse{Inputs: []*obs_grpc.Input{{
+			InputKind:            ptr("inputKind"),
+			InputName:            ptr("inputName"),
+			InputUUID:            ptr("inputUuid"),
+			UnversionedInputKind: ptr("unversionedInputKind"),
+		}}},
+		ResponseData: map[string]any{"inputs": []any{map[string]any{
+			"inputKind":            "inputKind",
+			"inputName":            "inputName",
+			"inputUuid":            "inputUuid",
+			"unversionedInputKind": "unversionedInputKind",
+		}}},
+	},
+	{
+		Request:      &obs_grpc.GetInputKindListRequest{Unversioned: ptr(true)},
+		RequestData:  map[string]any{"unversioned": true},
+		RequestType:  "GetInputKindList",
+		Response:     &obs_grpc.GetInputKindListResponse{InputKinds: []string{"inputKinds"}},
+		ResponseData: map[string]any{"inputKinds": []any{"inputKinds"}},
+	},
+	{
+		Request:     &obs_grpc.GetSpecialInputsRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetSpecialInputs",
+		Response: &obs_grpc.GetSpecialInputsResponse{
+			Desktop1: []byte("desktop1"),
+			Desktop2: []byte("desktop2"),
+			Mic1:     []byte("mic1"),
+			Mic2:     []byte("mic2"),
+			Mic3:     []byte("mic3"),
+			Mic4:     []byte("mic4"),
+		},
+		ResponseData: map[string]any{
+			"desktop1": "desktop1",
+			"desktop2": "desktop2",
+			"mic1":     "mic1",
+			"mic2":     "mic2",
+			"mic3":     "mic3",
+			"mic4":     "mic4",
+		},
+	},
+	{
+		Request: &obs_grpc.CreateInputRequest{
+			InputKind: "inputKind",
+			InputName: "inputName",
+			InputSettings: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+				"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+				"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+				"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+			}},
+			Scene:            &obs_grpc.CreateInputRequest_SceneName{SceneName: "sceneName"},
+			SceneItemEnabled: ptr(true),
+		},
+		RequestData: map[string]any{
+			"inputKind": "inputKind",
+			"inputName": "inputName",
+			"inputSettings": map[string]any{
+				"bool":   true,
+				"number": 0.5,
+				"string": "value",
+			},
+			"sceneItemEnabled": true,
+			"sceneName":        "sceneName",
+		},
+		RequestType: "CreateInput",
+		Response: &obs_grpc.CreateInputResponse{
+			InputUUID:   "inputUuid",
+			SceneItemID: 1,
+		},
+		ResponseData: map[string]any{
+			"inputUuid":   "inputUuid",
+			"sceneItemId": 1.0,
+		},
+	},
+	{
+		Request:      &obs_grpc.RemoveInputRequest{Input: &obs_grpc.RemoveInputRequest_InputName{InputName: "inputName"}},
+		RequestData:  map[string]any{"inputName": "inputName"},
+		RequestType:  "RemoveInput",
+		Response:     &obs_grpc.RemoveInputResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.SetInputNameRequest{
+			Input:        &obs_grpc.SetInputNameRequest_InputName{InputName: "inputName"},
+			NewInputName: "newInputName",
+		},
+		RequestData: map[string]any{
+			"inputName":    "inputName",
+			"newInputName": "newInputName",
+		},
+		RequestType:  "SetInputName",
+		Response:     &obs_grpc.SetInputNameResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetInputDefaultSettingsRequest{InputKind: "inputKind"},
+		RequestData: map[string]any{"inputKind": "inputKind"},
+		RequestType: "GetInputDefaultSettings",
+		Response: &obs_grpc.GetInputDefaultSettingsResponse{DefaultInputSettings: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+			"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+			"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+			"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+		}}},
+		ResponseData: map[string]any{"defaultInputSettings": map[string]any{
+			"bool":   true,
+			"number": 0.5,
+			"string": "value",
+		}},
+	},
+	{
+		Request:     &obs_grpc.GetInputSettingsRequest{Input: &obs_grpc.GetInputSettingsRequest_InputName{InputName: "inputName"}},
+		RequestData: map[string]any{"inputName": "inputName"},
+		RequestType: "GetInputSettings",
+		Response: &obs_grpc.GetInputSettingsResponse{
+			InputKind: "inputKind",
+			InputSettings: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+				"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+				"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+				"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+			}},
+		},
+		ResponseData: map[string]any{
+			"inputKind": "inputKind",
+			"inputSettings": map[string]any{
+				"bool":   true,
+				"number": 0.5,
+				"string": "value",
+			},
+		},
+	},
+	{
+		Request: &obs_grpc.SetInputSettingsRequest{
+			Input: &obs_grpc.SetInputSettingsRequest_InputName{InputName: "inputName"},
+			InputSettings: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+				"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+				"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+				"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+			}},
+			Overlay: ptr(true),
+		},
+		RequestData: map[string]any{
+			"inputName": "inputName",
+			"inputSettings": map[string]any{
+				"bool":   true,
+				"number": 0.5,
+				"string": "value",
+			},
+			"overlay": true,
+		},
+		RequestType:  "SetInputSettings",
+		Response:     &obs_grpc.SetInputSettingsResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.GetInputMuteRequest{Input: &obs_grpc.GetInputMuteRequest_InputName{InputName: "inputName"}},
+		RequestData:  map[string]any{"inputName": "inputName"},
+		RequestType:  "GetInputMute",
+		Response:     &obs_grpc.GetInputMuteResponse{InputMuted: true},
+		ResponseData: map[string]any{"inputMuted": true},
+	},
+	{
+		Request: &obs_grpc.SetInputMuteRequest{
+			Input:      &obs_grpc.SetInputMuteRequest_InputName{InputName: "inputName"},
+			InputMuted: true,
+		},
+		RequestData: map[string]any{
+			"inputMuted": true,
+			"inputName":  "inputName",
+		},
+		RequestType:  "SetInputMute",
+		Response:     &obs_grpc.SetInputMuteResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.ToggleInputMuteRequest{Input: &obs_grpc.ToggleInputMuteRequest_InputName{InputName: "inputName"}},
+		RequestData:  map[string]any{"inputName": "inputName"},
+		RequestType:  "ToggleInputMute",
+		Response:     &obs_grpc.ToggleInputMuteResponse{InputMuted: true},
+		ResponseData: map[string]any{"inputMuted": true},
+	},
+	{
+		Request:     &obs_grpc.GetInputVolumeRequest{Input: &obs_grpc.GetInputVolumeRequest_InputName{InputName: "inputName"}},
+		RequestData: map[string]any{"inputName": "inputName"},
+		RequestType: "GetInputVolume",
+		Response: &obs_grpc.GetInputVolumeResponse{
+			InputVolumeDb:  2.5,
+			InputVolumeMul: 1.5,
+		},
+		ResponseData: map[string]any{
+			"inputVolumeDb":  2.5,
+			"inputVolumeMul": 1.5,
+		},
+	},
+	{
+		Request: &obs_grpc.SetInputVolumeRequest{
+			Input:          &obs_grpc.SetInputVolumeRequest_InputName{InputName: "inputName"},
+			InputVolumeDb:  ptr(2.5),
+			InputVolumeMul: ptr(1.5),
+		},
+		RequestData: map[string]any{
+			"inputName":      "inputName",
+			"inputVolumeDb":  2.5,
+			"inputVolumeMul": 1.5,
+		},
+		RequestType:  "SetInputVolume",
+		Response:     &obs_grpc.SetInputVolumeResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.GetInputAudioBalanceRequest{Input: &obs_grpc.GetInputAudioBalanceRequest_InputName{InputName: "inputName"}},
+		RequestData:  map[string]any{"inputName": "inputName"},
+		RequestType:  "GetInputAudioBalance",
+		Response:     &obs_grpc.GetInputAudioBalanceResponse{InputAudioBalance: 1.5},
+		ResponseData: map[string]any{"inputAudioBalance": 1.5},
+	},
+	{
+		Request: &obs_grpc.SetInputAudioBalanceRequest{
+			Input:             &obs_grpc.SetInputAudioBalanceRequest_InputName{InputName: "inputName"},
+			InputAudioBalance: 1.0,
+		},
+		RequestData: map[string]any{
+			"inputAudioBalance": 1.0,
+			"inputName":         "inputName",
+		},
+		RequestType:  "SetInputAudioBalance",
+		Response:     &obs_grpc.SetInputAudioBalanceResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.GetInputAudioSyncOffsetRequest{Input: &obs_grpc.GetInputAudioSyncOffsetRequest_InputName{InputName: "inputName"}},
+		RequestData:  map[string]any{"inputName": "inputName"},
+		RequestType:  "GetInputAudioSyncOffset",
+		Response:     &obs_grpc.GetInputAudioSyncOffsetResponse{InputAudioSyncOffset: 1},
+		ResponseData: map[string]any{"inputAudioSyncOffset": 1.0},
+	},
+	{
+		Request: &obs_grpc.SetInputAudioSyncOffsetRequest{
+			Input:                &obs_grpc.SetInputAudioSyncOffsetRequest_InputName{InputName: "inputName"},
+			InputAudioSyncOffset: 1,
+		},
+		RequestData: map[string]any{
+			"inputAudioSyncOffset": 1.0,
+			"inputName":            "inputName",
+		},
+		RequestType:  "SetInputAudioSyncOffset",
+		Response:     &obs_grpc.SetInputAudioSyncOffsetResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.GetInputAudioMonitorTypeRequest{Input: &obs_grpc.GetInputAudioMonitorTypeRequest_InputName{InputName: "inputName"}},
+		RequestData:  map[string]any{"inputName": "inputName"},
+		RequestType:  "GetInputAudioMonitorType",
+		Response:     &obs_grpc.GetInputAudioMonitorTypeResponse{MonitorType: []byte("monitorType")},
+		ResponseData: map[string]any{"monitorType": "monitorType"},
+	},
+	{
+		Request: &obs_grpc.SetInputAudioMonitorTypeRequest{
+			Input:       &obs_grpc.SetInputAudioMonitorTypeRequest_InputName{InputName: "inputName"},
+			MonitorType: []byte("monitorType"),
+		},
+		RequestData: map[string]any{
+			"inputName":   "inputName",
+			"monitorType": "monitorType",
+		},
+		RequestType:  "SetInputAudioMonitorType",
+		Response:     &obs_grpc.SetInputAudioMonitorTypeResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		KnownIssue:   "InputAudioTracks is converted as an object with field 'fields', so the tracks are lost",
+		Request:      &obs_grpc.GetInputAudioTracksRequest{Input: &obs_grpc.GetInputAudioTracksRequest_InputName{InputName: "inputName"}},
+		RequestData:  map[string]any{"inputName": "inputName"},
+		RequestType:  "GetInputAudioTracks",
+		Response:     &obs_grpc.GetInputAudioTracksResponse{InputAudioTracks: &obs_grpc.InputAudioTracks{Fields: map[string]*obs_grpc.Any{"1": &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}}}}},
+		ResponseData: map[string]any{"inputAudioTracks": map[string]any{"1": true}},
+	},
+	{
+		KnownIssue: "InputAudioTracks is converted as an object with field 'fields', so the tracks are lost",
+		Request: &obs_grpc.SetInputAudioTracksRequest{
+			Input:            &obs_grpc.SetInputAudioTracksRequest_InputName{InputName: "inputName"},
+			InputAudioTracks: &obs_grpc.InputAudioTracks{Fields: map[string]*obs_grpc.Any{"1": &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}}}},
+		},
+		RequestData: map[string]any{
+			"inputAudioTracks": map[string]any{"1": true},
+			"inputName":        "inputName",
+		},
+		RequestType:  "SetInputAudioTracks",
+		Response:     &obs_grpc.SetInputAudioTracksResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		KnownIssue: "PropertyItem.ItemValue cannot be converted from a JSON value",
+		Request: &obs_grpc.GetInputPropertiesListPropertyItemsRequest{
+			Input:        &obs_grpc.GetInputPropertiesListPropertyItemsRequest_InputName{InputName: "inputName"},
+			PropertyName: "propertyName",
+		},
+		RequestData: map[string]any{
+			"inputName":    "inputName",
+			"propertyName": "propertyName",
+		},
+		RequestType: "GetInputPropertiesListPropertyItems",
+		Response: &obs_grpc.GetInputPropertiesListPropertyItemsResponse{PropertyItems: []*obs_grpc.PropertyItem{{
+			ItemEnabled: true,
+			ItemName:    "itemName",
+			ItemValue:   &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+		}}},
+		ResponseData: map[string]any{"propertyItems": []any{map[string]any{
+			"itemEnabled": true,
+			"itemName":    "itemName",
+			"itemValue":   "value",
+		}}},
+	},
+	{
+		Request: &obs_grpc.PressInputPropertiesButtonRequest{
+			Input:        &obs_grpc.PressInputPropertiesButtonRequest_InputName{InputName: "inputName"},
+			PropertyName: "propertyName",
+		},
+		RequestData: map[string]any{
+			"inputName":    "inputName",
+			"propertyName": "propertyName",
+		},
+		RequestType:  "PressInputPropertiesButton",
+		Response:     &obs_grpc.PressInputPropertiesButtonResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetMediaInputStatusRequest{Input: &obs_grpc.GetMediaInputStatusRequest_InputName{InputName: "inputName"}},
+		RequestData: map[string]any{"inputName": "inputName"},
+		RequestType: "GetMediaInputStatus",
+		Response: &obs_grpc.GetMediaInputStatusResponse{
+			MediaCursor:   2,
+			MediaDuration: 1,
+			MediaState:    []byte("mediaState"),
+		},
+		ResponseData: map[string]any{
+			"mediaCursor":   2.0,
+			"mediaDuration": 1.0,
+			"mediaState":    "mediaState",
+		},
+	},
+	{
+		Request: &obs_grpc.SetMediaInputCursorRequest{
+			Input:       &obs_grpc.SetMediaInputCursorRequest_InputName{InputName: "inputName"},
+			MediaCursor: 1,
+		},
+		RequestData: map[string]any{
+			"inputName":   "inputName",
+			"mediaCursor": 1.0,
+		},
+		RequestType:  "SetMediaInputCursor",
+		Response:     &obs_grpc.SetMediaInputCursorResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.OffsetMediaInputCursorRequest{
+			Input:             &obs_grpc.OffsetMediaInputCursorRequest_InputName{InputName: "inputName"},
+			MediaCursorOffset: 1,
+		},
+		RequestData: map[string]any{
+			"inputName":         "inputName",
+			"mediaCursorOffset": 1.0,
+		},
+		RequestType:  "OffsetMediaInputCursor",
+		Response:     &obs_grpc.OffsetMediaInputCursorResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.TriggerMediaInputActionRequest{
+			Input:       &obs_grpc.TriggerMediaInputActionRequest_InputName{InputName: "inputName"},
+			MediaAction: "mediaAction",
+		},
+		RequestData: map[string]any{
+			"inputName":   "inputName",
+			"mediaAction": "mediaAction",
+		},
+		RequestType:  "TriggerMediaInputAction",
+		Response:     &obs_grpc.TriggerMediaInputActionResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.GetVirtualCamStatusRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "GetVirtualCamStatus",
+		Response:     &obs_grpc.GetVirtualCamStatusResponse{OutputActive: true},
+		ResponseData: map[string]any{"outputActive": true},
+	},
+	{
+		Request:      &obs_grpc.ToggleVirtualCamRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "ToggleVirtualCam",
+		Response:     &obs_grpc.ToggleVirtualCamResponse{OutputActive: true},
+		ResponseData: map[string]any{"outputActive": true},
+	},
+	{
+		Request:      &obs_grpc.StartVirtualCamRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "StartVirtualCam",
+		Response:     &obs_grpc.StartVirtualCamResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.StopVirtualCamRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "StopVirtualCam",
+		Response:     &obs_grpc.StopVirtualCamResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.GetReplayBufferStatusRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "GetReplayBufferStatus",
+		Response:     &obs_grpc.GetReplayBufferStatusResponse{OutputActive: true},
+		ResponseData: map[string]any{"outputActive": true},
+	},
+	{
+		Request:      &obs_grpc.ToggleReplayBufferRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "ToggleReplayBuffer",
+		Response:     &obs_grpc.ToggleReplayBufferResponse{OutputActive: true},
+		ResponseData: map[string]any{"outputActive": true},
+	},
+	{
+		Request:      &obs_grpc.StartReplayBufferRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "StartReplayBuffer",
+		Response:     &obs_grpc.StartReplayBufferResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.StopReplayBufferRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "StopReplayBuffer",
+		Response:     &obs_grpc.StopReplayBufferResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.SaveReplayBufferRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "SaveReplayBuffer",
+		Response:     &obs_grpc.SaveReplayBufferResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.GetLastReplayBufferReplayRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "GetLastReplayBufferReplay",
+		Response:     &obs_grpc.GetLastReplayBufferReplayResponse{SavedReplayPath: "savedReplayPath"},
+		ResponseData: map[string]any{"savedReplayPath": "savedReplayPath"},
+	},
+	{
+		KnownIssue:  "Output.OutputFlags is repeated in protobuf, but an object in obs-websocket",
+		Request:     &obs_grpc.GetOutputListRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetOutputList",
+		Response: &obs_grpc.GetOutputListResponse{Outputs: []*obs_grpc.Output{{
+			Active: true,
+			Height: 2,
+			Kind:   "kind",
+			Name:   "name",
+			OutputFlags: []*obs_grpc.OutputFlags{{
+				Audio:      true,
+				Encoded:    true,
+				MultiTrack: true,
+				Service:    true,
+				Video:      true,
+			}},
+			Width: 1,
+		}}},
+		ResponseData: map[string]any{"outputs": []any{map[string]any{
+			"active": true,
+			"height": 2.0,
+			"kind":   "kind",
+			"name":   "name",
+			"outputFlags": []any{map[string]any{
+				"audio":      true,
+				"encoded":    true,
+				"multiTrack": true,
+				"service":    true,
+				"video":      true,
+			}},
+			"width": 1.0,
+		}}},
+	},
+	{
+		Request:     &obs_grpc.GetOutputStatusRequest{OutputName: "outputName"},
+		RequestData: map[string]any{"outputName": "outputName"},
+		RequestType: "GetOutputStatus",
+		Response: &obs_grpc.GetOutputStatusResponse{
+			OutputActive:        true,
+			OutputBytes:         3,
+			OutputCongestion:    2,
+			OutputDuration:      1,
+			OutputReconnecting:  true,
+			OutputSkippedFrames: 4,
+			OutputTimecode:      []byte("outputTimecode"),
+			OutputTotalFrames:   5,
+		},
+		ResponseData: map[string]any{
+			"outputActive":        true,
+			"outputBytes":         3.0,
+			"outputCongestion":    2.0,
+			"outputDuration":      1.0,
+			"outputReconnecting":  true,
+			"outputSkippedFrames": 4.0,
+			"outputTimecode":      "outputTimecode",
+			"outputTotalFrames":   5.0,
+		},
+	},
+	{
+		Request:      &obs_grpc.ToggleOutputRequest{OutputName: "outputName"},
+		RequestData:  map[string]any{"outputName": "outputName"},
+		RequestType:  "ToggleOutput",
+		Response:     &obs_grpc.ToggleOutputResponse{OutputActive: true},
+		ResponseData: map[string]any{"outputActive": true},
+	},
+	{
+		Request:      &obs_grpc.StartOutputRequest{OutputName: "outputName"},
+		RequestData:  map[string]any{"outputName": "outputName"},
+		RequestType:  "StartOutput",
+		Response:     &obs_grpc.StartOutputResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.StopOutputRequest{OutputName: "outputName"},
+		RequestData:  map[string]any{"outputName": "outputName"},
+		RequestType:  "StopOutput",
+		Response:     &obs_grpc.StopOutputResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetOutputSettingsRequest{OutputName: "outputName"},
+		RequestData: map[string]any{"outputName": "outputName"},
+		RequestType: "GetOutputSettings",
+		Response: &obs_grpc.GetOutputSettingsResponse{OutputSettings: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+			"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+			"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+			"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+		}}},
+		ResponseData: map[string]any{"outputSettings": map[string]any{
+			"bool":   true,
+			"number": 0.5,
+			"string": "value",
+		}},
+	},
+	{
+		Request: &obs_grpc.SetOutputSettingsRequest{
+			OutputName: "outputName",
+			OutputSettings: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+				"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+				"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+				"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+			}},
+		},
+		RequestData: map[string]any{
+			"outputName": "outputName",
+			"outputSettings": map[string]any{
+				"bool":   true,
+				"number": 0.5,
+				"string": "value",
+			},
+		},
+		RequestType:  "SetOutputSettings",
+		Response:     &obs_grpc.SetOutputSettingsResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetRecordStatusRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetRecordStatus",
+		Response: &obs_grpc.GetRecordStatusResponse{
+			OutputActive:   true,
+			OutputBytes:    2,
+			OutputDuration: 1,
+			OutputPaused:   true,
+			OutputTimecode: []byte("outputTimecode"),
+		},
+		ResponseData: map[string]any{
+			"outputActive":   true,
+			"outputBytes":    2.0,
+			"outputDuration": 1.0,
+			"outputPaused":   true,
+			"outputTimecode": "outputTimecode",
+		},
+	},
+	{
+		Request:      &obs_grpc.ToggleRecordRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "ToggleRecord",
+		Response:     &obs_grpc.ToggleRecordResponse{OutputActive: true},
+		ResponseData: map[string]any{"outputActive": true},
+	},
+	{
+		Request:      &obs_grpc.StartRecordRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "StartRecord",
+		Response:     &obs_grpc.StartRecordResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.StopRecordRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "StopRecord",
+		Response:     &obs_grpc.StopRecordResponse{OutputPath: "outputPath"},
+		ResponseData: map[string]any{"outputPath": "outputPath"},
+	},
+	{
+		Request:      &obs_grpc.ToggleRecordPauseRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "ToggleRecordPause",
+		Response:     &obs_grpc.ToggleRecordPauseResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.PauseRecordRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "PauseRecord",
+		Response:     &obs_grpc.PauseRecordResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.ResumeRecordRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "ResumeRecord",
+		Response:     &obs_grpc.ResumeRecordResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.SplitRecordFileRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "SplitRecordFile",
+		Response:     &obs_grpc.SplitRecordFileResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.CreateRecordChapterRequest{ChapterName: ptr("chapterName")},
+		RequestData:  map[string]any{"chapterName": "chapterName"},
+		RequestType:  "CreateRecordChapter",
+		Response:     &obs_grpc.CreateRecordChapterResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetSceneItemListRequest{Scene: &obs_grpc.GetSceneItemListRequest_SceneName{SceneName: "sceneName"}},
+		RequestData: map[string]any{"sceneName": "sceneName"},
+		RequestType: "GetSceneItemList",
+		Response: &obs_grpc.GetSceneItemListResponse{SceneItems: []*obs_grpc.SceneItem{{
+			InputKind:          "inputKind",
+			IsGroup:            true,
+			SceneItemBlendMode: "sceneItemBlendMode",
+			SceneItemEnabled:   true,
+			SceneItemID:        1,
+			SceneItemIndex:     2,
+			SceneItemLocked:    true,
+			SceneItemTransform: &obs_grpc.SceneItemTransform{
+				Alignment:       3.5,
+				BoundsAlignment: 4.5,
+				BoundsHeight:    5.5,
+				BoundsType:      "boundsType",
+				BoundsWidth:     6.5,
+				CropBottom:      7.5,
+				CropLeft:        8.5,
+				CropRight:       9.5,
+				CropToBounds:    true,
+				CropTop:         10.5,
+				Height:          11.5,
+				PositionX:       12.5,
+				PositionY:       13.5,
+				Rotation:        14.5,
+				ScaleX:          15.5,
+				ScaleY:          16.5,
+				SourceHeight:    17.5,
+				SourceWidth:     18.5,
+				Width:           19.5,
+			},
+			SourceName: "sourceName",
+			SourceType: "sourceType",
+			SourceUUID: "sourceUuid",
+		}}},
+		ResponseData: map[string]any{"sceneItems": []any{map[string]any{
+			"inputKind":          "inputKind",
+			"isGroup":            true,
+			"sceneItemBlendMode": "sceneItemBlendMode",
+			"sceneItemEnabled":   true,
+			"sceneItemId":        1.0,
+			"sceneItemIndex":     2.0,
+			"sceneItemLocked":    true,
+			"sceneItemTransform": map[string]any{
+				"alignment":       3.5,
+				"boundsAlignment": 4.5,
+				"boundsHeight":    5.5,
+				"boundsType":      "boundsType",
+				"boundsWidth":     6.5,
+				"cropBottom":      7.5,
+				"cropLeft":        8.5,
+				"cropRight":       9.5,
+				"cropToBounds":    true,
+				"cropTop":         10.5,
+				"height":          11.5,
+				"positionX":       12.5,
+				"positionY":       13.5,
+				"rotation":        14.5,
+				"scaleX":          15.5,
+				"scaleY":          16.5,
+				"sourceHeight":    17.5,
+				"sourceWidth":     18.5,
+				"width":           19.5,
+			},
+			"sourceName": "sourceName",
+			"sourceType": "sourceType",
+			"sourceUuid": "sourceUuid",
+		}}},
+	},
+	{
+		Request:     &obs_grpc.GetGroupSceneItemListRequest{Scene: &obs_grpc.GetGroupSceneItemListRequest_SceneName{SceneName: "sceneName"}},
+		RequestData: map[string]any{"sceneName": "sceneName"},
+		RequestType: "GetGroupSceneItemList",
+		Response: &obs_grpc.GetGroupSceneItemListResponse{SceneItems: []*obs_grpc.SceneItem{{
+			InputKind:          "inputKind",
+			IsGroup:            true,
+			SceneItemBlendMode: "sceneItemBlendMode",
+			SceneItemEnabled:   true,
+			SceneItemID:        1,
+			SceneItemIndex:     2,
+			SceneItemLocked:    true,
+			SceneItemTransform: &obs_grpc.SceneItemTransform{
+				Alignment:       3.5,
+				BoundsAlignment: 4.5,
+				BoundsHeight:    5.5,
+				BoundsType:      "boundsType",
+				BoundsWidth:     6.5,
+				CropBottom:      7.5,
+				CropLeft:        8.5,
+				CropRight:       9.5,
+				CropToBounds:    true,
+				CropTop:         10.5,
+				Height:          11.5,
+				PositionX:       12.5,
+				PositionY:       13.5,
+				Rotation:        14.5,
+				ScaleX:          15.5,
+				ScaleY:          16.5,
+				SourceHeight:    17.5,
+				SourceWidth:     18.5,
+				Width:           19.5,
+			},
+			SourceName: "sourceName",
+			SourceType: "sourceType",
+			SourceUUID: "sourceUuid",
+		}}},
+		ResponseData: map[string]any{"sceneItems": []any{map[string]any{
+			"inputKind":          "inputKind",
+			"isGroup":            true,
+			"sceneItemBlendMode": "sceneItemBlendMode",
+			"sceneItemEnabled":   true,
+			"sceneItemId":        1.0,
+			"sceneItemIndex":     2.0,
+			"sceneItemLocked":    true,
+			"sceneItemTransform": map[string]any{
+				"alignment":       3.5,
+				"boundsAlignment": 4.5,
+				"boundsHeight":    5.5,
+				"boundsType":      "boundsType",
+				"boundsWidth":     6.5,
+				"cropBottom":      7.5,
+				"cropLeft":        8.5,
+				"cropRight":       9.5,
+				"cropToBounds":    true,
+				"cropTop":         10.5,
+				"height":          11.5,
+				"positionX":       12.5,
+				"positionY":       13.5,
+				"rotation":        14.5,
+				"scaleX":          15.5,
+				"scaleY":          16.5,
+				"sourceHeight":    17.5,
+				"sourceWidth":     18.5,
+				"width":           19.5,
+			},
+			"sourceName": "sourceName",
+			"sourceType": "sourceType",
+			"sourceUuid": "sourceUuid",
+		}}},
+	},
+	{
+		Request: &obs_grpc.GetSceneItemIdRequest{
+			Scene:        &obs_grpc.GetSceneItemIdRequest_SceneName{SceneName: "sceneName"},
+			SearchOffset: ptr(int64(1)),
+			SourceName:   "sourceName",
+		},
+		RequestData: map[string]any{
+			"sceneName":    "sceneName",
+			"searchOffset": 1.0,
+			"sourceName":   "sourceName",
+		},
+		RequestType:  "GetSceneItemId",
+		Response:     &obs_grpc.GetSceneItemIdResponse{SceneItemID: 2},
+		ResponseData: map[string]any{"sceneItemId": 2.0},
+	},
+	{
+		Request: &obs_grpc.GetSceneItemSourceRequest{
+			Scene:       &obs_grpc.GetSceneItemSourceRequest_SceneName{SceneName: "sceneName"},
+			SceneItemID: 1,
+		},
+		RequestData: map[string]any{
+			"sceneItemId": 1.0,
+			"sceneName":   "sceneName",
+		},
+		RequestType: "GetSceneItemSource",
+		Response: &obs_grpc.GetSceneItemSourceResponse{
+			SourceName: "sourceName",
+			SourceUUID: "sourceUuid",
+		},
+		ResponseData: map[string]any{
+			"sourceName": "sourceName",
+			"sourceUuid": "sourceUuid",
+		},
+	},
+	{
+		Request: &obs_grpc.CreateSceneItemRequest{
+			Scene:            &obs_grpc.CreateSceneItemRequest_SceneName{SceneName: "sceneName"},
+			SceneItemEnabled: ptr(true),
+			Source:           &obs_grpc.CreateSceneItemRequest_SourceName{SourceName: "sourceName"},
+		},
+		RequestData: map[string]any{
+			"sceneItemEnabled": true,
+			"sceneName":        "sceneName",
+			"sourceName":       "sourceName",
+		},
+		RequestType:  "CreateSceneItem",
+		Response:     &obs_grpc.CreateSceneItemResponse{SceneItemID: 1},
+		ResponseData: map[string]any{"sceneItemId": 1.0},
+	},
+	{
+		Request: &obs_grpc.RemoveSceneItemRequest{
+			Scene:       &obs_grpc.RemoveSceneItemRequest_SceneName{SceneName: "sceneName"},
+			SceneItemID: 1,
+		},
+		RequestData: map[string]any{
+			"sceneItemId": 1.0,
+			"sceneName":   "sceneName",
+		},
+		RequestType:  "RemoveSceneItem",
+		Response:     &obs_grpc.RemoveSceneItemResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.DuplicateSceneItemRequest{
+			DestinationScene: &obs_grpc.DuplicateSceneItemRequest_DestinationSceneName{DestinationSceneName: "destinationSceneName"},
+			Scene:            &obs_grpc.DuplicateSceneItemRequest_SceneName{SceneName: "sceneName"},
+			SceneItemID:      1,
+		},
+		RequestData: map[string]any{
+			"destinationSceneName": "destinationSceneName",
+			"sceneItemId":          1.0,
+			"sceneName":            "sceneName",
+		},
+		RequestType:  "DuplicateSceneItem",
+		Response:     &obs_grpc.DuplicateSceneItemResponse{SceneItemID: 2},
+		ResponseData: map[string]any{"sceneItemId": 2.0},
+	},
+	{
+		Request: &obs_grpc.GetSceneItemTransformRequest{
+			Scene:       &obs_grpc.GetSceneItemTransformRequest_SceneName{SceneName: "sceneName"},
+			SceneItemID: 1,
+		},
+		RequestData: map[string]any{
+			"sceneItemId": 1.0,
+			"sceneName":   "sceneName",
+		},
+		RequestType: "GetSceneItemTransform",
+		Response: &obs_grpc.GetSceneItemTransformResponse{SceneItemTransform: &obs_grpc.SceneItemTransform{
+			Alignment:       2.5,
+			BoundsAlignment: 3.5,
+			BoundsHeight:    4.5,
+			BoundsType:      "boundsType",
+			BoundsWidth:     5.5,
+			CropBottom:      6.5,
+			CropLeft:        7.5,
+			CropRight:       8.5,
+			CropToBounds:    true,
+			CropTop:         9.5,
+			Height:          10.5,
+			PositionX:       11.5,
+			PositionY:       12.5,
+			Rotation:        13.5,
+			ScaleX:          14.5,
+			ScaleY:          15.5,
+			SourceHeight:    16.5,
+			SourceWidth:     17.5,
+			Width:           18.5,
+		}},
+		ResponseData: map[string]any{"sceneItemTransform": map[string]any{
+			"alignment":       2.5,
+			"boundsAlignment": 3.5,
+			"boundsHeight":    4.5,
+			"boundsType":      "boundsType",
+			"boundsWidth":     5.5,
+			"cropBottom":      6.5,
+			"cropLeft":        7.5,
+			"cropRight":       8.5,
+			"cropToBounds":    true,
+			"cropTop":         9.5,
+			"height":          10.5,
+			"positionX":       11.5,
+			"positionY":       12.5,
+			"rotation":        13.5,
+			"scaleX":          14.5,
+			"scaleY":          15.5,
+			"sourceHeight":    16.5,
+			"sourceWidth":     17.5,
+			"width":           18.5,
+		}},
+	},
+	{
+		Request: &obs_grpc.SetSceneItemTransformRequest{
+			Scene:       &obs_grpc.SetSceneItemTransformRequest_SceneName{SceneName: "sceneName"},
+			SceneItemID: 1,
+			SceneItemTransform: &obs_grpc.SceneItemTransform{
+				Alignment:       2.5,
+				BoundsAlignment: 3.5,
+				BoundsHeight:    4.5,
+				BoundsType:      "boundsType",
+				BoundsWidth:     5.5,
+				CropBottom:      6.5,
+				CropLeft:        7.5,
+				CropRight:       8.5,
+				CropToBounds:    true,
+				CropTop:         9.5,
+				Height:          10.5,
+				PositionX:       11.5,
+				PositionY:       12.5,
+				Rotation:        13.5,
+				ScaleX:          14.5,
+				ScaleY:          15.5,
+				SourceHeight:    16.5,
+				SourceWidth:     17.5,
+				Width:           18.5,
+			},
+		},
+		RequestData: map[string]any{
+			"sceneItemId": 1.0,
+			"sceneItemTransform": map[string]any{
+				"alignment":       2.5,
+				"boundsAlignment": 3.5,
+				"boundsHeight":    4.5,
+				"boundsType":      "boundsType",
+				"boundsWidth":     5.5,
+				"cropBottom":      6.5,
+				"cropLeft":        7.5,
+				"cropRight":       8.5,
+				"cropToBounds":    true,
+				"cropTop":         9.5,
+				"height":          10.5,
+				"positionX":       11.5,
+				"positionY":       12.5,
+				"rotation":        13.5,
+				"scaleX":          14.5,
+				"scaleY":          15.5,
+				"sourceHeight":    16.5,
+				"sourceWidth":     17.5,
+				"width":           18.5,
+			},
+			"sceneName": "sceneName",
+		},
+		RequestType:  "SetSceneItemTransform",
+		Response:     &obs_grpc.SetSceneItemTransformResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.GetSceneItemEnabledRequest{
+			Scene:       &obs_grpc.GetSceneItemEnabledRequest_SceneName{SceneName: "sceneName"},
+			SceneItemID: 1,
+		},
+		RequestData: map[string]any{
+			"sceneItemId": 1.0,
+			"sceneName":   "sceneName",
+		},
+		RequestType:  "GetSceneItemEnabled",
+		Response:     &obs_grpc.GetSceneItemEnabledResponse{SceneItemEnabled: true},
+		ResponseData: map[string]any{"sceneItemEnabled": true},
+	},
+	{
+		Request: &obs_grpc.SetSceneItemEnabledRequest{
+			Scene:            &obs_grpc.SetSceneItemEnabledRequest_SceneName{SceneName: "sceneName"},
+			SceneItemEnabled: true,
+			SceneItemID:      1,
+		},
+		RequestData: map[string]any{
+			"sceneItemEnabled": true,
+			"sceneItemId":      1.0,
+			"sceneName":        "sceneName",
+		},
+		RequestType:  "SetSceneItemEnabled",
+		Response:     &obs_grpc.SetSceneItemEnabledResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.GetSceneItemLockedRequest{
+			Scene:       &obs_grpc.GetSceneItemLockedRequest_SceneName{SceneName: "sceneName"},
+			SceneItemID: 1,
+		},
+		RequestData: map[string]any{
+			"sceneItemId": 1.0,
+			"sceneName":   "sceneName",
+		},
+		RequestType:  "GetSceneItemLocked",
+		Response:     &obs_grpc.GetSceneItemLockedResponse{SceneItemLocked: true},
+		ResponseData: map[string]any{"sceneItemLocked": true},
+	},
+	{
+		Request: &obs_grpc.SetSceneItemLockedRequest{
+			Scene:           &obs_grpc.SetSceneItemLockedRequest_SceneName{SceneName: "sceneName"},
+			SceneItemID:     1,
+			SceneItemLocked: true,
+		},
+		RequestData: map[string]any{
+			"sceneItemId":     1.0,
+			"sceneItemLocked": true,
+			"sceneName":       "sceneName",
+		},
+		RequestType:  "SetSceneItemLocked",
+		Response:     &obs_grpc.SetSceneItemLockedResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.GetSceneItemIndexRequest{
+			Scene:       &obs_grpc.GetSceneItemIndexRequest_SceneName{SceneName: "sceneName"},
+			SceneItemID: 1,
+		},
+		RequestData: map[string]any{
+			"sceneItemId": 1.0,
+			"sceneName":   "sceneName",
+		},
+		RequestType:  "GetSceneItemIndex",
+		Response:     &obs_grpc.GetSceneItemIndexResponse{SceneItemIndex: 2},
+		ResponseData: map[string]any{"sceneItemIndex": 2.0},
+	},
+	{
+		Request: &obs_grpc.SetSceneItemIndexRequest{
+			Scene:          &obs_grpc.SetSceneItemIndexRequest_SceneName{SceneName: "sceneName"},
+			SceneItemID:    1,
+			SceneItemIndex: 2,
+		},
+		RequestData: map[string]any{
+			"sceneItemId":    1.0,
+			"sceneItemIndex": 2.0,
+			"sceneName":      "sceneName",
+		},
+		RequestType:  "SetSceneItemIndex",
+		Response:     &obs_grpc.SetSceneItemIndexResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.GetSceneItemBlendModeRequest{
+			Scene:       &obs_grpc.GetSceneItemBlendModeRequest_SceneName{SceneName: "sceneName"},
+			SceneItemID: 1,
+		},
+		RequestData: map[string]any{
+			"sceneItemId": 1.0,
+			"sceneName":   "sceneName",
+		},
+		RequestType:  "GetSceneItemBlendMode",
+		Response:     &obs_grpc.GetSceneItemBlendModeResponse{SceneItemBlendMode: []byte("sceneItemBlendMode")},
+		ResponseData: map[string]any{"sceneItemBlendMode": "sceneItemBlendMode"},
+	},
+	{
+		Request: &obs_grpc.SetSceneItemBlendModeRequest{
+			Scene:              &obs_grpc.SetSceneItemBlendModeRequest_SceneName{SceneName: "sceneName"},
+			SceneItemBlendMode: []byte("sceneItemBlendMode"),
+			SceneItemID:        1,
+		},
+		RequestData: map[string]any{
+			"sceneItemBlendMode": "sceneItemBlendMode",
+			"sceneItemId":        1.0,
+			"sceneName":          "sceneName",
+		},
+		RequestType:  "SetSceneItemBlendMode",
+		Response:     &obs_grpc.SetSceneItemBlendModeResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetSceneListRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetSceneList",
+		Response: &obs_grpc.GetSceneListResponse{
+			CurrentPreviewSceneName: "currentPreviewSceneName",
+			CurrentPreviewSceneUUID: "currentPreviewSceneUuid",
+			CurrentProgramSceneName: "currentProgramSceneName",
+			CurrentProgramSceneUUID: "currentProgramSceneUuid",
+			Scenes: []*obs_grpc.Scene{{
+				SceneIndex: ptr(int64(1)),
+				SceneName:  ptr("sceneName"),
+				SceneUUID:  ptr("sceneUuid"),
+			}},
+		},
+		ResponseData: map[string]any{
+			"currentPreviewSceneName": "currentPreviewSceneName",
+			"currentPreviewSceneUuid": "currentPreviewSceneUuid",
+			"currentProgramSceneName": "currentProgramSceneName",
+			"currentProgramSceneUuid": "currentProgramSceneUuid",
+			"scenes": []any{map[string]any{
+				"sceneIndex": 1.0,
+				"sceneName":  "sceneName",
+				"sceneUuid":  "sceneUuid",
+			}},
+		},
+	},
+	{
+		Request:      &obs_grpc.GetGroupListRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "GetGroupList",
+		Response:     &obs_grpc.GetGroupListResponse{Groups: [][]byte{[]byte("groups")}},
+		ResponseData: map[string]any{"groups": []any{"groups"}},
+	},
+	{
+		Request:     &obs_grpc.GetCurrentProgramSceneRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetCurrentProgramScene",
+		Response: &obs_grpc.GetCurrentProgramSceneResponse{
+			CurrentProgramSceneName: "currentProgramSceneName",
+			CurrentProgramSceneUUID: "currentProgramSceneUuid",
+			SceneName:               "sceneName",
+			SceneUUID:               "sceneUuid",
+		},
+		ResponseData: map[string]any{
+			"currentProgramSceneName": "currentProgramSceneName",
+			"currentProgramSceneUuid": "currentProgramSceneUuid",
+			"sceneName":               "sceneName",
+			"sceneUuid":               "sceneUuid",
+		},
+	},
+	{
+		Request:      &obs_grpc.SetCurrentProgramSceneRequest{Scene: &obs_grpc.SetCurrentProgramSceneRequest_SceneName{SceneName: "sceneName"}},
+		RequestData:  map[string]any{"sceneName": "sceneName"},
+		RequestType:  "SetCurrentProgramScene",
+		Response:     &obs_grpc.SetCurrentProgramSceneResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetCurrentPreviewSceneRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetCurrentPreviewScene",
+		Response: &obs_grpc.GetCurrentPreviewSceneResponse{
+			CurrentPreviewSceneName: "currentPreviewSceneName",
+			CurrentPreviewSceneUUID: "currentPreviewSceneUuid",
+			SceneName:               "sceneName",
+			SceneUUID:               "sceneUuid",
+		},
+		ResponseData: map[string]any{
+			"currentPreviewSceneName": "currentPreviewSceneName",
+			"currentPreviewSceneUuid": "currentPreviewSceneUuid",
+			"sceneName":               "sceneName",
+			"sceneUuid":               "sceneUuid",
+		},
+	},
+	{
+		Request:      &obs_grpc.SetCurrentPreviewSceneRequest{Scene: &obs_grpc.SetCurrentPreviewSceneRequest_SceneName{SceneName: "sceneName"}},
+		RequestData:  map[string]any{"sceneName": "sceneName"},
+		RequestType:  "SetCurrentPreviewScene",
+		Response:     &obs_grpc.SetCurrentPreviewSceneResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.CreateSceneRequest{SceneName: "sceneName"},
+		RequestData:  map[string]any{"sceneName": "sceneName"},
+		RequestType:  "CreateScene",
+		Response:     &obs_grpc.CreateSceneResponse{SceneUUID: "sceneUuid"},
+		ResponseData: map[string]any{"sceneUuid": "sceneUuid"},
+	},
+	{
+		Request:      &obs_grpc.RemoveSceneRequest{Scene: &obs_grpc.RemoveSceneRequest_SceneName{SceneName: "sceneName"}},
+		RequestData:  map[string]any{"sceneName": "sceneName"},
+		RequestType:  "RemoveScene",
+		Response:     &obs_grpc.RemoveSceneResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.SetSceneNameRequest{
+			NewSceneName: "newSceneName",
+			Scene:        &obs_grpc.SetSceneNameRequest_SceneName{SceneName: "sceneName"},
+		},
+		RequestData: map[string]any{
+			"newSceneName": "newSceneName",
+			"sceneName":    "sceneName",
+		},
+		RequestType:  "SetSceneName",
+		Response:     &obs_grpc.SetSceneNameResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetSceneSceneTransitionOverrideRequest{Scene: &obs_grpc.GetSceneSceneTransitionOverrideRequest_SceneName{SceneName: "sceneName"}},
+		RequestData: map[string]any{"sceneName": "sceneName"},
+		RequestType: "GetSceneSceneTransitionOverride",
+		Response: &obs_grpc.GetSceneSceneTransitionOverrideResponse{
+			TransitionDuration: 1,
+			TransitionName:     "transitionName",
+		},
+		ResponseData: map[string]any{
+			"transitionDuration": 1.0,
+			"transitionName":     "transitionName",
+		},
+	},
+	{
+		Request: &obs_grpc.SetSceneSceneTransitionOverrideRequest{
+			Scene:              &obs_grpc.SetSceneSceneTransitionOverrideRequest_SceneName{SceneName: "sceneName"},
+			TransitionDuration: ptr(int64(50)),
+			TransitionName:     ptr("transitionName"),
+		},
+		RequestData: map[string]any{
+			"sceneName":          "sceneName",
+			"transitionDuration": 50.0,
+			"transitionName":     "transitionName",
+		},
+		RequestType:  "SetSceneSceneTransitionOverride",
+		Response:     &obs_grpc.SetSceneSceneTransitionOverrideResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetSourceActiveRequest{Source: &obs_grpc.GetSourceActiveRequest_SourceName{SourceName: "sourceName"}},
+		RequestData: map[string]any{"sourceName": "sourceName"},
+		RequestType: "GetSourceActive",
+		Response: &obs_grpc.GetSourceActiveResponse{
+			VideoActive:  true,
+			VideoShowing: true,
+		},
+		ResponseData: map[string]any{
+			"videoActive":  true,
+			"videoShowing": true,
+		},
+	},
+	{
+		Request: &obs_grpc.GetSourceScreenshotRequest{
+			ImageCompressionQuality: ptr(int64(3)),
+			ImageFormat:             []byte("imageFormat"),
+			ImageHeight:             ptr(int64(8)),
+			ImageWidth:              ptr(int64(8)),
+			Source:                  &obs_grpc.GetSourceScreenshotRequest_SourceName{SourceName: "sourceName"},
+		},
+		RequestData: map[string]any{
+			"imageCompressionQuality": 3.0,
+			"imageFormat":             "imageFormat",
+			"imageHeight":             8.0,
+			"imageWidth":              8.0,
+			"sourceName":              "sourceName",
+		},
+		RequestType:  "GetSourceScreenshot",
+		Response:     &obs_grpc.GetSourceScreenshotResponse{ImageData: []byte("imageData")},
+		ResponseData: map[string]any{"imageData": "imageData"},
+	},
+	{
+		Request: &obs_grpc.SaveSourceScreenshotRequest{
+			ImageCompressionQuality: ptr(int64(3)),
+			ImageFilePath:           "imageFilePath",
+			ImageFormat:             []byte("imageFormat"),
+			ImageHeight:             ptr(int64(8)),
+			ImageWidth:              ptr(int64(8)),
+			Source:                  &obs_grpc.SaveSourceScreenshotRequest_SourceName{SourceName: "sourceName"},
+		},
+		RequestData: map[string]any{
+			"imageCompressionQuality": 3.0,
+			"imageFilePath":           "imageFilePath",
+			"imageFormat":             "imageFormat",
+			"imageHeight":             8.0,
+			"imageWidth":              8.0,
+			"sourceName":              "sourceName",
+		},
+		RequestType:  "SaveSourceScreenshot",
+		Response:     &obs_grpc.SaveSourceScreenshotResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetStreamStatusRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetStreamStatus",
+		Response: &obs_grpc.GetStreamStatusResponse{
+			OutputActive:        true,
+			OutputBytes:         3,
+			OutputCongestion:    2,
+			OutputDuration:      1,
+			OutputReconnecting:  true,
+			OutputSkippedFrames: 4,
+			OutputTimecode:      []byte("outputTimecode"),
+			OutputTotalFrames:   5,
+		},
+		ResponseData: map[string]any{
+			"outputActive":        true,
+			"outputBytes":         3.0,
+			"outputCongestion":    2.0,
+			"outputDuration":      1.0,
+			"outputReconnecting":  true,
+			"outputSkippedFrames": 4.0,
+			"outputTimecode":      "outputTimecode",
+			"outputTotalFrames":   5.0,
+		},
+	},
+	{
+		Request:      &obs_grpc.ToggleStreamRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "ToggleStream",
+		Response:     &obs_grpc.ToggleStreamResponse{OutputActive: true},
+		ResponseData: map[string]any{"outputActive": true},
+	},
+	{
+		Request:      &obs_grpc.StartStreamRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "StartStream",
+		Response:     &obs_grpc.StartStreamResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.StopStreamRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "StopStream",
+		Response:     &obs_grpc.StopStreamResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.SendStreamCaptionRequest{CaptionText: []byte("captionText")},
+		RequestData:  map[string]any{"captionText": "captionText"},
+		RequestType:  "SendStreamCaption",
+		Response:     &obs_grpc.SendStreamCaptionResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.GetTransitionKindListRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "GetTransitionKindList",
+		Response:     &obs_grpc.GetTransitionKindListResponse{TransitionKinds: []string{"transitionKinds"}},
+		ResponseData: map[string]any{"transitionKinds": []any{"transitionKinds"}},
+	},
+	{
+		Request:     &obs_grpc.GetSceneTransitionListRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetSceneTransitionList",
+		Response: &obs_grpc.GetSceneTransitionListResponse{
+			CurrentSceneTransitionKind: "currentSceneTransitionKind",
+			CurrentSceneTransitionName: "currentSceneTransitionName",
+			CurrentSceneTransitionUUID: "currentSceneTransitionUuid",
+			Transitions: []*obs_grpc.Transition{{
+				TransitionConfigurable: true,
+				TransitionFixed:        true,
+				TransitionKind:         "transitionKind",
+				TransitionName:         "transitionName",
+				TransitionUUID:         "transitionUuid",
+			}},
+		},
+		ResponseData: map[string]any{
+			"currentSceneTransitionKind": "currentSceneTransitionKind",
+			"currentSceneTransitionName": "currentSceneTransitionName",
+			"currentSceneTransitionUuid": "currentSceneTransitionUuid",
+			"transitions": []any{map[string]any{
+				"transitionConfigurable": true,
+				"transitionFixed":        true,
+				"transitionKind":         "transitionKind",
+				"transitionName":         "transitionName",
+				"transitionUuid":         "transitionUuid",
+			}},
+		},
+	},
+	{
+		Request:     &obs_grpc.GetCurrentSceneTransitionRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetCurrentSceneTransition",
+		Response: &obs_grpc.GetCurrentSceneTransitionResponse{
+			TransitionConfigurable: true,
+			TransitionDuration:     1,
+			TransitionFixed:        true,
+			TransitionKind:         "transitionKind",
+			TransitionName:         "transitionName",
+			TransitionSettings: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+				"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+				"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+				"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+			}},
+			TransitionUUID: "transitionUuid",
+		},
+		ResponseData: map[string]any{
+			"transitionConfigurable": true,
+			"transitionDuration":     1.0,
+			"transitionFixed":        true,
+			"transitionKind":         "transitionKind",
+			"transitionName":         "transitionName",
+			"transitionSettings": map[string]any{
+				"bool":   true,
+				"number": 0.5,
+				"string": "value",
+			},
+			"transitionUuid": "transitionUuid",
+		},
+	},
+	{
+		Request:      &obs_grpc.SetCurrentSceneTransitionRequest{TransitionName: "transitionName"},
+		RequestData:  map[string]any{"transitionName": "transitionName"},
+		RequestType:  "SetCurrentSceneTransition",
+		Response:     &obs_grpc.SetCurrentSceneTransitionResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.SetCurrentSceneTransitionDurationRequest{TransitionDuration: 50},
+		RequestData:  map[string]any{"transitionDuration": 50.0},
+		RequestType:  "SetCurrentSceneTransitionDuration",
+		Response:     &obs_grpc.SetCurrentSceneTransitionDurationResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.SetCurrentSceneTransitionSettingsRequest{
+			Overlay: ptr(true),
+			TransitionSettings: &obs_grpc.AbstractObject{Fields: map[string]*obs_grpc.Any{
+				"bool":   &obs_grpc.Any{Union: &obs_grpc.Any_Bool{Bool: true}},
+				"number": &obs_grpc.Any{Union: &obs_grpc.Any_Float{Float: 0.5}},
+				"string": &obs_grpc.Any{Union: &obs_grpc.Any_String_{String_: []byte("value")}},
+			}},
+		},
+		RequestData: map[string]any{
+			"overlay": true,
+			"transitionSettings": map[string]any{
+				"bool":   true,
+				"number": 0.5,
+				"string": "value",
+			},
+		},
+		RequestType:  "SetCurrentSceneTransitionSettings",
+		Response:     &obs_grpc.SetCurrentSceneTransitionSettingsResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.GetCurrentSceneTransitionCursorRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "GetCurrentSceneTransitionCursor",
+		Response:     &obs_grpc.GetCurrentSceneTransitionCursorResponse{TransitionCursor: 1.5},
+		ResponseData: map[string]any{"transitionCursor": 1.5},
+	},
+	{
+		Request:      &obs_grpc.TriggerStudioModeTransitionRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "TriggerStudioModeTransition",
+		Response:     &obs_grpc.TriggerStudioModeTransitionResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.SetTBarPositionRequest{
+			Position: 1,
+			Release:  ptr(true),
+		},
+		RequestData: map[string]any{
+			"position": 1.0,
+			"release":  true,
+		},
+		RequestType:  "SetTBarPosition",
+		Response:     &obs_grpc.SetTBarPositionResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.GetStudioModeEnabledRequest{},
+		RequestData:  map[string]any{},
+		RequestType:  "GetStudioModeEnabled",
+		Response:     &obs_grpc.GetStudioModeEnabledResponse{StudioModeEnabled: true},
+		ResponseData: map[string]any{"studioModeEnabled": true},
+	},
+	{
+		Request:      &obs_grpc.SetStudioModeEnabledRequest{StudioModeEnabled: true},
+		RequestData:  map[string]any{"studioModeEnabled": true},
+		RequestType:  "SetStudioModeEnabled",
+		Response:     &obs_grpc.SetStudioModeEnabledResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.OpenInputPropertiesDialogRequest{Input: &obs_grpc.OpenInputPropertiesDialogRequest_InputName{InputName: "inputName"}},
+		RequestData:  map[string]any{"inputName": "inputName"},
+		RequestType:  "OpenInputPropertiesDialog",
+		Response:     &obs_grpc.OpenInputPropertiesDialogResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.OpenInputFiltersDialogRequest{Input: &obs_grpc.OpenInputFiltersDialogRequest_InputName{InputName: "inputName"}},
+		RequestData:  map[string]any{"inputName": "inputName"},
+		RequestType:  "OpenInputFiltersDialog",
+		Response:     &obs_grpc.OpenInputFiltersDialogResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:      &obs_grpc.OpenInputInteractDialogRequest{Input: &obs_grpc.OpenInputInteractDialogRequest_InputName{InputName: "inputName"}},
+		RequestData:  map[string]any{"inputName": "inputName"},
+		RequestType:  "OpenInputInteractDialog",
+		Response:     &obs_grpc.OpenInputInteractDialogResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request:     &obs_grpc.GetMonitorListRequest{},
+		RequestData: map[string]any{},
+		RequestType: "GetMonitorList",
+		Response: &obs_grpc.GetMonitorListResponse{Monitors: []*obs_grpc.Monitor{{
+			MonitorHeight:    1,
+			MonitorIndex:     2,
+			MonitorName:      "monitorName",
+			MonitorPositionX: 3,
+			MonitorPositionY: 4,
+			MonitorWidth:     5,
+		}}},
+		ResponseData: map[string]any{"monitors": []any{map[string]any{
+			"monitorHeight":    1.0,
+			"monitorIndex":     2.0,
+			"monitorName":      "monitorName",
+			"monitorPositionX": 3.0,
+			"monitorPositionY": 4.0,
+			"monitorWidth":     5.0,
+		}}},
+	},
+	{
+		Request: &obs_grpc.OpenVideoMixProjectorRequest{
+			MonitorIndex:      ptr(int64(1)),
+			ProjectorGeometry: []byte("projectorGeometry"),
+			VideoMixType:      []byte("videoMixType"),
+		},
+		RequestData: map[string]any{
+			"monitorIndex":      1.0,
+			"projectorGeometry": "projectorGeometry",
+			"videoMixType":      "videoMixType",
+		},
+		RequestType:  "OpenVideoMixProjector",
+		Response:     &obs_grpc.OpenVideoMixProjectorResponse{},
+		ResponseData: map[string]any{},
+	},
+	{
+		Request: &obs_grpc.OpenSourceProjectorRequest{
+			MonitorIndex:      ptr(int64(1)),
+			ProjectorGeometry: []byte("projectorGeometry"),
+			Source:            &obs_grpc.OpenSourceProjectorRequest_SourceName{SourceName: "sourceName"},
+		},
+		RequestData: map[string]any{
+			"monitorIndex":      1.0,
+			"projectorGeometry": "projectorGeometry",
+			"sourceName":        "sourceName",
+		},
+		RequestType:  "OpenSourceProjector",
+		Response:     &obs_grpc.OpenSourceProjectorResponse{},
+		ResponseData: map[string]any{},
+	},
+}
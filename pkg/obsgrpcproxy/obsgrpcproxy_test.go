@@ -1,9 +1,27 @@
 package obsgrpcproxy
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
 	"testing"
+	"time"
 
+	"github.com/andreykaipov/goobs"
+	"github.com/andreykaipov/goobs/api/typedefs"
 	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestAbstractObject(t *testing.T) {
@@ -24,3 +42,262 @@ func TestAbstractObject(t *testing.T) {
 		"B": "another string",
 	}, m)
 }
+
+func FuzzAnyConversion(f *testing.F) {
+	for _, seed := range []string{
+		`null`, `true`, `1`, `-0.5`, `1e308`, `"string"`,
+		`[]`, `[1, "a", null, [true]]`,
+		`{}`, `{"a": {"b": [{"c": null}]}}`,
+	} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var v any
+		if json.Unmarshal(data, &v) != nil {
+			return
+		}
+		a, err := anyGo2Protobuf(v)
+		require.NoError(t, err)
+		require.Equal(t, v, AnyProtobuf2Go(roundTripProtobuf(t, a)))
+	})
+}
+
+func FuzzAbstractObjectConversion(f *testing.F) {
+	for _, seed := range []string{
+		`{}`, `{"a": null}`, `{"a": [1, [2, {"b": "c"}]]}`, `{"a": {"b": {}}, "c": 0.25}`,
+	} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var m map[string]any
+		if json.Unmarshal(data, &m) != nil || m == nil {
+			return
+		}
+		obj := roundTripProtobuf(t, ToAbstractObject(m))
+		result, err := FromAbstractObject[map[string]any](obj)
+		require.NoError(t, err)
+		if len(m) == 0 {
+			// an empty object is the same as no object in protobuf
+			require.Empty(t, result)
+			return
+		}
+		require.Equal(t, m, result)
+	})
+}
+
+// FuzzFromAbstractObject feeds arbitrary (user-supplied) messages.
+func FuzzFromAbstractObject(f *testing.F) {
+	for _, seed := range []*obs_grpc.AbstractObject{
+		{},
+		{Fields: map[string]*obs_grpc.Any{"nil": nil, "unset": {}}},
+		{Fields: map[string]*obs_grpc.Any{"nan": {Union: &obs_grpc.Any_Float{Float: math.NaN()}}}},
+		{Fields: map[string]*obs_grpc.Any{"list": {Union: &obs_grpc.Any_List{List: &obs_grpc.AnyList{
+			Items: []*obs_grpc.Any{nil, {Union: &obs_grpc.Any_Object{}}, {Union: &obs_grpc.Any_List{}}},
+		}}}}},
+		{Fields: map[string]*obs_grpc.Any{"invalidUTF8": {Union: &obs_grpc.Any_String_{String_: []byte{0xff}}}}},
+		{Fields: map[string]*obs_grpc.Any{
+			"positionX":  {Union: &obs_grpc.Any_Float{Float: 10.5}},
+			"boundsType": {Union: &obs_grpc.Any_String_{String_: []byte("OBS_BOUNDS_NONE")}},
+		}},
+	} {
+		b, err := proto.Marshal(seed)
+		require.NoError(f, err)
+		f.Add(b)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var obj obs_grpc.AbstractObject
+		if proto.Unmarshal(data, &obj) != nil {
+			return
+		}
+		m, err := FromAbstractObject[map[string]any](&obj)
+		if err != nil {
+			return
+		}
+		// a successful conversion to a typed value round-trips
+		if transform, err := FromAbstractObject[typedefs.SceneItemTransform](&obj); err == nil {
+			again, err := FromAbstractObject[typedefs.SceneItemTransform](ToAbstractObject(transform))
+			require.NoError(t, err)
+			require.Equal(t, transform, again)
+		}
+
+		// after a conversion the values are JSON-compatible, so
+		// the further conversions are lossless
+		m, err = FromAbstractObject[map[string]any](ToAbstractObject(m))
+		require.NoError(t, err)
+		again, err := FromAbstractObject[map[string]any](ToAbstractObject(m))
+		require.NoError(t, err)
+		require.Equal(t, m, again)
+	})
+}
+
+func roundTripProtobuf[T proto.Message](t *testing.T, msg T) T {
+	b, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	result := msg.ProtoReflect().New().Interface().(T)
+	require.NoError(t, proto.Unmarshal(b, result))
+	return result
+}
+
+func TestRequestStatusFromError(t *testing.T) {
+	status, ok := RequestStatusFromError(fmt.Errorf("request GetSceneItemId: ResourceNotFound (600): No scene items were found"))
+	require.True(t, ok)
+	require.Equal(t, obs_grpc.RequestStatus_ResourceNotFound, status)
+
+	status, ok = RequestStatusFromError(fmt.Errorf("query error: %w", fmt.Errorf("request SetCurrentProgramScene: ResourceNotFound (600): No source was found")))
+	require.True(t, ok)
+	require.Equal(t, obs_grpc.RequestStatus_ResourceNotFound, status)
+
+	status, ok = RequestStatusFromError(fmt.Errorf("rpc error: code = Unknown desc = query error: request GetInputMute: InvalidResourceState (604): The specified input does not support audio."))
+	require.True(t, ok)
+	require.Equal(t, obs_grpc.RequestStatus_InvalidResourceState, status)
+
+	_, ok = RequestStatusFromError(fmt.Errorf("request GetStats: timeout waiting for response from server"))
+	require.False(t, ok)
+}
+
+type testRequestHook struct {
+	before func(ctx context.Context, methodName string, req proto.Message) error
+	after  func(ctx context.Context, methodName string, req proto.Message, resp proto.Message, err error) error
+}
+
+func (h testRequestHook) BeforeRequest(ctx context.Context, methodName string, req proto.Message) error {
+	return h.before(ctx, methodName, req)
+}
+
+func (h testRequestHook) AfterRequest(ctx context.Context, methodName string, req proto.Message, resp proto.Message, err error) error {
+	return h.after(ctx, methodName, req, resp, err)
+}
+
+func TestRequestHook(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	errVeto := errors.New("vetoed")
+	var afterCalls []string
+	proxy := New(
+		ctx,
+		func(ctx context.Context) (*goobs.Client, context.CancelFunc, error) {
+			return nil, nil, fmt.Errorf("no OBS in tests")
+		},
+		OptionRequestHook{testRequestHook{
+			before: func(ctx context.Context, methodName string, req proto.Message) error {
+				if req, ok := req.(*obs_grpc.SetCurrentProgramSceneRequest); ok && req.GetSceneName() == "BRB" {
+					return errVeto
+				}
+				return nil
+			},
+			after: func(ctx context.Context, methodName string, req proto.Message, resp proto.Message, err error) error {
+				afterCalls = append(afterCalls, methodName)
+				require.Nil(t, resp)
+				return err
+			},
+		}},
+	)
+
+	_, err := proxy.SetCurrentProgramScene(ctx, &obs_grpc.SetCurrentProgramSceneRequest{Scene: &obs_grpc.SetCurrentProgramSceneRequest_SceneName{SceneName: "BRB"}})
+	require.ErrorIs(t, err, errVeto)
+
+	_, err = proxy.SetCurrentProgramScene(ctx, &obs_grpc.SetCurrentProgramSceneRequest{Scene: &obs_grpc.SetCurrentProgramSceneRequest_SceneName{SceneName: "Live"}})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, errVeto)
+
+	require.Equal(t, []string{"SetCurrentProgramScene", "SetCurrentProgramScene"}, afterCalls)
+}
+
+func TestQueryRetryIsBounded(t *testing.T) {
+	proxy := &Proxy{
+		QueryErrorHandler: func(ctx context.Context, err error) error {
+			return nil
+		},
+		config: Options{
+			OptionRetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, BackoffMultiplier: 2},
+		}.config(),
+	}
+
+	attempts := 0
+	err := proxy.query(context.Background(), "GetStats", func() error {
+		attempts++
+		return fmt.Errorf("request GetStats: NotReady (207)")
+	})
+	require.Error(t, err)
+	require.Equal(t, 3, attempts)
+}
+
+func TestQueryRespectsDeadline(t *testing.T) {
+	proxy := &Proxy{
+		config: Options{
+			OptionRequestTimeout{RequestType: "GetStats", Timeout: 10 * time.Millisecond},
+		}.config(),
+	}
+
+	unblock := make(chan struct{})
+	defer close(unblock)
+	err := proxy.query(context.Background(), "GetStats", func() error {
+		<-unblock
+		return nil
+	})
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
+
+func TestQueryTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	prevTracerProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(tracerProvider)
+	defer otel.SetTracerProvider(prevTracerProvider)
+
+	proxy := &Proxy{
+		QueryErrorHandler: func(ctx context.Context, err error) error {
+			return nil
+		},
+		config: Options{
+			OptionRetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, BackoffMultiplier: 2},
+		}.config(),
+	}
+
+	ctx, parent := tracerProvider.Tracer("test").Start(context.Background(), "/obs_grpc.OBS/GetStats")
+	attempts := 0
+	err := proxy.query(ctx, "GetStats", func() error {
+		attempts++
+		if attempts == 1 {
+			return fmt.Errorf("request GetStats: NotReady (207)")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	parent.End()
+
+	attributes := func(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+		result := map[attribute.Key]attribute.Value{}
+		for _, kv := range span.Attributes {
+			result[kv.Key] = kv.Value
+		}
+		return result
+	}
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+	attemptSpans, parentSpan := spans[:2], spans[2]
+	require.Equal(t, parent.SpanContext().SpanID(), parentSpan.SpanContext.SpanID())
+	require.Equal(t, "GetStats", attributes(parentSpan)["obs.request_type"].AsString())
+
+	// each attempt has its own span, which is a child of the span of the call
+	for attempt, span := range attemptSpans {
+		require.Equal(t, "obs-websocket/GetStats", span.Name)
+		require.Equal(t, trace.SpanKindClient, span.SpanKind)
+		require.Equal(t, parent.SpanContext().TraceID(), span.SpanContext.TraceID())
+		require.Equal(t, parent.SpanContext().SpanID(), span.Parent.SpanID())
+		attrs := attributes(span)
+		require.Equal(t, "GetStats", attrs["obs.request_type"].AsString())
+		require.Equal(t, int64(attempt), attrs["obs.attempt"].AsInt64())
+	}
+	require.NotEqual(t, attemptSpans[0].SpanContext.SpanID(), attemptSpans[1].SpanContext.SpanID())
+
+	require.Equal(t, otelcodes.Error, attemptSpans[0].Status.Code)
+	require.Equal(t, "NotReady", attributes(attemptSpans[0])["obs.request_status"].AsString())
+	require.Equal(t, int64(obs_grpc.RequestStatus_NotReady), attributes(attemptSpans[0])["obs.request_status.code"].AsInt64())
+	require.Len(t, attemptSpans[0].Events, 1) // the recorded error
+
+	require.Equal(t, otelcodes.Unset, attemptSpans[1].Status.Code)
+	require.Equal(t, "Success", attributes(attemptSpans[1])["obs.request_status"].AsString())
+}
@@ -0,0 +1,312 @@
+package obsgrpcproxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// OBSJSON2Protobuf fills the message with the values of an object in
+// the representation used by obs-websocket (for example the request data
+// or the event data). Fields are matched case-insensitively, so that for
+// example "sceneItemId" is set to field "sceneItemID". Unknown fields are
+// ignored.
+func OBSJSON2Protobuf(
+	in map[string]any,
+	msg protoreflect.Message,
+) error {
+	fields := msg.Descriptor().Fields()
+	for key, value := range in {
+		fd := findFieldByOBSName(fields, key)
+		if fd == nil || value == nil {
+			continue
+		}
+		if err := setFieldFromOBSJSON(msg, fd, value); err != nil {
+			return fmt.Errorf("unable to set field '%s': %w", key, err)
+		}
+	}
+	return nil
+}
+
+// GoOBSStruct2Protobuf fills the message with the values of a goobs
+// structure (for example an event or a response).
+func GoOBSStruct2Protobuf(
+	in any,
+	msg protoreflect.Message,
+) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("unable to serialize %T: %w", in, err)
+	}
+	m := map[string]any{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return fmt.Errorf("unable to deserialize %T as an object: %w", in, err)
+	}
+	return OBSJSON2Protobuf(m, msg)
+}
+
+func obsFieldNameKey(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", "."))
+}
+
+func findFieldByOBSName(
+	fields protoreflect.FieldDescriptors,
+	obsName string,
+) protoreflect.FieldDescriptor {
+	key := obsFieldNameKey(obsName)
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if obsFieldNameKey(string(fd.Name())) == key {
+			return fd
+		}
+	}
+	return nil
+}
+
+func setFieldFromOBSJSON(
+	msg protoreflect.Message,
+	fd protoreflect.FieldDescriptor,
+	value any,
+) error {
+	switch {
+	case fd.IsList():
+		items, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("expected an array, received %T", value)
+		}
+		list := msg.Mutable(fd).List()
+		for idx, item := range items {
+			var v protoreflect.Value
+			if fd.Message() != nil {
+				v = list.NewElement()
+				if err := setMessageFromOBSJSON(v.Message(), item); err != nil {
+					return fmt.Errorf("unable to convert item #%d: %w", idx, err)
+				}
+			} else {
+				var err error
+				v, err = obsJSON2ProtobufScalar(fd, item)
+				if err != nil {
+					return fmt.Errorf("unable to convert item #%d: %w", idx, err)
+				}
+			}
+			list.Append(v)
+		}
+		return nil
+	case fd.IsMap():
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("expected an object, received %T", value)
+		}
+		m := msg.Mutable(fd).Map()
+		for k, item := range obj {
+			var v protoreflect.Value
+			if fd.MapValue().Message() != nil {
+				v = m.NewValue()
+				if err := setMessageFromOBSJSON(v.Message(), item); err != nil {
+					return fmt.Errorf("unable to convert item '%s': %w", k, err)
+				}
+			} else {
+				var err error
+				v, err = obsJSON2ProtobufScalar(fd.MapValue(), item)
+				if err != nil {
+					return fmt.Errorf("unable to convert item '%s': %w", k, err)
+				}
+			}
+			m.Set(protoreflect.ValueOfString(k).MapKey(), v)
+		}
+		return nil
+	case fd.Message() != nil:
+		return setMessageFromOBSJSON(msg.Mutable(fd).Message(), value)
+	default:
+		v, err := obsJSON2ProtobufScalar(fd, value)
+		if err != nil {
+			return err
+		}
+		msg.Set(fd, v)
+		return nil
+	}
+}
+
+func setMessageFromOBSJSON(
+	msg protoreflect.Message,
+	value any,
+) error {
+	switch msg.Descriptor().FullName() {
+	case (*obs_grpc.Any)(nil).ProtoReflect().Descriptor().FullName():
+		v, err := anyGo2Protobuf(value)
+		if err != nil {
+			return err
+		}
+		copyMessage(msg, v.ProtoReflect())
+		return nil
+	case (*obs_grpc.AbstractObject)(nil).ProtoReflect().Descriptor().FullName(),
+		(*obs_grpc.InputAudioTracks)(nil).ProtoReflect().Descriptor().FullName():
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("expected an object, received %T", value)
+		}
+		return setFieldFromOBSJSON(msg, msg.Descriptor().Fields().ByName("fields"), obj)
+	}
+
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("expected an object, received %T", value)
+	}
+	return OBSJSON2Protobuf(obj, msg)
+}
+
+func copyMessage(dst, src protoreflect.Message) {
+	src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		dst.Set(fd, v)
+		return true
+	})
+}
+
+func obsJSON2ProtobufScalar(
+	fd protoreflect.FieldDescriptor,
+	value any,
+) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		if s, ok := value.(string); ok {
+			return protoreflect.ValueOfString(s), nil
+		}
+	case protoreflect.BytesKind:
+		if s, ok := value.(string); ok {
+			return protoreflect.ValueOfBytes([]byte(s)), nil
+		}
+	case protoreflect.BoolKind:
+		if b, ok := value.(bool); ok {
+			return protoreflect.ValueOfBool(b), nil
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if f, ok := value.(float64); ok {
+			return protoreflect.ValueOfInt64(int64(f)), nil
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if f, ok := value.(float64); ok {
+			return protoreflect.ValueOfInt32(int32(f)), nil
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if f, ok := value.(float64); ok {
+			return protoreflect.ValueOfUint64(uint64(f)), nil
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if f, ok := value.(float64); ok {
+			return protoreflect.ValueOfUint32(uint32(f)), nil
+		}
+	case protoreflect.DoubleKind:
+		if f, ok := value.(float64); ok {
+			return protoreflect.ValueOfFloat64(f), nil
+		}
+	case protoreflect.FloatKind:
+		if f, ok := value.(float64); ok {
+			return protoreflect.ValueOfFloat32(float32(f)), nil
+		}
+	case protoreflect.EnumKind:
+		switch v := value.(type) {
+		case string:
+			enumValue := fd.Enum().Values().ByName(protoreflect.Name(v))
+			if enumValue == nil {
+				return protoreflect.Value{}, fmt.Errorf("unknown value '%s' of enum %s", v, fd.Enum().FullName())
+			}
+			return protoreflect.ValueOfEnum(enumValue.Number()), nil
+		case float64:
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), nil
+		}
+	}
+	return protoreflect.Value{}, fmt.Errorf("unable to convert %T to %s", value, fd.Kind())
+}
+
+// Protobuf2OBSJSON converts the message to the representation used by
+// obs-websocket (the inverse of OBSJSON2Protobuf). Fields without presence
+// are always included, the others only if they are set.
+func Protobuf2OBSJSON(msg protoreflect.Message) map[string]any {
+	result := map[string]any{}
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.HasPresence() && !msg.Has(fd) {
+			continue
+		}
+		result[OBSFieldName(fd)] = protobufField2OBSJSON(fd, msg.Get(fd))
+	}
+	return result
+}
+
+// OBSFieldName returns the name used by obs-websocket for the field
+// (for example "sceneItemId" for field "sceneItemID").
+func OBSFieldName(fd protoreflect.FieldDescriptor) string {
+	name := strings.ReplaceAll(string(fd.Name()), "_", ".")
+	name = strings.ReplaceAll(name, "UUID", "Uuid")
+	if strings.HasSuffix(name, "ID") {
+		name = name[:len(name)-2] + "Id"
+	}
+	return name
+}
+
+func protobufField2OBSJSON(
+	fd protoreflect.FieldDescriptor,
+	v protoreflect.Value,
+) any {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		result := make([]any, 0, list.Len())
+		for idx := 0; idx < list.Len(); idx++ {
+			result = append(result, protobufValue2OBSJSON(fd, list.Get(idx)))
+		}
+		return result
+	case fd.IsMap():
+		result := map[string]any{}
+		v.Map().Range(func(k protoreflect.MapKey, item protoreflect.Value) bool {
+			result[k.String()] = protobufValue2OBSJSON(fd.MapValue(), item)
+			return true
+		})
+		return result
+	default:
+		return protobufValue2OBSJSON(fd, v)
+	}
+}
+
+func protobufValue2OBSJSON(
+	fd protoreflect.FieldDescriptor,
+	v protoreflect.Value,
+) any {
+	switch fd.Kind() {
+	case protoreflect.BytesKind:
+		return string(v.Bytes())
+	case protoreflect.EnumKind:
+		if enumValue := fd.Enum().Values().ByNumber(v.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return int64(v.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return protobufMessage2OBSJSON(v.Message())
+	default:
+		return v.Interface()
+	}
+}
+
+func protobufMessage2OBSJSON(msg protoreflect.Message) any {
+	switch msg.Descriptor().FullName() {
+	case (*obs_grpc.Any)(nil).ProtoReflect().Descriptor().FullName():
+		oneof := msg.Descriptor().Oneofs().ByName("Union")
+		fd := msg.WhichOneof(oneof)
+		if fd == nil {
+			return nil
+		}
+		return protobufValue2OBSJSON(fd, msg.Get(fd))
+	case (*obs_grpc.AbstractObject)(nil).ProtoReflect().Descriptor().FullName(),
+		(*obs_grpc.InputAudioTracks)(nil).ProtoReflect().Descriptor().FullName():
+		fd := msg.Descriptor().Fields().ByName("fields")
+		return protobufField2OBSJSON(fd, msg.Get(fd))
+	case (*obs_grpc.AnyList)(nil).ProtoReflect().Descriptor().FullName():
+		fd := msg.Descriptor().Fields().ByName("items")
+		return protobufField2OBSJSON(fd, msg.Get(fd))
+	}
+	return Protobuf2OBSJSON(msg)
+}
@@ -1,13 +1,130 @@
 package obsgrpcproxy
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/andreykaipov/goobs"
+	"google.golang.org/protobuf/proto"
+)
 
 type EventHook interface {
 	ProcessEvent(ctx context.Context, event any)
 }
 
+type ConnectionHook interface {
+	ProcessConnectionStateChange(ctx context.Context, isConnected bool)
+}
+
+// DeprecationHook is called on every call of a request which is
+// deprecated by obs-websocket.
+type DeprecationHook interface {
+	ProcessDeprecatedRequest(ctx context.Context, requestType string)
+}
+
+// RequestHook is called from every generated method of Proxy.
+//
+// BeforeRequest is called before the request is sent to OBS; it may modify
+// the request (which may be nil) or veto it by returning an error.
+//
+// AfterRequest is called with the result of the request; it may modify
+// the response or replace the returned error.
+type RequestHook interface {
+	BeforeRequest(ctx context.Context, methodName string, req proto.Message) error
+	AfterRequest(ctx context.Context, methodName string, req proto.Message, resp proto.Message, err error) error
+}
+
+// RetryPolicy defines how many times a request to OBS is attempted if
+// QueryErrorHandler reports that the error was handled, and how long to
+// wait between the attempts.
+type RetryPolicy struct {
+	MaxAttempts       int
+	InitialBackoff    time.Duration
+	MaxBackoff        time.Duration
+	BackoffMultiplier float64
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := float64(p.InitialBackoff)
+	for i := 0; i < attempt; i++ {
+		backoff *= p.BackoffMultiplier
+	}
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+	return time.Duration(backoff)
+}
+
 type configT struct {
-	EventHooks []EventHook
+	EventHooks       []EventHook
+	ConnectionHooks  []ConnectionHook
+	RequestHooks     []RequestHook
+	DeprecationHooks []DeprecationHook
+
+	DefaultRequestTimeout time.Duration
+	RequestTimeouts       map[string]time.Duration
+	RetryPolicy           RetryPolicy
+
+	EventHistoryMaxCount int
+	EventHistoryMaxAge   time.Duration
+}
+
+func defaultConfig() configT {
+	return configT{
+		DefaultRequestTimeout: 15 * time.Second,
+		RequestTimeouts: map[string]time.Duration{
+			// "Sleep" may sleep up to 50 seconds by design
+			"Sleep": time.Minute,
+		},
+		RetryPolicy: RetryPolicy{
+			MaxAttempts:       3,
+			InitialBackoff:    100 * time.Millisecond,
+			MaxBackoff:        2 * time.Second,
+			BackoffMultiplier: 2,
+		},
+		EventHistoryMaxCount: 1000,
+		EventHistoryMaxAge:   5 * time.Minute,
+	}
+}
+
+func (cfg configT) requestTimeout(requestType string) time.Duration {
+	if timeout, ok := cfg.RequestTimeouts[requestType]; ok {
+		return timeout
+	}
+	return cfg.DefaultRequestTimeout
+}
+
+// goOBSResponseTimeoutIfDisabled is the response timeout of goobs used if
+// a request timeout is disabled: goobs does not support disabling it.
+const goOBSResponseTimeoutIfDisabled = 24 * time.Hour
+
+// goOBSResponseTimeout returns the largest of the request timeouts.
+func (cfg configT) goOBSResponseTimeout() time.Duration {
+	result := cfg.DefaultRequestTimeout
+	for _, timeout := range cfg.RequestTimeouts {
+		if timeout > result {
+			result = timeout
+		}
+		if timeout == 0 {
+			return goOBSResponseTimeoutIfDisabled
+		}
+	}
+	if cfg.DefaultRequestTimeout == 0 {
+		return goOBSResponseTimeoutIfDisabled
+	}
+	return result
+}
+
+// GoOBSOptions returns the options of goobs.New to be used with a Proxy
+// having the given options: goobs times out the requests on its own (after
+// 10 seconds by default), so its response timeout is set to the largest
+// of the request timeouts.
+func GoOBSOptions(opts ...Option) []goobs.Option {
+	timeout := Options(opts).config().goOBSResponseTimeout()
+	return []goobs.Option{
+		// goobs takes the number of milliseconds
+		goobs.WithResponseTimeout(time.Duration(timeout.Milliseconds())),
+	}
 }
 
 type Option interface {
@@ -23,7 +140,7 @@ func (s Options) apply(cfg *configT) {
 }
 
 func (s Options) config() configT {
-	cfg := configT{}
+	cfg := defaultConfig()
 	s.apply(&cfg)
 	return cfg
 }
@@ -33,3 +150,65 @@ type OptionEventHook struct{ EventHook }
 func (opt OptionEventHook) apply(cfg *configT) {
 	cfg.EventHooks = append(cfg.EventHooks, opt.EventHook)
 }
+
+type OptionConnectionHook struct{ ConnectionHook }
+
+func (opt OptionConnectionHook) apply(cfg *configT) {
+	cfg.ConnectionHooks = append(cfg.ConnectionHooks, opt.ConnectionHook)
+}
+
+type OptionDeprecationHook struct{ DeprecationHook }
+
+func (opt OptionDeprecationHook) apply(cfg *configT) {
+	cfg.DeprecationHooks = append(cfg.DeprecationHooks, opt.DeprecationHook)
+}
+
+type OptionRequestHook struct{ RequestHook }
+
+func (opt OptionRequestHook) apply(cfg *configT) {
+	cfg.RequestHooks = append(cfg.RequestHooks, opt.RequestHook)
+}
+
+// OptionDefaultRequestTimeout sets the timeout of a request to OBS used if
+// there is no timeout specific to the request type (see OptionRequestTimeout).
+// Zero value disables the timeout (the deadline of the caller is still respected).
+type OptionDefaultRequestTimeout time.Duration
+
+func (opt OptionDefaultRequestTimeout) apply(cfg *configT) {
+	cfg.DefaultRequestTimeout = time.Duration(opt)
+}
+
+// OptionRequestTimeout sets the timeout of requests to OBS of the given type
+// (for example "GetStats"). Zero value disables the timeout.
+type OptionRequestTimeout struct {
+	RequestType string
+	Timeout     time.Duration
+}
+
+func (opt OptionRequestTimeout) apply(cfg *configT) {
+	timeouts := make(map[string]time.Duration, len(cfg.RequestTimeouts)+1)
+	for k, v := range cfg.RequestTimeouts {
+		timeouts[k] = v
+	}
+	timeouts[opt.RequestType] = opt.Timeout
+	cfg.RequestTimeouts = timeouts
+}
+
+type OptionRetryPolicy RetryPolicy
+
+func (opt OptionRetryPolicy) apply(cfg *configT) {
+	cfg.RetryPolicy = RetryPolicy(opt)
+}
+
+// OptionEventHistory limits the history of events kept for the subscribers
+// resuming a subscription (see SubscribeToEventsRequest). Zero MaxCount
+// disables the history, zero MaxAge disables the age limit.
+type OptionEventHistory struct {
+	MaxCount int
+	MaxAge   time.Duration
+}
+
+func (opt OptionEventHistory) apply(cfg *configT) {
+	cfg.EventHistoryMaxCount = opt.MaxCount
+	cfg.EventHistoryMaxAge = opt.MaxAge
+}
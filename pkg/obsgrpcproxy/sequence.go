@@ -0,0 +1,116 @@
+package obsgrpcproxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ExecuteRequestSequence executes the requests one after another.
+//
+// It is not a request batch of obs-websocket (goobs does not support them):
+// each request is still sent to OBS separately, only the round trips
+// between the client and the proxy are saved.
+func (proxy *Proxy) ExecuteRequestSequence(
+	ctx context.Context,
+	req *obs_grpc.ExecuteRequestSequenceRequest,
+) (_ret *obs_grpc.ExecuteRequestSequenceResponse, _err error) {
+	logger.Tracef(ctx, "ExecuteRequestSequence")
+	defer func() {
+		_err = proxy.afterRequest(ctx, "ExecuteRequestSequence", req, _ret, _err)
+		if _err != nil {
+			_ret = nil
+		}
+		logger.Tracef(ctx, "/ExecuteRequestSequence: %v", _err)
+	}()
+	if err := proxy.beforeRequest(ctx, "ExecuteRequestSequence", req); err != nil {
+		return nil, err
+	}
+
+	result := &obs_grpc.ExecuteRequestSequenceResponse{}
+	for idx, item := range req.GetRequests() {
+		itemResult := proxy.executeSequenceItem(ctx, item)
+		result.Results = append(result.Results, itemResult)
+		if !itemResult.RequestStatusResult && req.GetHaltOnFailure() {
+			logger.Debugf(ctx, "request #%d (%s) failed, halting the sequence: %s", idx, item.GetRequestType(), itemResult.RequestStatusComment)
+			break
+		}
+	}
+	return result, nil
+}
+
+func (proxy *Proxy) executeSequenceItem(
+	ctx context.Context,
+	item *obs_grpc.RequestSequenceItem,
+) *obs_grpc.RequestSequenceResult {
+	result := &obs_grpc.RequestSequenceResult{
+		RequestType: item.GetRequestType(),
+	}
+	resp, err := proxy.callMethod(ctx, item.GetRequestType(), item.GetRequestData())
+	if err != nil {
+		requestStatus, _ := RequestStatusFromError(err)
+		result.RequestStatusCode = int64(requestStatus)
+		result.RequestStatusComment = status.Convert(err).Message()
+		return result
+	}
+	b, err := proto.Marshal(resp)
+	if err != nil {
+		result.RequestStatusComment = fmt.Sprintf("unable to serialize the response: %v", err)
+		return result
+	}
+	result.RequestStatusResult = true
+	result.RequestStatusCode = int64(obs_grpc.RequestStatus_Success)
+	result.ResponseData = b
+	return result
+}
+
+// FindMethod returns the unary method of the OBS service with the given
+// name, or nil if there is no such method.
+func FindMethod(methodName string) *grpc.MethodDesc {
+	for idx := range obs_grpc.OBS_ServiceDesc.Methods {
+		if obs_grpc.OBS_ServiceDesc.Methods[idx].MethodName == methodName {
+			return &obs_grpc.OBS_ServiceDesc.Methods[idx]
+		}
+	}
+	return nil
+}
+
+// callMethod calls a unary method of the OBS service by its name.
+func (proxy *Proxy) callMethod(
+	ctx context.Context,
+	methodName string,
+	requestData []byte,
+) (proto.Message, error) {
+	method := FindMethod(methodName)
+	if method == nil || methodName == "ExecuteRequestSequence" {
+		return nil, fmt.Errorf("unknown request type '%s'", methodName)
+	}
+
+	resp, err := method.Handler(proxy, ctx, func(req any) error {
+		return proto.Unmarshal(requestData, req.(proto.Message))
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(proto.Message), nil
+}
+
+func (p *ProxyAsClient) ExecuteRequestSequence(
+	ctx context.Context,
+	req *obs_grpc.ExecuteRequestSequenceRequest,
+	opts ...grpc.CallOption,
+) (*obs_grpc.ExecuteRequestSequenceResponse, error) {
+	return (*Proxy)(p).ExecuteRequestSequence(ctx, req)
+}
+
+func (p *ClientAsServer) ExecuteRequestSequence(
+	ctx context.Context,
+	req *obs_grpc.ExecuteRequestSequenceRequest,
+) (*obs_grpc.ExecuteRequestSequenceResponse, error) {
+	return p.OBSClient.ExecuteRequestSequence(ctx, req)
+}
@@ -0,0 +1,42 @@
+package obsgrpcproxy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/andreykaipov/goobs"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+)
+
+func TestExecuteRequestSequence(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	proxy := New(
+		ctx,
+		func(ctx context.Context) (*goobs.Client, context.CancelFunc, error) {
+			return nil, nil, fmt.Errorf("no OBS in tests")
+		},
+	)
+
+	req := &obs_grpc.ExecuteRequestSequenceRequest{
+		Requests: []*obs_grpc.RequestSequenceItem{
+			{RequestType: "NoSuchRequest"},
+			{RequestType: "GetVersion"},
+		},
+	}
+	resp, err := proxy.ExecuteRequestSequence(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 2)
+	require.False(t, resp.Results[0].RequestStatusResult)
+	require.Contains(t, resp.Results[0].RequestStatusComment, "unknown request type")
+	require.Equal(t, "GetVersion", resp.Results[1].RequestType)
+	require.Contains(t, resp.Results[1].RequestStatusComment, "no OBS in tests")
+
+	req.HaltOnFailure = true
+	resp, err = proxy.ExecuteRequestSequence(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+}
@@ -0,0 +1,120 @@
+package obsgrpcproxy
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fieldRule is a rule of a request field (or of a oneof), generated from
+// valueRestrictions and valueOptional of protocol.json.
+type fieldRule struct {
+	Field string
+
+	// Required is only checked for messages, since an unset value of
+	// the other types can't be told from the zero value (and obs-websocket
+	// accepts the empty strings, like an empty caption).
+	Required bool
+
+	Min          *float64
+	Max          *float64
+	ExclusiveMin *float64
+	ExclusiveMax *float64
+}
+
+// validateRequest checks the request against requestFieldRules, and returns
+// an error with code InvalidArgument (and details of type
+// errdetails.BadRequest) if any rule is violated.
+func validateRequest(requestType string, req proto.Message) error {
+	rules := requestFieldRules[requestType]
+	if len(rules) == 0 {
+		return nil
+	}
+
+	msg := req.ProtoReflect()
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, rule := range rules {
+		var description string
+		if od := msg.Descriptor().Oneofs().ByName(protoreflect.Name(rule.Field)); od != nil {
+			description = rule.checkOneof(msg, od)
+		} else if fd := msg.Descriptor().Fields().ByName(protoreflect.Name(rule.Field)); fd != nil {
+			description = rule.check(msg, fd)
+		}
+		if description != "" {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       rule.Field,
+				Description: description,
+			})
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		descriptions = append(descriptions, violation.Field+": "+violation.Description)
+	}
+	s := status.Newf(codes.InvalidArgument, "invalid request %s: %s", requestType, strings.Join(descriptions, "; "))
+	if withDetails, err := s.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+		s = withDetails
+	}
+	return s.Err()
+}
+
+// checkOneof returns the description of the violation, or an empty string.
+func (rule fieldRule) checkOneof(msg protoreflect.Message, od protoreflect.OneofDescriptor) string {
+	if !rule.Required {
+		return ""
+	}
+	fd := msg.WhichOneof(od)
+	if fd == nil || (fd.Kind() == protoreflect.StringKind && msg.Get(fd).String() == "") {
+		fields := od.Fields()
+		names := make([]string, 0, fields.Len())
+		for idx := 0; idx < fields.Len(); idx++ {
+			names = append(names, string(fields.Get(idx).Name()))
+		}
+		return "one of " + strings.Join(names, ", ") + " is required"
+	}
+	return ""
+}
+
+// check returns the description of the violation, or an empty string.
+func (rule fieldRule) check(msg protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+	if fd.IsList() || fd.IsMap() {
+		return ""
+	}
+
+	if rule.Required && fd.Kind() == protoreflect.MessageKind && !msg.Has(fd) {
+		return "is required"
+	}
+
+	if fd.HasPresence() && !msg.Has(fd) {
+		return ""
+	}
+	var v float64
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		v = float64(msg.Get(fd).Int())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		v = msg.Get(fd).Float()
+	default:
+		return ""
+	}
+	switch {
+	case rule.Min != nil && v < *rule.Min:
+		return fmt.Sprintf("must be >= %v, but is %v", *rule.Min, v)
+	case rule.Max != nil && v > *rule.Max:
+		return fmt.Sprintf("must be <= %v, but is %v", *rule.Max, v)
+	case rule.ExclusiveMin != nil && v <= *rule.ExclusiveMin:
+		return fmt.Sprintf("must be > %v, but is %v", *rule.ExclusiveMin, v)
+	case rule.ExclusiveMax != nil && v >= *rule.ExclusiveMax:
+		return fmt.Sprintf("must be < %v, but is %v", *rule.ExclusiveMax, v)
+	}
+	return ""
+}
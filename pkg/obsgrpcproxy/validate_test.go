@@ -0,0 +1,110 @@
+package obsgrpcproxy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/andreykaipov/goobs"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateRequest(t *testing.T) {
+	mic := &obs_grpc.SetInputVolumeRequest_InputName{InputName: "Mic"}
+	require.NoError(t, validateRequest("SetInputVolume", &obs_grpc.SetInputVolumeRequest{Input: mic}))
+	require.NoError(t, validateRequest("SetInputVolume", &obs_grpc.SetInputVolumeRequest{Input: mic, InputVolumeDb: ptr(-100.0)}))
+
+	err := validateRequest("SetInputVolume", &obs_grpc.SetInputVolumeRequest{
+		Input:          mic,
+		InputVolumeMul: ptr(21.0),
+		InputVolumeDb:  ptr(-101.0),
+	})
+	s := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, s.Code())
+	require.Equal(t, "invalid request SetInputVolume: inputVolumeMul: must be <= 20, but is 21; inputVolumeDb: must be >= -100, but is -101", s.Message())
+	require.Len(t, s.Details(), 1)
+	badRequest := s.Details()[0].(*errdetails.BadRequest)
+	require.Len(t, badRequest.FieldViolations, 2)
+	require.Equal(t, "inputVolumeMul", badRequest.FieldViolations[0].Field)
+	require.Equal(t, "inputVolumeDb", badRequest.FieldViolations[1].Field)
+
+	// the non-optional numbers are always checked
+	err = validateRequest("SetCurrentSceneTransitionDuration", &obs_grpc.SetCurrentSceneTransitionDurationRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = validateRequest("SetInputSettings", &obs_grpc.SetInputSettingsRequest{
+		Input: &obs_grpc.SetInputSettingsRequest_InputName{InputName: "Mic"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.ErrorContains(t, err, "inputSettings: is required")
+	require.NoError(t, validateRequest("SetInputSettings", &obs_grpc.SetInputSettingsRequest{
+		Input:         &obs_grpc.SetInputSettingsRequest_InputName{InputName: "Mic"},
+		InputSettings: &obs_grpc.AbstractObject{},
+	}))
+}
+
+func TestValidateRequestEmptyStrings(t *testing.T) {
+	// obs-websocket accepts the empty strings, an empty caption clears it
+	require.NoError(t, validateRequest("SendStreamCaption", &obs_grpc.SendStreamCaptionRequest{}))
+	require.NoError(t, validateRequest("SetProfileParameter", &obs_grpc.SetProfileParameterRequest{
+		ParameterCategory: []byte("Output"),
+		ParameterName:     "Mode",
+	}))
+}
+
+func TestValidateRequestOneof(t *testing.T) {
+	for _, req := range []*obs_grpc.GetInputMuteRequest{
+		{},
+		{Input: &obs_grpc.GetInputMuteRequest_InputName{}},
+		{Input: &obs_grpc.GetInputMuteRequest_InputUUID{}},
+	} {
+		err := validateRequest("GetInputMute", req)
+		s := status.Convert(err)
+		require.Equal(t, codes.InvalidArgument, s.Code())
+		require.Equal(t, "invalid request GetInputMute: input: one of inputName, inputUUID is required", s.Message())
+		require.Equal(t, "input", s.Details()[0].(*errdetails.BadRequest).FieldViolations[0].Field)
+	}
+	require.NoError(t, validateRequest("GetInputMute", &obs_grpc.GetInputMuteRequest{
+		Input: &obs_grpc.GetInputMuteRequest_InputName{InputName: "Mic"},
+	}))
+	require.NoError(t, validateRequest("GetInputMute", &obs_grpc.GetInputMuteRequest{
+		Input: &obs_grpc.GetInputMuteRequest_InputUUID{InputUUID: "1d5d5f3c-7b8f-4a59-9e1d-9d5a8c7f0e2a"},
+	}))
+
+	// the destination scene defaults to the source scene
+	require.NoError(t, validateRequest("DuplicateSceneItem", &obs_grpc.DuplicateSceneItemRequest{
+		Scene: &obs_grpc.DuplicateSceneItemRequest_SceneName{SceneName: "Live"},
+	}))
+}
+
+func TestProxyValidatesRequests(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	proxy := New(
+		ctx,
+		func(ctx context.Context) (*goobs.Client, context.CancelFunc, error) {
+			return nil, nil, fmt.Errorf("no OBS in tests")
+		},
+	)
+
+	// rejected before connecting to OBS
+	_, err := proxy.SetInputVolume(ctx, &obs_grpc.SetInputVolumeRequest{
+		Input:          &obs_grpc.SetInputVolumeRequest_InputName{InputName: "Mic"},
+		InputVolumeMul: ptr(-1.0),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = proxy.SetInputVolume(ctx, &obs_grpc.SetInputVolumeRequest{
+		Input:          &obs_grpc.SetInputVolumeRequest_InputName{InputName: "Mic"},
+		InputVolumeMul: ptr(1.0),
+	})
+	require.ErrorContains(t, err, "no OBS in tests")
+
+	// the empty strings are sent to OBS
+	_, err = proxy.SendStreamCaption(ctx, &obs_grpc.SendStreamCaptionRequest{CaptionText: []byte{}})
+	require.ErrorContains(t, err, "no OBS in tests")
+}
@@ -0,0 +1,180 @@
+package obsgrpcweb
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+// prefaceTimeout is how long a new connection could take to send enough
+// bytes to distinguish HTTP/2 from HTTP/1.x.
+const prefaceTimeout = 10 * time.Second
+
+// SplitListener splits the connections accepted by the listener: the ones
+// starting with the HTTP/2 client preface (the native gRPC clients) are
+// accepted by grpcListener, and the others (the browsers, which use
+// HTTP/1.x without TLS) are accepted by httpListener. This way both kinds
+// of clients could share a port, while the native clients are still
+// served by grpc.Server.Serve.
+//
+// Closing any of the returned listeners closes the original one.
+func SplitListener(listener net.Listener) (grpcListener, httpListener net.Listener) {
+	s := &splitter{
+		listener: listener,
+		doneCh:   make(chan struct{}),
+	}
+	grpcSide := &splitListener{splitter: s, connCh: make(chan net.Conn)}
+	httpSide := &splitListener{splitter: s, connCh: make(chan net.Conn)}
+	go s.acceptLoop(grpcSide.connCh, httpSide.connCh)
+	return grpcSide, httpSide
+}
+
+type splitter struct {
+	listener  net.Listener
+	closeOnce sync.Once
+	doneCh    chan struct{}
+	err       error
+}
+
+func (s *splitter) acceptLoop(grpcCh, httpCh chan net.Conn) {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			s.closeOnce.Do(func() {
+				s.err = err
+				close(s.doneCh)
+			})
+			return
+		}
+		go s.route(conn, grpcCh, httpCh)
+	}
+}
+
+func (s *splitter) route(conn net.Conn, grpcCh, httpCh chan net.Conn) {
+	preface := []byte(http2.ClientPreface)
+	buf := make([]byte, len(preface))
+	n := 0
+	conn.SetReadDeadline(time.Now().Add(prefaceTimeout))
+	for n < len(buf) && string(buf[:n]) == string(preface[:n]) {
+		r, err := conn.Read(buf[n:])
+		if err != nil {
+			conn.Close()
+			return
+		}
+		n += r
+	}
+	conn.SetReadDeadline(time.Time{})
+
+	ch := httpCh
+	if n == len(preface) && string(buf) == string(preface) {
+		ch = grpcCh
+	}
+	select {
+	case ch <- &peekedConn{Conn: conn, peeked: buf[:n]}:
+	case <-s.doneCh:
+		conn.Close()
+	}
+}
+
+func (s *splitter) close() error {
+	err := s.listener.Close()
+	s.closeOnce.Do(func() {
+		s.err = net.ErrClosed
+		close(s.doneCh)
+	})
+	return err
+}
+
+type splitListener struct {
+	*splitter
+	connCh chan net.Conn
+}
+
+var _ net.Listener = (*splitListener)(nil)
+
+func (l *splitListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.connCh:
+		return conn, nil
+	case <-l.doneCh:
+		return nil, l.err
+	}
+}
+
+func (l *splitListener) Close() error {
+	return l.close()
+}
+
+func (l *splitListener) Addr() net.Addr {
+	return l.listener.Addr()
+}
+
+// peekedConn is a net.Conn returning the already read bytes first.
+type peekedConn struct {
+	net.Conn
+	peeked []byte
+}
+
+func (c *peekedConn) Read(b []byte) (int, error) {
+	if len(c.peeked) > 0 {
+		n := copy(b, c.peeked)
+		c.peeked = c.peeked[n:]
+		return n, nil
+	}
+	return c.Conn.Read(b)
+}
+
+// pipeListener is an in-process net.Listener, the connections are
+// made with dial.
+type pipeListener struct {
+	connCh    chan net.Conn
+	closeOnce sync.Once
+	doneCh    chan struct{}
+}
+
+var _ net.Listener = (*pipeListener)(nil)
+
+func newPipeListener() *pipeListener {
+	return &pipeListener{
+		connCh: make(chan net.Conn),
+		doneCh: make(chan struct{}),
+	}
+}
+
+func (l *pipeListener) dial(ctx context.Context, _ string) (net.Conn, error) {
+	serverConn, clientConn := net.Pipe()
+	select {
+	case l.connCh <- serverConn:
+		return clientConn, nil
+	case <-l.doneCh:
+		return nil, net.ErrClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (l *pipeListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.connCh:
+		return conn, nil
+	case <-l.doneCh:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *pipeListener) Close() error {
+	l.closeOnce.Do(func() { close(l.doneCh) })
+	return nil
+}
+
+func (l *pipeListener) Addr() net.Addr {
+	return pipeAddr{}
+}
+
+type pipeAddr struct{}
+
+func (pipeAddr) Network() string { return "pipe" }
+func (pipeAddr) String() string  { return "obsgrpcweb" }
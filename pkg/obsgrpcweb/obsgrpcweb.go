@@ -0,0 +1,440 @@
+// Package obsgrpcweb serves a gRPC server to browsers using gRPC-Web
+// (including server-streaming RPCs, like SubscribeToEvents).
+//
+// The gRPC-Web requests are translated to native gRPC calls (like Envoy
+// does), so the gRPC server itself is served only with grpc.Server.Serve.
+package obsgrpcweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	contentTypeGRPCWeb     = "application/grpc-web"
+	contentTypeGRPCWebText = "application/grpc-web-text"
+
+	// maxRequestSize is the default maximal message size of gRPC with
+	// the header of the frame.
+	maxRequestSize = 4<<20 + 5
+
+	frameFlagCompressed = 0x01
+	frameFlagTrailer    = 0x80
+)
+
+// requiredRequestHeaders are the request headers used by the gRPC-Web
+// clients, they are allowed in the cross-origin requests in any case.
+var requiredRequestHeaders = []string{
+	"content-type",
+	"x-grpc-web",
+	"x-user-agent",
+	"grpc-timeout",
+}
+
+// Handler serves gRPC-Web requests (and the CORS preflight requests) by
+// calling the gRPC server via a connection to it.
+type Handler struct {
+	config configT
+	conn   *grpc.ClientConn
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+// New returns a Handler calling the given gRPC server. The server is
+// served on an in-process listener (until it is stopped), so it should
+// be called after all the services are registered.
+func New(grpcServer *grpc.Server, opts ...Option) (*Handler, error) {
+	listener := newPipeListener()
+	conn, err := grpc.NewClient(
+		"passthrough:///obsgrpcweb",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(listener.dial),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the connection to the gRPC server: %w", err)
+	}
+	go grpcServer.Serve(listener)
+	return &Handler{
+		config: Options(opts).config(),
+		conn:   conn,
+	}, nil
+}
+
+// Close closes the connection to the gRPC server.
+func (h *Handler) Close() error {
+	return h.conn.Close()
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	origin := req.Header.Get("Origin")
+	isCrossOrigin := origin != "" && !isSameOrigin(origin, req.Host)
+	if isCrossOrigin && !h.config.isAllowedOrigin(origin) {
+		http.Error(w, "the origin is not allowed", http.StatusForbidden)
+		return
+	}
+
+	if req.Method == http.MethodOptions && req.Header.Get("Access-Control-Request-Method") != "" {
+		h.servePreflight(w, req, origin)
+		return
+	}
+
+	contentType := req.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, contentTypeGRPCWeb) {
+		http.NotFound(w, req)
+		return
+	}
+	if req.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	if isCrossOrigin {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+		w.Header().Add("Vary", "Origin")
+	}
+	h.serveGRPCWeb(w, req, contentType)
+}
+
+func isSameOrigin(origin string, host string) bool {
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, host)
+}
+
+func (h *Handler) servePreflight(w http.ResponseWriter, req *http.Request, origin string) {
+	if req.Header.Get("Access-Control-Request-Method") != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusForbidden)
+		return
+	}
+	var requestedHeaders []string
+	for _, header := range strings.Split(req.Header.Get("Access-Control-Request-Headers"), ",") {
+		header = strings.ToLower(strings.TrimSpace(header))
+		if header == "" {
+			continue
+		}
+		if !h.config.isAllowedRequestHeader(header) {
+			http.Error(w, fmt.Sprintf("header '%s' is not allowed", header), http.StatusForbidden)
+			return
+		}
+		requestedHeaders = append(requestedHeaders, header)
+	}
+
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	w.Header().Set("Access-Control-Allow-Credentials", "true")
+	w.Header().Set("Access-Control-Allow-Methods", http.MethodPost)
+	if len(requestedHeaders) > 0 {
+		w.Header().Set("Access-Control-Allow-Headers", strings.Join(requestedHeaders, ", "))
+	}
+	w.Header().Set("Access-Control-Max-Age", "600")
+	w.Header().Add("Vary", "Origin")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) serveGRPCWeb(w http.ResponseWriter, req *http.Request, contentType string) {
+	isText := strings.HasPrefix(contentType, contentTypeGRPCWebText)
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("unable to read the request: %v", err), http.StatusBadRequest)
+		return
+	}
+	if isText {
+		body, err = decodeBase64Chunks(body)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("unable to decode the request: %v", err), http.StatusBadRequest)
+			return
+		}
+	}
+	messages, err := parseFrames(body)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("unable to parse the request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancelFn, err := requestContext(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer cancelFn()
+
+	w.Header().Set("Content-Type", contentType)
+	rw := &responseWriter{w: w, isText: isText}
+	stream, err := h.conn.NewStream(ctx, &grpc.StreamDesc{
+		ServerStreams: true,
+		ClientStreams: true,
+	}, req.URL.Path, grpc.ForceCodec(rawCodec{}))
+	if err != nil {
+		rw.writeTrailer(status.Convert(err), nil)
+		return
+	}
+	rw.writeTrailer(forward(stream, messages, rw), stream.Trailer())
+}
+
+// forward sends the messages to the stream and writes the responses,
+// and returns the status of the call.
+func forward(
+	stream grpc.ClientStream,
+	messages [][]byte,
+	rw *responseWriter,
+) *status.Status {
+	for _, msg := range messages {
+		if err := stream.SendMsg(msg); err != nil {
+			if err == io.EOF {
+				// the server ended the call, the status is returned by RecvMsg
+				break
+			}
+			return status.Convert(err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		return status.Convert(err)
+	}
+
+	if header, err := stream.Header(); err == nil {
+		rw.setHeader(header)
+	}
+	for {
+		var msg []byte
+		err := stream.RecvMsg(&msg)
+		if err == io.EOF {
+			return status.New(codes.OK, "")
+		}
+		if err != nil {
+			return status.Convert(err)
+		}
+		if err := rw.writeFrame(0, msg); err != nil {
+			return status.New(codes.Canceled, err.Error())
+		}
+	}
+}
+
+// requestContext returns the context of the call with the metadata from
+// the headers of the request and the deadline from header grpc-timeout.
+func requestContext(req *http.Request) (context.Context, context.CancelFunc, error) {
+	ctx := req.Context()
+	md := metadata.MD{}
+	for key, values := range req.Header {
+		key = strings.ToLower(key)
+		if isHTTPHeader(key) {
+			continue
+		}
+		md.Append(key, values...)
+	}
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	timeout := req.Header.Get("Grpc-Timeout")
+	if timeout == "" {
+		ctx, cancelFn := context.WithCancel(ctx)
+		return ctx, cancelFn, nil
+	}
+	d, err := parseTimeout(timeout)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse grpc-timeout '%s': %w", timeout, err)
+	}
+	ctx, cancelFn := context.WithTimeout(ctx, d)
+	return ctx, cancelFn, nil
+}
+
+// isHTTPHeader checks if the header belongs to HTTP (or gRPC-Web), rather
+// than to the metadata of the call.
+func isHTTPHeader(key string) bool {
+	switch key {
+	case "accept", "accept-encoding", "accept-language", "connection", "content-length",
+		"content-type", "cookie", "host", "keep-alive", "origin", "pragma", "referer",
+		"te", "transfer-encoding", "upgrade", "user-agent", "x-grpc-web", "x-user-agent",
+		"cache-control":
+		return true
+	}
+	return strings.HasPrefix(key, "grpc-") ||
+		strings.HasPrefix(key, "sec-") ||
+		strings.HasPrefix(key, "access-control-")
+}
+
+// parseTimeout parses the value of header grpc-timeout (like "10S").
+func parseTimeout(s string) (time.Duration, error) {
+	if len(s) < 2 {
+		return 0, fmt.Errorf("too short")
+	}
+	value, err := strconv.ParseInt(s[:len(s)-1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	var unit time.Duration
+	switch s[len(s)-1] {
+	case 'H':
+		unit = time.Hour
+	case 'M':
+		unit = time.Minute
+	case 'S':
+		unit = time.Second
+	case 'm':
+		unit = time.Millisecond
+	case 'u':
+		unit = time.Microsecond
+	case 'n':
+		unit = time.Nanosecond
+	default:
+		return 0, fmt.Errorf("unknown unit '%c'", s[len(s)-1])
+	}
+	return time.Duration(value) * unit, nil
+}
+
+// parseFrames returns the messages of the length-prefixed frames.
+func parseFrames(body []byte) ([][]byte, error) {
+	var messages [][]byte
+	for len(body) > 0 {
+		if len(body) < 5 {
+			return nil, fmt.Errorf("the frame header is truncated")
+		}
+		flags := body[0]
+		size := binary.BigEndian.Uint32(body[1:5])
+		if uint64(len(body)-5) < uint64(size) {
+			return nil, fmt.Errorf("the frame is truncated")
+		}
+		if flags&frameFlagCompressed != 0 {
+			return nil, fmt.Errorf("the compressed messages are not supported")
+		}
+		messages = append(messages, body[5:5+size])
+		body = body[5+size:]
+	}
+	return messages, nil
+}
+
+// decodeBase64Chunks decodes the body of a grpc-web-text request, which
+// could be a concatenation of padded base64 chunks.
+func decodeBase64Chunks(in []byte) ([]byte, error) {
+	in = bytes.TrimSpace(in)
+	var result []byte
+	for len(in) > 0 {
+		end := bytes.IndexByte(in, '=')
+		if end < 0 {
+			end = len(in)
+		}
+		for end < len(in) && in[end] == '=' {
+			end++
+		}
+		chunk, err := base64.StdEncoding.DecodeString(string(in[:end]))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, chunk...)
+		in = in[end:]
+	}
+	return result, nil
+}
+
+type responseWriter struct {
+	w             http.ResponseWriter
+	isText        bool
+	headerWritten bool
+}
+
+func (rw *responseWriter) setHeader(md metadata.MD) {
+	var keys []string
+	for key, values := range md {
+		if key == "content-type" {
+			continue
+		}
+		keys = append(keys, key)
+		for _, value := range values {
+			rw.w.Header().Add(key, value)
+		}
+	}
+	if len(keys) > 0 && rw.w.Header().Get("Access-Control-Allow-Origin") != "" {
+		rw.w.Header().Set("Access-Control-Expose-Headers", strings.Join(keys, ", "))
+	}
+}
+
+func (rw *responseWriter) writeFrame(flags byte, data []byte) error {
+	if !rw.headerWritten {
+		rw.w.WriteHeader(http.StatusOK)
+		rw.headerWritten = true
+	}
+	frame := make([]byte, 5+len(data))
+	frame[0] = flags
+	binary.BigEndian.PutUint32(frame[1:5], uint32(len(data)))
+	copy(frame[5:], data)
+	if rw.isText {
+		frame = []byte(base64.StdEncoding.EncodeToString(frame))
+	}
+	if _, err := rw.w.Write(frame); err != nil {
+		return err
+	}
+	if flusher, ok := rw.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
+
+// writeTrailer writes the status and the trailer metadata of the call as
+// the last frame.
+func (rw *responseWriter) writeTrailer(s *status.Status, trailer metadata.MD) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "grpc-status: %d\r\n", s.Code())
+	if s.Message() != "" {
+		fmt.Fprintf(&buf, "grpc-message: %s\r\n", encodeGRPCMessage(s.Message()))
+	}
+	for key, values := range trailer {
+		if key == "content-type" {
+			// it is sent in the trailers of a trailers-only response
+			continue
+		}
+		for _, value := range values {
+			fmt.Fprintf(&buf, "%s: %s\r\n", key, value)
+		}
+	}
+	rw.writeFrame(frameFlagTrailer, buf.Bytes())
+}
+
+// encodeGRPCMessage percent-encodes the message as required by the gRPC
+// protocol.
+func encodeGRPCMessage(msg string) string {
+	var sb strings.Builder
+	for i := 0; i < len(msg); i++ {
+		c := msg[i]
+		if c >= ' ' && c <= '~' && c != '%' {
+			sb.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&sb, "%%%02X", c)
+	}
+	return sb.String()
+}
+
+// rawCodec passes the serialized messages as is.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v any) ([]byte, error) {
+	b, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("expected []byte, but received %T", v)
+	}
+	return b, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v any) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("expected *[]byte, but received %T", v)
+	}
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+func (rawCodec) Name() string {
+	return "proto"
+}
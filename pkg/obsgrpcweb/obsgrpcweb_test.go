@@ -0,0 +1,219 @@
+package obsgrpcweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andreykaipov/goobs/api/events"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsgrpcproxy"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeServer struct {
+	obs_grpc.UnimplementedOBSServer
+	Events []any
+}
+
+func (srv *fakeServer) GetInputMute(
+	ctx context.Context,
+	req *obs_grpc.GetInputMuteRequest,
+) (*obs_grpc.GetInputMuteResponse, error) {
+	return &obs_grpc.GetInputMuteResponse{InputMuted: req.GetInputName() == "Mic"}, nil
+}
+
+func (srv *fakeServer) SubscribeToEvents(
+	req *obs_grpc.SubscribeToEventsRequest,
+	stream obs_grpc.OBS_SubscribeToEventsServer,
+) error {
+	for idx, ev := range srv.Events {
+		envelope, err := obsgrpcproxy.EventGo2Protobuf(ev)
+		if err != nil {
+			return err
+		}
+		envelope.SeqNo = uint64(idx + 1)
+		if err := stream.Send(envelope); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTestServer(t *testing.T, opts ...Option) *httptest.Server {
+	grpcServer := grpc.NewServer()
+	obs_grpc.RegisterOBSServer(grpcServer, &fakeServer{
+		Events: []any{
+			&events.CurrentProgramSceneChanged{SceneName: "Intro"},
+			&events.CurrentProgramSceneChanged{SceneName: "Live"},
+		},
+	})
+	handler, err := New(grpcServer, opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { handler.Close() })
+	t.Cleanup(grpcServer.Stop)
+	httpServer := httptest.NewServer(handler)
+	t.Cleanup(httpServer.Close)
+	return httpServer
+}
+
+// callGRPCWeb calls the method via gRPC-Web and returns the messages
+// (the data frames) of the response.
+func callGRPCWeb(
+	t *testing.T,
+	url string,
+	origin string,
+	req proto.Message,
+) (*http.Response, [][]byte) {
+	b, err := proto.Marshal(req)
+	require.NoError(t, err)
+	var body bytes.Buffer
+	body.WriteByte(0)
+	binary.Write(&body, binary.BigEndian, uint32(len(b)))
+	body.Write(b)
+
+	httpReq, err := http.NewRequest(http.MethodPost, url, &body)
+	require.NoError(t, err)
+	httpReq.Header.Set("Content-Type", "application/grpc-web+proto")
+	if origin != "" {
+		httpReq.Header.Set("Origin", origin)
+	}
+	resp, err := http.DefaultClient.Do(httpReq)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var messages [][]byte
+	for len(respBody) >= 5 {
+		flags := respBody[0]
+		size := binary.BigEndian.Uint32(respBody[1:5])
+		frame := respBody[5 : 5+size]
+		respBody = respBody[5+size:]
+		if flags&0x80 != 0 {
+			require.Contains(t, string(frame), "grpc-status: 0")
+			continue
+		}
+		messages = append(messages, frame)
+	}
+	return resp, messages
+}
+
+func TestGRPCWeb(t *testing.T) {
+	httpServer := newTestServer(t, OptionAllowedOrigins{"https://panel.example.com"})
+
+	resp, messages := callGRPCWeb(t, httpServer.URL+"/OBS/GetInputMute", "https://panel.example.com", &obs_grpc.GetInputMuteRequest{Input: &obs_grpc.GetInputMuteRequest_InputName{InputName: "Mic"}})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "https://panel.example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	require.Len(t, messages, 1)
+	var muteResp obs_grpc.GetInputMuteResponse
+	require.NoError(t, proto.Unmarshal(messages[0], &muteResp))
+	require.True(t, muteResp.InputMuted)
+
+	_, messages = callGRPCWeb(t, httpServer.URL+"/OBS/SubscribeToEvents", "", &obs_grpc.SubscribeToEventsRequest{})
+	require.Len(t, messages, 2)
+	var ev obs_grpc.EventEnvelope
+	require.NoError(t, proto.Unmarshal(messages[1], &ev))
+	require.Equal(t, uint64(2), ev.GetSeqNo())
+
+	preflight := func(origin string) *http.Response {
+		req, err := http.NewRequest(http.MethodOptions, httpServer.URL+"/OBS/GetInputMute", nil)
+		require.NoError(t, err)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+	require.Equal(t, "https://panel.example.com", preflight("https://panel.example.com").Header.Get("Access-Control-Allow-Origin"))
+	require.Empty(t, preflight("https://evil.example.com").Header.Get("Access-Control-Allow-Origin"))
+}
+
+func TestGRPCWebText(t *testing.T) {
+	httpServer := newTestServer(t)
+
+	b, err := proto.Marshal(&obs_grpc.GetInputMuteRequest{Input: &obs_grpc.GetInputMuteRequest_InputName{InputName: "Mic"}})
+	require.NoError(t, err)
+	frame := append([]byte{0, 0, 0, 0, byte(len(b))}, b...)
+	httpReq, err := http.NewRequest(http.MethodPost, httpServer.URL+"/OBS/GetInputMute", strings.NewReader(base64.StdEncoding.EncodeToString(frame)))
+	require.NoError(t, err)
+	httpReq.Header.Set("Content-Type", "application/grpc-web-text")
+	resp, err := http.DefaultClient.Do(httpReq)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, "application/grpc-web-text", resp.Header.Get("Content-Type"))
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	// the frames are encoded separately
+	body, err := decodeBase64Chunks(respBody)
+	require.NoError(t, err)
+	messages, err := parseFrames(body)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	var muteResp obs_grpc.GetInputMuteResponse
+	require.NoError(t, proto.Unmarshal(messages[0], &muteResp))
+	require.True(t, muteResp.InputMuted)
+	require.Contains(t, string(messages[1]), "grpc-status: 0")
+}
+
+func TestGRPCWebError(t *testing.T) {
+	httpServer := newTestServer(t)
+
+	httpReq, err := http.NewRequest(http.MethodPost, httpServer.URL+"/OBS/GetStats", bytes.NewReader([]byte{0, 0, 0, 0, 0}))
+	require.NoError(t, err)
+	httpReq.Header.Set("Content-Type", "application/grpc-web+proto")
+	resp, err := http.DefaultClient.Do(httpReq)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	messages, err := parseFrames(respBody)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.Contains(t, string(messages[0]), fmt.Sprintf("grpc-status: %d\r\n", codes.Unimplemented))
+	require.Contains(t, string(messages[0]), "grpc-message: method GetStats not implemented")
+}
+
+func TestSplitListener(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	obs_grpc.RegisterOBSServer(grpcServer, &fakeServer{})
+	handler, err := New(grpcServer)
+	require.NoError(t, err)
+	defer handler.Close()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcListener, httpListener := SplitListener(listener)
+	go grpcServer.Serve(grpcListener)
+	defer grpcServer.Stop()
+	go http.Serve(httpListener, handler)
+
+	// a native gRPC client
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	resp, err := obs_grpc.NewOBSClient(conn).GetInputMute(context.Background(), &obs_grpc.GetInputMuteRequest{Input: &obs_grpc.GetInputMuteRequest_InputName{InputName: "Mic"}})
+	require.NoError(t, err)
+	require.True(t, resp.InputMuted)
+
+	// a browser
+	_, messages := callGRPCWeb(t, "http://"+listener.Addr().String()+"/OBS/GetInputMute", "", &obs_grpc.GetInputMuteRequest{Input: &obs_grpc.GetInputMuteRequest_InputName{InputName: "Mic"}})
+	require.Len(t, messages, 1)
+}
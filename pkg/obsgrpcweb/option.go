@@ -0,0 +1,75 @@
+package obsgrpcweb
+
+import (
+	"net/url"
+	"strings"
+)
+
+type configT struct {
+	AllowedOrigins        []string
+	AllowedRequestHeaders []string
+}
+
+func defaultConfig() configT {
+	return configT{
+		AllowedRequestHeaders: []string{"*"},
+	}
+}
+
+// isAllowedOrigin is called only for cross-origin requests.
+func (cfg configT) isAllowedOrigin(origin string) bool {
+	for _, allowed := range cfg.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+		if u, err := url.Parse(origin); err == nil && strings.EqualFold(allowed, u.Host) {
+			return true
+		}
+	}
+	return false
+}
+
+func (cfg configT) isAllowedRequestHeader(header string) bool {
+	for _, allowed := range append(cfg.AllowedRequestHeaders, requiredRequestHeaders...) {
+		if allowed == "*" || strings.EqualFold(allowed, header) {
+			return true
+		}
+	}
+	return false
+}
+
+type Option interface {
+	apply(cfg *configT)
+}
+
+type Options []Option
+
+func (s Options) apply(cfg *configT) {
+	for _, opt := range s {
+		opt.apply(cfg)
+	}
+}
+
+func (s Options) config() configT {
+	cfg := defaultConfig()
+	s.apply(&cfg)
+	return cfg
+}
+
+// OptionAllowedOrigins sets the origins (for example "https://panel.example.com"
+// or just "panel.example.com") allowed to make cross-origin requests;
+// "*" allows any origin. By default cross-origin requests are rejected.
+type OptionAllowedOrigins []string
+
+func (opt OptionAllowedOrigins) apply(cfg *configT) {
+	cfg.AllowedOrigins = append(cfg.AllowedOrigins, opt...)
+}
+
+// OptionAllowedRequestHeaders sets the request headers allowed in
+// cross-origin requests (in addition to the ones required by gRPC-Web);
+// "*" (the default) allows any header.
+type OptionAllowedRequestHeaders []string
+
+func (opt OptionAllowedRequestHeaders) apply(cfg *configT) {
+	cfg.AllowedRequestHeaders = opt
+}
@@ -0,0 +1,90 @@
+// Package obslisteners creates the listeners the servers could be served on,
+// besides the usual TCP ones: unix domain sockets and the sockets passed
+// by systemd (socket activation).
+package obslisteners
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"os/user"
+	"strconv"
+)
+
+// ListenUnix listens on a unix domain socket. A stale socket file left by
+// a previous process is removed. If mode is not zero, then the permissions
+// of the socket file are set to it; if group is not empty, then the group
+// (a name or a numeric ID) of the socket file is set to it. The socket is
+// accessible only by the owner until the group and the permissions are set.
+func ListenUnix(path string, mode fs.FileMode, group string) (net.Listener, error) {
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+	listener, defaultMode, err := listenUnixPrivate(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to listen on '%s': %w", path, err)
+	}
+	if mode == 0 {
+		mode = defaultMode
+	}
+	if err := setPermissions(path, mode, group); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return listener, nil
+}
+
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil
+	case err != nil:
+		return fmt.Errorf("unable to stat '%s': %w", path, err)
+	case info.Mode().Type() != fs.ModeSocket:
+		return fmt.Errorf("'%s' already exists and it is not a socket", path)
+	}
+	if conn, err := net.Dial("unix", path); err == nil {
+		conn.Close()
+		return fmt.Errorf("'%s' is already used by another process", path)
+	}
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("unable to remove the stale socket '%s': %w", path, err)
+	}
+	return nil
+}
+
+func setPermissions(path string, mode fs.FileMode, group string) error {
+	if group != "" {
+		gid, err := lookupGroupID(group)
+		if err != nil {
+			return err
+		}
+		if err := os.Lchown(path, -1, gid); err != nil {
+			return fmt.Errorf("unable to change the group of '%s' to '%s': %w", path, group, err)
+		}
+	}
+	if mode != 0 {
+		if err := os.Chmod(path, mode); err != nil {
+			return fmt.Errorf("unable to change the permissions of '%s' to %v: %w", path, mode, err)
+		}
+	}
+	return nil
+}
+
+func lookupGroupID(group string) (int, error) {
+	if gid, err := strconv.Atoi(group); err == nil {
+		return gid, nil
+	}
+	g, err := user.LookupGroup(group)
+	if err != nil {
+		return 0, fmt.Errorf("unable to find group '%s': %w", group, err)
+	}
+	gid, err := strconv.Atoi(g.Gid)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse the ID '%s' of group '%s': %w", g.Gid, group, err)
+	}
+	return gid, nil
+}
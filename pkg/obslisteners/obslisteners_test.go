@@ -0,0 +1,64 @@
+//go:build unix
+
+package obslisteners
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenUnix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "obsgrpcproxy.sock")
+
+	// a stale socket left by a crashed process
+	stale, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	stale.Close()
+
+	listener, err := ListenUnix(path, 0600, "")
+	require.NoError(t, err)
+	defer listener.Close()
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	_, err = ListenUnix(path, 0600, "")
+	require.ErrorContains(t, err, "already used")
+
+	notSocket := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(notSocket, nil, 0600))
+	_, err = ListenUnix(notSocket, 0600, "")
+	require.ErrorContains(t, err, "not a socket")
+}
+
+func TestListenUnixDefaultMode(t *testing.T) {
+	umask := syscall.Umask(0022)
+	defer syscall.Umask(umask)
+
+	path := filepath.Join(t.TempDir(), "obsgrpcproxy.sock")
+	listener, err := ListenUnix(path, 0, "")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	// the umask is restored after the socket is created
+	require.Equal(t, 0022, syscall.Umask(0022))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0755), info.Mode().Perm())
+}
+
+func TestSystemdNotActivated(t *testing.T) {
+	t.Setenv("LISTEN_PID", "1")
+	t.Setenv("LISTEN_FDS", "1")
+	result, err := Systemd()
+	require.NoError(t, err)
+	require.Empty(t, result)
+	_, ok := os.LookupEnv("LISTEN_FDS")
+	require.False(t, ok)
+}
@@ -0,0 +1,12 @@
+//go:build !unix
+
+package obslisteners
+
+import (
+	"net"
+)
+
+// Systemd returns nothing: socket activation is supported only on unix.
+func Systemd() ([]net.Listener, error) {
+	return nil, nil
+}
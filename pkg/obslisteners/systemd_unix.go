@@ -0,0 +1,61 @@
+//go:build unix
+
+package obslisteners
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// listenFDsStart is the first file descriptor passed by systemd.
+const listenFDsStart = 3
+
+// Systemd returns the listeners passed by systemd (see sd_listen_fds(3)),
+// or nothing if the process was not socket-activated. The environment
+// variables of the protocol are unset, so that they are not inherited by
+// the child processes.
+func Systemd() ([]net.Listener, error) {
+	pid, hasPID := os.LookupEnv("LISTEN_PID")
+	fdsCount, hasFDs := os.LookupEnv("LISTEN_FDS")
+	fdNames := os.Getenv("LISTEN_FDNAMES")
+	os.Unsetenv("LISTEN_PID")
+	os.Unsetenv("LISTEN_FDS")
+	os.Unsetenv("LISTEN_FDNAMES")
+	if !hasPID || !hasFDs {
+		return nil, nil
+	}
+	if pid != strconv.Itoa(os.Getpid()) {
+		// the variables were meant for another process
+		return nil, nil
+	}
+	count, err := strconv.Atoi(fdsCount)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse LISTEN_FDS '%s': %w", fdsCount, err)
+	}
+
+	names := strings.Split(fdNames, ":")
+	var result []net.Listener
+	for idx := 0; idx < count; idx++ {
+		fd := listenFDsStart + idx
+		syscall.CloseOnExec(fd)
+		name := fmt.Sprintf("LISTEN_FD_%d", fd)
+		if idx < len(names) && names[idx] != "" {
+			name = names[idx]
+		}
+		f := os.NewFile(uintptr(fd), name)
+		listener, err := net.FileListener(f)
+		f.Close()
+		if err != nil {
+			for _, l := range result {
+				l.Close()
+			}
+			return nil, fmt.Errorf("unable to use file descriptor %d (%s) as a listener: %w", fd, name, err)
+		}
+		result = append(result, listener)
+	}
+	return result, nil
+}
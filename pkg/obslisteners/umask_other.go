@@ -0,0 +1,15 @@
+//go:build !unix
+
+package obslisteners
+
+import (
+	"io/fs"
+	"net"
+)
+
+// listenUnixPrivate listens on a unix domain socket; there are no unix
+// permissions to restrict on this platform.
+func listenUnixPrivate(path string) (net.Listener, fs.FileMode, error) {
+	listener, err := net.Listen("unix", path)
+	return listener, 0, err
+}
@@ -0,0 +1,25 @@
+//go:build unix
+
+package obslisteners
+
+import (
+	"io/fs"
+	"net"
+	"sync"
+	"syscall"
+)
+
+// umaskLocker serializes the changes of the umask, which is process-wide.
+var umaskLocker sync.Mutex
+
+// listenUnixPrivate listens on a unix domain socket, which is accessible
+// only by the owner until its permissions are changed, and returns the
+// permissions the socket would have by default (according to the umask).
+func listenUnixPrivate(path string) (net.Listener, fs.FileMode, error) {
+	umaskLocker.Lock()
+	defer umaskLocker.Unlock()
+	prevUmask := syscall.Umask(0177)
+	defer syscall.Umask(prevUmask)
+	listener, err := net.Listen("unix", path)
+	return listener, fs.FileMode(0777 &^ prevUmask), err
+}
@@ -0,0 +1,328 @@
+package obsmetrics
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsgrpcproxy"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "obs_grpc_proxy"
+
+type Metrics struct {
+	RequestsTotal   *prometheus.CounterVec
+	RequestDuration *prometheus.HistogramVec
+
+	DeprecatedRequestsTotal *prometheus.CounterVec
+
+	OBSConnected       prometheus.Gauge
+	OBSConnectsTotal   prometheus.Counter
+	OBSReconnectsTotal prometheus.Counter
+	EventsTotal        *prometheus.CounterVec
+
+	OBSActiveFPS              prometheus.Gauge
+	OBSCPUUsage               prometheus.Gauge
+	OBSMemoryUsage            prometheus.Gauge
+	OBSRenderSkippedFrames    prometheus.Gauge
+	OBSRenderTotalFrames      prometheus.Gauge
+	OBSOutputSkippedFrames    prometheus.Gauge
+	OBSOutputTotalFrames      prometheus.Gauge
+	OBSAverageFrameRenderTime prometheus.Gauge
+
+	OutputActive        *prometheus.GaugeVec
+	OutputReconnecting  *prometheus.GaugeVec
+	OutputPaused        *prometheus.GaugeVec
+	OutputCongestion    *prometheus.GaugeVec
+	OutputSkippedFrames *prometheus.GaugeVec
+	OutputTotalFrames   *prometheus.GaugeVec
+	OutputBytes         *prometheus.GaugeVec
+	OutputBitrate       *prometheus.GaugeVec
+
+	hasBeenConnected atomic.Bool
+
+	prevOutputBytesLocker sync.Mutex
+	prevOutputBytes       map[string]outputBytesSample
+}
+
+type outputBytesSample struct {
+	Bytes int64
+	TS    time.Time
+}
+
+var _ obsgrpcproxy.EventHook = (*Metrics)(nil)
+var _ obsgrpcproxy.ConnectionHook = (*Metrics)(nil)
+var _ obsgrpcproxy.DeprecationHook = (*Metrics)(nil)
+
+func New(reg prometheus.Registerer) *Metrics {
+	gauge := func(name, help string) prometheus.Gauge {
+		return prometheus.NewGauge(prometheus.GaugeOpts{Namespace: namespace, Subsystem: "obs", Name: name, Help: help})
+	}
+	outputGauge := func(name, help string) *prometheus.GaugeVec {
+		return prometheus.NewGaugeVec(prometheus.GaugeOpts{Namespace: namespace, Subsystem: "obs_output", Name: name, Help: help}, []string{"output"})
+	}
+
+	m := &Metrics{
+		RequestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "The amount of handled gRPC requests (the failed ones have a code other than OK).",
+		}, []string{"method", "code"}),
+		RequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "The latency of gRPC requests.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 15),
+		}, []string{"method"}),
+		DeprecatedRequestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "deprecated_requests_total",
+			Help:      "The amount of calls of the requests deprecated by obs-websocket.",
+		}, []string{"request_type"}),
+
+		OBSConnected: gauge("connected", "1 if the proxy is currently connected to OBS, 0 otherwise."),
+		OBSConnectsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "obs",
+			Name:      "connects_total",
+			Help:      "The amount of successful connections to OBS.",
+		}),
+		OBSReconnectsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "obs",
+			Name:      "reconnects_total",
+			Help:      "The amount of successful connections to OBS after the connection was lost.",
+		}),
+		EventsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "obs",
+			Name:      "events_total",
+			Help:      "The amount of events received from OBS.",
+		}, []string{"event_type"}),
+
+		OBSActiveFPS:              gauge("active_fps", "Current FPS being rendered."),
+		OBSCPUUsage:               gauge("cpu_usage_percent", "Current CPU usage of OBS."),
+		OBSMemoryUsage:            gauge("memory_usage_megabytes", "Amount of memory used by OBS."),
+		OBSRenderSkippedFrames:    gauge("render_skipped_frames", "Amount of frames skipped by OBS in the render thread."),
+		OBSRenderTotalFrames:      gauge("render_total_frames", "Amount of frames rendered by OBS in the render thread."),
+		OBSOutputSkippedFrames:    gauge("output_thread_skipped_frames", "Amount of frames skipped by OBS in the output thread."),
+		OBSOutputTotalFrames:      gauge("output_thread_total_frames", "Amount of frames outputted by OBS in the output thread."),
+		OBSAverageFrameRenderTime: gauge("average_frame_render_time_milliseconds", "Average time OBS is spending rendering a frame."),
+
+		OutputActive:        outputGauge("active", "1 if the output is active, 0 otherwise."),
+		OutputReconnecting:  outputGauge("reconnecting", "1 if the output is reconnecting, 0 otherwise."),
+		OutputPaused:        outputGauge("paused", "1 if the output is paused, 0 otherwise."),
+		OutputCongestion:    outputGauge("congestion", "Congestion of the output."),
+		OutputSkippedFrames: outputGauge("skipped_frames", "Amount of frames skipped by the output process."),
+		OutputTotalFrames:   outputGauge("total_frames", "Amount of frames delivered by the output process."),
+		OutputBytes:         outputGauge("bytes", "Amount of bytes sent by the output."),
+		OutputBitrate:       outputGauge("bitrate_bits_per_second", "Bitrate of the output, derived from the change of the amount of sent bytes."),
+
+		prevOutputBytes: map[string]outputBytesSample{},
+	}
+
+	if reg != nil {
+		reg.MustRegister(m.collectors()...)
+	}
+	return m
+}
+
+func (m *Metrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		m.RequestsTotal,
+		m.RequestDuration,
+		m.DeprecatedRequestsTotal,
+		m.OBSConnected,
+		m.OBSConnectsTotal,
+		m.OBSReconnectsTotal,
+		m.EventsTotal,
+		m.OBSActiveFPS,
+		m.OBSCPUUsage,
+		m.OBSMemoryUsage,
+		m.OBSRenderSkippedFrames,
+		m.OBSRenderTotalFrames,
+		m.OBSOutputSkippedFrames,
+		m.OBSOutputTotalFrames,
+		m.OBSAverageFrameRenderTime,
+		m.OutputActive,
+		m.OutputReconnecting,
+		m.OutputPaused,
+		m.OutputCongestion,
+		m.OutputSkippedFrames,
+		m.OutputTotalFrames,
+		m.OutputBytes,
+		m.OutputBitrate,
+	}
+}
+
+func (m *Metrics) observeRequest(
+	method string,
+	duration time.Duration,
+	err error,
+) {
+	code := status.Code(err).String()
+	m.RequestsTotal.WithLabelValues(method, code).Inc()
+	m.RequestDuration.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		startTS := time.Now()
+		resp, err := handler(ctx, req)
+		m.observeRequest(info.FullMethod, time.Since(startTS), err)
+		return resp, err
+	}
+}
+
+func (m *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		startTS := time.Now()
+		err := handler(srv, ss)
+		m.observeRequest(info.FullMethod, time.Since(startTS), err)
+		return err
+	}
+}
+
+func (m *Metrics) ProcessEvent(
+	_ context.Context,
+	event any,
+) {
+	t := reflect.TypeOf(event)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	eventType := "<nil>"
+	if t != nil {
+		eventType = t.Name()
+	}
+	m.EventsTotal.WithLabelValues(eventType).Inc()
+}
+
+func (m *Metrics) ProcessDeprecatedRequest(
+	_ context.Context,
+	requestType string,
+) {
+	m.DeprecatedRequestsTotal.WithLabelValues(requestType).Inc()
+}
+
+func (m *Metrics) ProcessConnectionStateChange(
+	_ context.Context,
+	isConnected bool,
+) {
+	if !isConnected {
+		m.OBSConnected.Set(0)
+		return
+	}
+
+	m.OBSConnected.Set(1)
+	m.OBSConnectsTotal.Inc()
+	if m.hasBeenConnected.Swap(true) {
+		m.OBSReconnectsTotal.Inc()
+	}
+}
+
+// ServeOBSScraping periodically requests statistics from OBS
+// and updates the OBS-side gauges, until the context is cancelled.
+func (m *Metrics) ServeOBSScraping(
+	ctx context.Context,
+	client obs_grpc.OBSClient,
+	interval time.Duration,
+) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		m.ScrapeOBS(ctx, client)
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (m *Metrics) ScrapeOBS(
+	ctx context.Context,
+	client obs_grpc.OBSClient,
+) {
+	stats, err := client.GetStats(ctx, &obs_grpc.GetStatsRequest{})
+	if err != nil {
+		logger.Debugf(ctx, "unable to get the stats from OBS: %v", err)
+	} else {
+		m.OBSActiveFPS.Set(float64(stats.ActiveFps))
+		m.OBSCPUUsage.Set(float64(stats.CpuUsage))
+		m.OBSMemoryUsage.Set(float64(stats.MemoryUsage))
+		m.OBSRenderSkippedFrames.Set(float64(stats.RenderSkippedFrames))
+		m.OBSRenderTotalFrames.Set(float64(stats.RenderTotalFrames))
+		m.OBSOutputSkippedFrames.Set(float64(stats.OutputSkippedFrames))
+		m.OBSOutputTotalFrames.Set(float64(stats.OutputTotalFrames))
+		m.OBSAverageFrameRenderTime.Set(float64(stats.AverageFrameRenderTime))
+	}
+
+	streamStatus, err := client.GetStreamStatus(ctx, &obs_grpc.GetStreamStatusRequest{})
+	if err != nil {
+		logger.Debugf(ctx, "unable to get the stream status from OBS: %v", err)
+	} else {
+		m.OutputActive.WithLabelValues("stream").Set(boolToFloat64(streamStatus.OutputActive))
+		m.OutputReconnecting.WithLabelValues("stream").Set(boolToFloat64(streamStatus.OutputReconnecting))
+		m.OutputCongestion.WithLabelValues("stream").Set(float64(streamStatus.OutputCongestion))
+		m.OutputSkippedFrames.WithLabelValues("stream").Set(float64(streamStatus.OutputSkippedFrames))
+		m.OutputTotalFrames.WithLabelValues("stream").Set(float64(streamStatus.OutputTotalFrames))
+		m.observeOutputBytes("stream", streamStatus.OutputBytes)
+	}
+
+	recordStatus, err := client.GetRecordStatus(ctx, &obs_grpc.GetRecordStatusRequest{})
+	if err != nil {
+		logger.Debugf(ctx, "unable to get the record status from OBS: %v", err)
+	} else {
+		m.OutputActive.WithLabelValues("record").Set(boolToFloat64(recordStatus.OutputActive))
+		m.OutputPaused.WithLabelValues("record").Set(boolToFloat64(recordStatus.OutputPaused))
+		m.observeOutputBytes("record", recordStatus.OutputBytes)
+	}
+}
+
+func (m *Metrics) observeOutputBytes(
+	output string,
+	outputBytes int64,
+) {
+	m.prevOutputBytesLocker.Lock()
+	defer m.prevOutputBytesLocker.Unlock()
+	now := time.Now()
+	m.OutputBytes.WithLabelValues(output).Set(float64(outputBytes))
+	prev, ok := m.prevOutputBytes[output]
+	m.prevOutputBytes[output] = outputBytesSample{Bytes: outputBytes, TS: now}
+	if !ok || outputBytes < prev.Bytes {
+		// either the first sample, or the output was restarted
+		m.OutputBitrate.WithLabelValues(output).Set(0)
+		return
+	}
+
+	duration := now.Sub(prev.TS)
+	if duration <= 0 {
+		return
+	}
+	m.OutputBitrate.WithLabelValues(output).Set(float64(outputBytes-prev.Bytes) * 8 / duration.Seconds())
+}
+
+func boolToFloat64(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,160 @@
+package obsmetrics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeOBSClient struct {
+	obs_grpc.OBSClient
+
+	locker      sync.Mutex
+	OutputBytes int64
+}
+
+func (c *fakeOBSClient) GetStats(ctx context.Context, req *obs_grpc.GetStatsRequest, opts ...grpc.CallOption) (*obs_grpc.GetStatsResponse, error) {
+	return &obs_grpc.GetStatsResponse{ActiveFps: 60, CpuUsage: 12}, nil
+}
+
+func (c *fakeOBSClient) GetStreamStatus(ctx context.Context, req *obs_grpc.GetStreamStatusRequest, opts ...grpc.CallOption) (*obs_grpc.GetStreamStatusResponse, error) {
+	c.locker.Lock()
+	defer c.locker.Unlock()
+	return &obs_grpc.GetStreamStatusResponse{OutputActive: true, OutputBytes: c.OutputBytes}, nil
+}
+
+func (c *fakeOBSClient) GetRecordStatus(ctx context.Context, req *obs_grpc.GetRecordStatusRequest, opts ...grpc.CallOption) (*obs_grpc.GetRecordStatusResponse, error) {
+	return nil, status.Error(codes.Unavailable, "no OBS in tests")
+}
+
+func (c *fakeOBSClient) setOutputBytes(outputBytes int64) {
+	c.locker.Lock()
+	defer c.locker.Unlock()
+	c.OutputBytes = outputBytes
+}
+
+// shiftOutputBytesSample makes the previous sample of the output look
+// taken the given duration ago.
+func (m *Metrics) shiftOutputBytesSample(output string, d time.Duration) {
+	m.prevOutputBytesLocker.Lock()
+	defer m.prevOutputBytesLocker.Unlock()
+	sample := m.prevOutputBytes[output]
+	sample.TS = sample.TS.Add(-d)
+	m.prevOutputBytes[output] = sample
+}
+
+func TestScrapeOBS(t *testing.T) {
+	ctx := context.Background()
+	reg := prometheus.NewRegistry()
+	m := New(reg)
+	client := &fakeOBSClient{OutputBytes: 1000}
+
+	m.ScrapeOBS(ctx, client)
+	require.Equal(t, 60.0, testutil.ToFloat64(m.OBSActiveFPS))
+	require.Equal(t, 12.0, testutil.ToFloat64(m.OBSCPUUsage))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.OutputActive.WithLabelValues("stream")))
+	// the first sample has no bitrate
+	require.Equal(t, 0.0, testutil.ToFloat64(m.OutputBitrate.WithLabelValues("stream")))
+	// the failed request does not produce the metrics of the output
+	require.Equal(t, 0, testutil.CollectAndCount(m.OutputPaused))
+
+	m.shiftOutputBytesSample("stream", 2*time.Second)
+	client.setOutputBytes(1000 + 250_000)
+	m.ScrapeOBS(ctx, client)
+	require.InDelta(t, 1_000_000, testutil.ToFloat64(m.OutputBitrate.WithLabelValues("stream")), 1000)
+	require.Equal(t, 251000.0, testutil.ToFloat64(m.OutputBytes.WithLabelValues("stream")))
+
+	// the output was restarted
+	m.shiftOutputBytesSample("stream", 2*time.Second)
+	client.setOutputBytes(100)
+	m.ScrapeOBS(ctx, client)
+	require.Equal(t, 0.0, testutil.ToFloat64(m.OutputBitrate.WithLabelValues("stream")))
+
+	err := testutil.GatherAndCompare(reg, strings.NewReader(`
+# HELP obs_grpc_proxy_obs_output_bytes Amount of bytes sent by the output.
+# TYPE obs_grpc_proxy_obs_output_bytes gauge
+obs_grpc_proxy_obs_output_bytes{output="stream"} 100
+`), "obs_grpc_proxy_obs_output_bytes")
+	require.NoError(t, err)
+}
+
+func TestScrapeOBSConcurrently(t *testing.T) {
+	ctx := context.Background()
+	m := New(nil)
+	client := &fakeOBSClient{}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				m.ScrapeOBS(ctx, client)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestConnectionStateChange(t *testing.T) {
+	ctx := context.Background()
+	m := New(prometheus.NewRegistry())
+
+	m.ProcessConnectionStateChange(ctx, true)
+	require.Equal(t, 1.0, testutil.ToFloat64(m.OBSConnected))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.OBSConnectsTotal))
+	require.Equal(t, 0.0, testutil.ToFloat64(m.OBSReconnectsTotal))
+
+	for i := 0; i < 2; i++ {
+		m.ProcessConnectionStateChange(ctx, false)
+		require.Equal(t, 0.0, testutil.ToFloat64(m.OBSConnected))
+		m.ProcessConnectionStateChange(ctx, true)
+	}
+	require.Equal(t, 1.0, testutil.ToFloat64(m.OBSConnected))
+	require.Equal(t, 3.0, testutil.ToFloat64(m.OBSConnectsTotal))
+	require.Equal(t, 2.0, testutil.ToFloat64(m.OBSReconnectsTotal))
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+}
+
+func TestInterceptors(t *testing.T) {
+	ctx := context.Background()
+	m := New(prometheus.NewRegistry())
+
+	unary := m.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/obs_grpc.OBS/GetVersion"}
+	resp, err := unary(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+	_, err = unary(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.NotFound, "no such scene")
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	stream := m.StreamServerInterceptor()
+	streamInfo := &grpc.StreamServerInfo{FullMethod: "/obs_grpc.OBS/SubscribeToEvents"}
+	err = stream(nil, fakeServerStream{}, streamInfo, func(srv any, stream grpc.ServerStream) error {
+		return fmt.Errorf("the stream is broken")
+	})
+	require.Error(t, err)
+
+	require.Equal(t, 1.0, testutil.ToFloat64(m.RequestsTotal.WithLabelValues("/obs_grpc.OBS/GetVersion", "OK")))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.RequestsTotal.WithLabelValues("/obs_grpc.OBS/GetVersion", "NotFound")))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.RequestsTotal.WithLabelValues("/obs_grpc.OBS/SubscribeToEvents", "Unknown")))
+	require.Equal(t, 2, testutil.CollectAndCount(m.RequestDuration))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsdoc"
@@ -50,7 +51,12 @@ func Generate(
 		}
 	}
 
-	err := generateRequests(ctx, w, p.Requests, existingObjectTypes)
+	err := generateEventEnvelope(ctx, w, p.Events)
+	if err != nil {
+		return fmt.Errorf("unable to generate the event envelope: %w", err)
+	}
+
+	err = generateRequests(ctx, w, p.Requests, existingObjectTypes)
 	if err != nil {
 		return fmt.Errorf("unable to generate requests: %w", err)
 	}
@@ -68,7 +74,7 @@ func generateEnum(
 		if v, ok := value.EnumValue.(int64); !(ok && v == 0) {
 			continue
 		}
-		fmt.Fprintf(w, "\t%s = %v;\n", value.EnumIdentifier, value.EnumValue)
+		fmt.Fprintf(w, "\t%s = %v%s;\n", value.EnumIdentifier, value.EnumValue, deprecatedFieldOption(value.Deprecated))
 		break
 	}
 	for _, value := range enum.EnumIdentifiers {
@@ -76,10 +82,10 @@ func generateEnum(
 			continue
 		}
 		if value.EnumIdentifier == "None" {
-			fmt.Fprintf(w, "\t_%s = %v;\n", value.EnumIdentifier, value.EnumValue)
+			fmt.Fprintf(w, "\t_%s = %v%s;\n", value.EnumIdentifier, value.EnumValue, deprecatedFieldOption(value.Deprecated))
 			continue
 		}
-		fmt.Fprintf(w, "\t%s = %v;\n", value.EnumIdentifier, value.EnumValue)
+		fmt.Fprintf(w, "\t%s = %v%s;\n", value.EnumIdentifier, value.EnumValue, deprecatedFieldOption(value.Deprecated))
 	}
 	fmt.Fprintf(w, "}\n")
 	return nil
@@ -92,6 +98,7 @@ func generateEvent(
 	existingObjectTypes map[string]struct{},
 ) error {
 	fmt.Fprintf(w, "message Event%s {\n", event.EventType)
+	writeDeprecatedOption(w, event.Deprecated)
 	for idx, field := range event.DataFields {
 		typeName := TypeNameObs2Protobuf(field.ValueType, field.ValueName, existingObjectTypes)
 		fmt.Fprintf(w, "\t%s %v = %d;\n", typeName, FieldNameObs2Protobuf(field.ValueName), idx+1)
@@ -100,6 +107,32 @@ func generateEvent(
 	return nil
 }
 
+// eventEnvelopeFirstUnionFieldNumber is the field number of the first event
+// in the oneof of message EventEnvelope; the numbers below are reserved for the
+// metadata fields.
+const eventEnvelopeFirstUnionFieldNumber = 16
+
+func generateEventEnvelope(
+	_ context.Context,
+	w io.Writer,
+	events []obsdoc.Event,
+) error {
+	fmt.Fprintf(w, "message EventEnvelope {\n")
+	fmt.Fprintf(w, "\tuint64 seqNo = 1;\n")
+	fmt.Fprintf(w, "\tint64 timestampUnixNano = 2;\n")
+	fmt.Fprintf(w, "\tstring eventType = 3;\n")
+	fmt.Fprintf(w, "\tstring eventCategory = 4;\n")
+	fmt.Fprintf(w, "\tEventSubscription eventSubscription = 5;\n")
+	fmt.Fprintf(w, "\tEventsGap gap = 6;\n")
+	fmt.Fprintf(w, "\toneof Union {\n")
+	for idx, event := range events {
+		fmt.Fprintf(w, "\t\tEvent%s %s = %d%s;\n", event.EventType, untitle(event.EventType), eventEnvelopeFirstUnionFieldNumber+idx, deprecatedFieldOption(event.Deprecated))
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "}\n")
+	return nil
+}
+
 func title(s string) string {
 	if len(s) == 0 {
 		return ""
@@ -108,12 +141,28 @@ func title(s string) string {
 	return strings.ToUpper(s[:1]) + s[1:]
 }
 
+func untitle(s string) string {
+	if len(s) == 0 {
+		return ""
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
 var regexpArrayTypeParser = regexp.MustCompile(`Array\<([^>]+)\>`)
 
+// IsFloatNumber checks if the number field is fractional in obs-websocket
+// (the other numbers are integers, even if goobs keeps them as float64).
 func IsFloatNumber(fieldName string) bool {
 	switch {
 	case strings.HasSuffix(fieldName, "Balance"):
 		return true
+	case strings.HasSuffix(fieldName, "VolumeMul"):
+		return true
+	case strings.HasSuffix(fieldName, "VolumeDb"):
+		return true
+	case strings.EqualFold(fieldName, "transitionCursor"):
+		return true
 	}
 	return false
 }
@@ -172,16 +221,38 @@ func generateRequests(
 ) error {
 	fmt.Fprintf(w, "service OBS {\n")
 	for _, request := range requests {
+		if request.Deprecated {
+			fmt.Fprintf(w, "\trpc %s(%sRequest) returns (%sResponse) {\n\t\toption deprecated = true;\n\t}\n", request.RequestType, request.RequestType, request.RequestType)
+			continue
+		}
 		fmt.Fprintf(w, "\trpc %s(%sRequest) returns (%sResponse) {}\n", request.RequestType, request.RequestType, request.RequestType)
 	}
+	fmt.Fprintf(w, "\trpc SubscribeToEvents(SubscribeToEventsRequest) returns (stream EventEnvelope) {}\n")
+	fmt.Fprintf(w, "\trpc ExecuteRequestSequence(ExecuteRequestSequenceRequest) returns (ExecuteRequestSequenceResponse) {}\n")
+	fmt.Fprintf(w, "\trpc GetProxyCapabilities(GetProxyCapabilitiesRequest) returns (GetProxyCapabilitiesResponse) {}\n")
 	fmt.Fprintf(w, "}\n")
 	for _, request := range requests {
 		fmt.Fprintf(w, "message %sRequest {\n", request.RequestType)
+		writeDeprecatedOption(w, request.Deprecated)
+		oneofs := RequestOneofs(request.RequestFields)
 		for idx, field := range request.RequestFields {
-			fmt.Fprintf(w, "\t%s %v = %d;\n", fieldTypeObs2Protobuf(field, existingObjectTypes), FieldNameObs2Protobuf(field.ValueName), idx+1)
+			if oneof, ok := oneofs[field.ValueName]; ok {
+				if field.ValueName == oneof.NameField {
+					if err := generateOneof(w, oneof, request.RequestFields[idx:idx+2], idx+1, existingObjectTypes); err != nil {
+						return fmt.Errorf("unable to generate oneof '%s' of request %s: %w", oneof.Name, request.RequestType, err)
+					}
+				}
+				continue
+			}
+			options, err := requestFieldOptions(field)
+			if err != nil {
+				return fmt.Errorf("unable to parse the restrictions of field '%s' of request %s: %w", field.ValueName, request.RequestType, err)
+			}
+			fmt.Fprintf(w, "\t%s %v = %d%s;\n", fieldTypeObs2Protobuf(field, existingObjectTypes), FieldNameObs2Protobuf(field.ValueName), idx+1, options)
 		}
 		fmt.Fprintf(w, "}\n")
 		fmt.Fprintf(w, "message %sResponse {\n", request.RequestType)
+		writeDeprecatedOption(w, request.Deprecated)
 		for idx, field := range request.ResponseFields {
 			typeName := TypeNameObs2Protobuf(field.ValueType, field.ValueName, existingObjectTypes)
 			fmt.Fprintf(w, "\t%s %v = %d;\n", typeName, FieldNameObs2Protobuf(field.ValueName), idx+1)
@@ -192,6 +263,108 @@ func generateRequests(
 	return nil
 }
 
+// writeDeprecatedOption marks the enclosing message as deprecated
+// (if it is).
+func writeDeprecatedOption(w io.Writer, deprecated bool) {
+	if deprecated {
+		fmt.Fprintf(w, "\toption deprecated = true;\n")
+	}
+}
+
+// deprecatedFieldOption returns the option marking a field or an enum value
+// as deprecated (if it is).
+func deprecatedFieldOption(deprecated bool) string {
+	if deprecated {
+		return " [deprecated = true]"
+	}
+	return ""
+}
+
+// generateOneof writes the oneof of a name-or-UUID pair of fields, keeping
+// their field numbers (so that the wire format does not change) and
+// options.
+func generateOneof(
+	w io.Writer,
+	oneof Oneof,
+	fields []obsdoc.Field,
+	firstFieldNumber int,
+	existingObjectTypes map[string]struct{},
+) error {
+	fmt.Fprintf(w, "\toneof %s {\n", oneof.Name)
+	if oneof.Required {
+		fmt.Fprintf(w, "\t\toption (oneofRestrictions) = {required: true};\n")
+	}
+	for idx, field := range fields {
+		options, err := requestFieldOptions(field)
+		if err != nil {
+			return fmt.Errorf("unable to parse the restrictions of field '%s': %w", field.ValueName, err)
+		}
+		typeName := TypeNameObs2Protobuf(field.ValueType, field.ValueName, existingObjectTypes)
+		fmt.Fprintf(w, "\t\t%s %v = %d%s;\n", typeName, FieldNameObs2Protobuf(field.ValueName), firstFieldNumber+idx, options)
+	}
+	fmt.Fprintf(w, "\t}\n")
+	return nil
+}
+
+// requestFieldOptions returns the options of a request field: "deprecated"
+// and "restrictions" (see message FieldRestrictions in objects.proto), if
+// it has any.
+func requestFieldOptions(field obsdoc.Field) (string, error) {
+	var options []string
+	if field.Deprecated {
+		options = append(options, "deprecated = true")
+	}
+	restrictions, err := fieldRestrictionsOption(field)
+	if err != nil {
+		return "", err
+	}
+	if restrictions != "" {
+		options = append(options, restrictions)
+	}
+	if len(options) == 0 {
+		return "", nil
+	}
+	return " [" + strings.Join(options, ", ") + "]", nil
+}
+
+// fieldRestrictionsOption returns option "restrictions" of a request field,
+// if it has any.
+func fieldRestrictionsOption(field obsdoc.Field) (string, error) {
+	restrictions, err := obsdoc.ParseValueRestrictions(field.ValueRestrictions)
+	if err != nil {
+		return "", err
+	}
+	var values []string
+	for _, bound := range []struct {
+		name  string
+		value *float64
+	}{
+		{"min", restrictions.Min},
+		{"max", restrictions.Max},
+		{"exclusiveMin", restrictions.ExclusiveMin},
+		{"exclusiveMax", restrictions.ExclusiveMax},
+	} {
+		if bound.value != nil {
+			values = append(values, bound.name+": "+strconv.FormatFloat(*bound.value, 'g', -1, 64))
+		}
+	}
+	if IsRequiredObject(field) {
+		values = append(values, "required: true")
+	}
+	if len(values) == 0 {
+		return "", nil
+	}
+	return "(restrictions) = {" + strings.Join(values, ", ") + "}", nil
+}
+
+// IsRequiredObject checks if the field is a required object. Only the
+// presence of the objects could be checked: a string has no presence in
+// proto3, and obs-websocket accepts the empty strings (like an empty
+// caption of SendStreamCaption).
+func IsRequiredObject(field obsdoc.Field) bool {
+	return !field.ValueOptional && field.ValueType == "Object"
+}
+
 func FieldNameObs2Protobuf(fieldName string) string {
 	fieldName = strings.ReplaceAll(fieldName, ".", "_")
 	fieldName = strings.ReplaceAll(fieldName, "Uuid", "UUID")
@@ -0,0 +1,42 @@
+package obsprotobufgen
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsdoc"
+)
+
+func TestGenerateRequestsOneofOptions(t *testing.T) {
+	requests := []obsdoc.Request{{
+		RequestType: "SetSceneItemThing",
+		RequestFields: []obsdoc.Field{
+			{ValueName: "sceneName", ValueType: "String", ValueOptional: true, Deprecated: true},
+			{ValueName: "sceneUuid", ValueType: "String", ValueOptional: true, ValueRestrictions: ">= 1"},
+			{ValueName: "sceneItemId", ValueType: "Number", ValueRestrictions: ">= 0", Deprecated: true},
+		},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, generateRequests(context.Background(), &buf, requests, map[string]struct{}{}))
+	out := buf.String()
+	require.Contains(t, out, "\toneof scene {\n\t\toption (oneofRestrictions) = {required: true};\n")
+	require.Contains(t, out, "\t\tstring sceneName = 1 [deprecated = true];\n")
+	require.Contains(t, out, "\t\tstring sceneUUID = 2 [(restrictions) = {min: 1}];\n")
+	require.Contains(t, out, "\tint64 sceneItemID = 3 [deprecated = true, (restrictions) = {min: 0}];\n")
+}
+
+func TestGenerateRequestsOneofInvalidRestrictions(t *testing.T) {
+	requests := []obsdoc.Request{{
+		RequestType: "SetSceneItemThing",
+		RequestFields: []obsdoc.Field{
+			{ValueName: "sceneName", ValueType: "String", ValueOptional: true},
+			{ValueName: "sceneUuid", ValueType: "String", ValueOptional: true, ValueRestrictions: "~ 1"},
+		},
+	}}
+
+	var buf bytes.Buffer
+	require.Error(t, generateRequests(context.Background(), &buf, requests, map[string]struct{}{}))
+}
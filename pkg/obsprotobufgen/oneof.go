@@ -0,0 +1,47 @@
+package obsprotobufgen
+
+import (
+	"strings"
+
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsdoc"
+)
+
+// Oneof is a pair of adjacent request fields referring to the same object
+// either by name or by UUID (like "sceneName" and "sceneUuid"), which is
+// generated as a oneof.
+type Oneof struct {
+	Name      string
+	NameField string
+	UUIDField string
+
+	// Required is set if one of the fields must be set; it is not if
+	// obs-websocket describes the behavior when both are omitted (like
+	// for "destinationSceneName").
+	Required bool
+}
+
+// RequestOneofs returns the name-or-UUID pairs of the request fields,
+// indexed by both field names.
+func RequestOneofs(fields []obsdoc.Field) map[string]Oneof {
+	result := map[string]Oneof{}
+	for idx := 0; idx+1 < len(fields); idx++ {
+		nameField, uuidField := fields[idx], fields[idx+1]
+		name, ok := strings.CutSuffix(nameField.ValueName, "Name")
+		if !ok || name == "" || uuidField.ValueName != name+"Uuid" {
+			continue
+		}
+		if !nameField.ValueOptional || !uuidField.ValueOptional || nameField.ValueType != "String" || uuidField.ValueType != "String" {
+			continue
+		}
+		behavior, _ := nameField.ValueOptionalBehavior.(string)
+		oneof := Oneof{
+			Name:      name,
+			NameField: nameField.ValueName,
+			UUIDField: uuidField.ValueName,
+			Required:  behavior == "" || behavior == "Unknown",
+		}
+		result[oneof.NameField] = oneof
+		result[oneof.UUIDField] = oneof
+	}
+	return result
+}
@@ -0,0 +1,140 @@
+// Package obsprotojson serializes the messages of the OBS service to JSON
+// the same way as protojson does, except that the 'bytes' fields (which are
+// used for OBS strings) are represented as text instead of base64.
+package obsprotojson
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+var (
+	textualFiles     *protoregistry.Files
+	textualFilesErr  error
+	textualFilesOnce sync.Once
+)
+
+// TextualDescriptor returns a copy of the message descriptor, where all
+// the 'bytes' fields (including the nested ones) are replaced with
+// 'string' fields. Both types have the same wire format, so a message could
+// be converted by serializing it and parsing it back.
+func TextualDescriptor(
+	desc protoreflect.MessageDescriptor,
+) (protoreflect.MessageDescriptor, error) {
+	textualFilesOnce.Do(func() {
+		textualFiles, textualFilesErr = newTextualFiles(
+			obs_grpc.File_objects_proto,
+			obs_grpc.File_obs_proto,
+		)
+	})
+	if textualFilesErr != nil {
+		return nil, textualFilesErr
+	}
+	d, err := textualFiles.FindDescriptorByName(desc.FullName())
+	if err != nil {
+		return nil, fmt.Errorf("unable to find '%s': %w", desc.FullName(), err)
+	}
+	result, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not a message", desc.FullName())
+	}
+	return result, nil
+}
+
+func newTextualFiles(files ...protoreflect.FileDescriptor) (*protoregistry.Files, error) {
+	set := &descriptorpb.FileDescriptorSet{}
+
+	// the dependencies (like google/protobuf/descriptor.proto) are kept as is
+	seen := map[string]struct{}{}
+	for _, file := range files {
+		seen[file.Path()] = struct{}{}
+	}
+	for _, file := range files {
+		imports := file.Imports()
+		for idx := 0; idx < imports.Len(); idx++ {
+			dep := imports.Get(idx).FileDescriptor
+			if _, ok := seen[dep.Path()]; ok {
+				continue
+			}
+			seen[dep.Path()] = struct{}{}
+			set.File = append(set.File, protodesc.ToFileDescriptorProto(dep))
+		}
+	}
+
+	for _, file := range files {
+		fileProto := protodesc.ToFileDescriptorProto(file)
+		for _, msg := range fileProto.MessageType {
+			replaceBytesWithString(msg)
+		}
+		set.File = append(set.File, fileProto)
+	}
+	result, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build the descriptors: %w", err)
+	}
+	return result, nil
+}
+
+func replaceBytesWithString(msg *descriptorpb.DescriptorProto) {
+	for _, field := range msg.Field {
+		if field.GetType() == descriptorpb.FieldDescriptorProto_TYPE_BYTES {
+			field.Type = descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()
+		}
+	}
+	for _, nested := range msg.NestedType {
+		replaceBytesWithString(nested)
+	}
+}
+
+// Textual converts the message to its textual representation (see
+// TextualDescriptor). If it is not possible (for example, a 'bytes' field
+// is not a valid UTF-8 text), then the message is returned as is.
+func Textual(msg proto.Message) proto.Message {
+	desc, err := TextualDescriptor(msg.ProtoReflect().Descriptor())
+	if err != nil {
+		return msg
+	}
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return msg
+	}
+	result := dynamicpb.NewMessage(desc)
+	if err := proto.Unmarshal(b, result); err != nil {
+		return msg
+	}
+	return result
+}
+
+// Marshal serializes the message to JSON.
+func Marshal(opts protojson.MarshalOptions, msg proto.Message) ([]byte, error) {
+	return opts.Marshal(Textual(msg))
+}
+
+// Unmarshal parses the JSON into the message.
+func Unmarshal(opts protojson.UnmarshalOptions, data []byte, msg proto.Message) error {
+	desc, err := TextualDescriptor(msg.ProtoReflect().Descriptor())
+	if err != nil {
+		return err
+	}
+	textual := dynamicpb.NewMessage(desc)
+	if err := opts.Unmarshal(data, textual); err != nil {
+		return err
+	}
+	b, err := proto.Marshal(textual)
+	if err != nil {
+		return fmt.Errorf("unable to serialize the intermediate representation: %w", err)
+	}
+	if err := proto.Unmarshal(b, msg); err != nil {
+		return fmt.Errorf("unable to parse the intermediate representation: %w", err)
+	}
+	return nil
+}
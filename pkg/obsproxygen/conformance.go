@@ -0,0 +1,417 @@
+package obsproxygen
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsdoc"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsprotobufgen"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const obsGRPCPkg = "github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+
+// knownIssues are the requests the generated conformance tests are known to
+// fail for: the tests are expected to fail with the reason, and start
+// failing when the issue is fixed (then remove the entry).
+var knownIssues = map[string]string{
+	"GetInputAudioTracks":                 "InputAudioTracks is converted as an object with field 'fields', so the tracks are lost",
+	"SetInputAudioTracks":                 "InputAudioTracks is converted as an object with field 'fields', so the tracks are lost",
+	"GetInputPropertiesListPropertyItems": "PropertyItem.ItemValue cannot be converted from a JSON value",
+	"GetOutputList":                       "Output.OutputFlags is repeated in protobuf, but an object in obs-websocket",
+	"GetSourceFilterList":                 "Filter.FilterSettings is lost: it is converted as an object with field 'fields'",
+	"GetStreamServiceSettings":            "StreamServiceSettings.UseAuth is lost: goobs names it 'use_auth'",
+	"SetStreamServiceSettings":            "StreamServiceSettings.UseAuth is lost: goobs names it 'use_auth'",
+	"SetPersistentData":                   "slotValue is sent as the protobuf structure of Any instead of the value",
+	"TriggerHotkeyByKeySequence":          "keyModifiers.* are not forwarded (and goobs has wrong JSON names of KeyModifiers)",
+}
+
+// GenerateConformanceTests generates the conformance test cases of package
+// obsgrpcproxy: for each request a protobuf request with all the fields
+// populated, the obs-websocket request data it is expected to be sent as,
+// the response data of a scripted OBS and the protobuf response it is
+// expected to be converted to.
+//
+// The protobuf messages are taken from package obs_grpc, so it should be
+// generated first. The values of the objects (like Scene or SceneItem) are
+// derived from their protobuf messages, since protocol.json does not
+// describe them. The numbers are fractional only in the fields which are
+// fractional in obs-websocket (double in protobuf).
+func GenerateConformanceTests(
+	ctx context.Context,
+	w io.Writer,
+	p *obsdoc.Protocol,
+) error {
+	if p == nil {
+		return nil
+	}
+
+	code := jen.NewFile("obsgrpcproxy_test")
+	code.ImportName(obsGRPCPkg, "obs_grpc")
+	code.HeaderComment("This file was automatically generated by github.com/xaionaro-go/obs-grpc-proxy/scripts/generate")
+
+	var tests []jen.Code
+	for idx, request := range p.Requests {
+		test, err := generateConformanceTest(request)
+		if err != nil {
+			return fmt.Errorf("unable to generate the conformance test for request #%d:%s: %w", idx, request.RequestType, err)
+		}
+		tests = append(tests, test)
+	}
+	code.Var().Id("conformanceTests").Op("=").Index().Id("conformanceTest").ValuesFunc(func(g *jen.Group) {
+		for _, test := range tests {
+			g.Line().Add(test)
+		}
+		g.Line()
+	})
+
+	err := code.Render(w)
+	if err != nil {
+		return fmt.Errorf("unable to render the code: %w", err)
+	}
+
+	return nil
+}
+
+func generateConformanceTest(request obsdoc.Request) (jen.Code, error) {
+	s := &sampler{}
+
+	reqProto, reqOBS, err := s.sampleFields(request.RequestType+"Request", request.RequestFields)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sample the request: %w", err)
+	}
+	respProto, respOBS, err := s.sampleFields(request.RequestType+"Response", request.ResponseFields)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sample the response: %w", err)
+	}
+
+	fields := jen.Dict{
+		jen.Id("RequestType"):  jen.Lit(request.RequestType),
+		jen.Id("Request"):      reqProto,
+		jen.Id("RequestData"):  reqOBS,
+		jen.Id("ResponseData"): respOBS,
+		jen.Id("Response"):     respProto,
+	}
+	if reason := knownIssues[request.RequestType]; reason != "" {
+		fields[jen.Id("KnownIssue")] = jen.Lit(reason)
+	}
+	return jen.Values(fields), nil
+}
+
+// sampler produces the representative values of the fields.
+type sampler struct {
+	// lastNumber makes the numbers distinct, so that mixed up fields
+	// are noticed.
+	lastNumber int
+}
+
+// sample is a value in both representations.
+type sample struct {
+	Proto jen.Code
+	OBS   any // jen.Code or obsObject
+
+	// ProtoElem is the protobuf message as an element of a slice (with
+	// the type elided), if the value is a message.
+	ProtoElem jen.Code
+}
+
+// obsObject is an object of obs-websocket, the nested objects come from
+// the dotted field names (like "keyModifiers.shift").
+type obsObject map[string]any
+
+func (obj obsObject) set(path []string, value jen.Code) {
+	if len(path) == 1 {
+		obj[path[0]] = value
+		return
+	}
+	child, ok := obj[path[0]].(obsObject)
+	if !ok {
+		child = obsObject{}
+		obj[path[0]] = child
+	}
+	child.set(path[1:], value)
+}
+
+func (obj obsObject) code() jen.Code {
+	items := jen.Dict{}
+	for k, v := range obj {
+		if child, ok := v.(obsObject); ok {
+			v = child.code()
+		}
+		items[jen.Lit(k)] = v.(jen.Code)
+	}
+	return jen.Map(jen.String()).Any().Values(items)
+}
+
+// sampleFields samples a request or a response message. It returns
+// the protobuf message and the obs-websocket data.
+func (s *sampler) sampleFields(
+	msgName string,
+	fields []obsdoc.Field,
+) (jen.Code, jen.Code, error) {
+	md := obs_grpc.File_obs_proto.Messages().ByName(protoreflect.Name(msgName))
+	if md == nil {
+		return nil, nil, fmt.Errorf("message %s is not found, the protobuf code is probably outdated", msgName)
+	}
+
+	// an object with the sub-fields described (like "keyModifiers" with
+	// "keyModifiers.shift") is sent as the sub-fields
+	parents := map[string]struct{}{}
+	for _, field := range fields {
+		if idx := strings.LastIndex(field.ValueName, "."); idx >= 0 {
+			parents[field.ValueName[:idx]] = struct{}{}
+		}
+	}
+
+	protoFields := jen.Dict{}
+	obsData := obsObject{}
+	sampledOneofs := map[protoreflect.Name]struct{}{}
+	for _, field := range fields {
+		if _, ok := parents[field.ValueName]; ok {
+			continue
+		}
+		fd := md.Fields().ByName(protoreflect.Name(obsprotobufgen.FieldNameObs2Protobuf(field.ValueName)))
+		if fd == nil {
+			return nil, nil, fmt.Errorf("field %s is not found in message %s", field.ValueName, msgName)
+		}
+		if od := fd.ContainingOneof(); od != nil && !od.IsSynthetic() {
+			// only the first member of a oneof could be set
+			if _, ok := sampledOneofs[od.Name()]; ok {
+				continue
+			}
+			sampledOneofs[od.Name()] = struct{}{}
+			value, err := s.sampleField(fd, field.ValueName, field.ValueRestrictions)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to sample field %s: %w", field.ValueName, err)
+			}
+			goName := title(string(fd.Name()))
+			protoFields[jen.Id(title(string(od.Name())))] = jen.Op("&").Qual(obsGRPCPkg, msgName+"_"+goName).Values(jen.Dict{
+				jen.Id(goName): value.Proto,
+			})
+			obsData.set(strings.Split(field.ValueName, "."), obsCode(value.OBS))
+			continue
+		}
+		value, err := s.sampleField(fd, field.ValueName, field.ValueRestrictions)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to sample field %s: %w", field.ValueName, err)
+		}
+		goName, err := goFieldName(md, fd)
+		if err != nil {
+			return nil, nil, err
+		}
+		protoFields[jen.Id(goName)] = value.Proto
+		obsData.set(strings.Split(field.ValueName, "."), obsCode(value.OBS))
+	}
+	return jen.Op("&").Qual(obsGRPCPkg, msgName).Values(protoFields), obsData.code(), nil
+}
+
+func obsCode(v any) jen.Code {
+	if obj, ok := v.(obsObject); ok {
+		return obj.code()
+	}
+	return v.(jen.Code)
+}
+
+// goFieldName returns the name of the field in the generated Go structure.
+func goFieldName(md protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor) (string, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+	if err != nil {
+		return "", fmt.Errorf("unable to find the Go type of message %s: %w", md.FullName(), err)
+	}
+	t := reflect.TypeOf(mt.Zero().Interface()).Elem()
+	for idx := 0; idx < t.NumField(); idx++ {
+		for _, part := range strings.Split(t.Field(idx).Tag.Get("protobuf"), ",") {
+			if part == "name="+string(fd.Name()) {
+				return t.Field(idx).Name, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("field %s is not found in the Go type of message %s", fd.Name(), md.FullName())
+}
+
+func (s *sampler) sampleField(
+	fd protoreflect.FieldDescriptor,
+	obsName string,
+	restrictions any,
+) (sample, error) {
+	if fd.IsList() {
+		item, err := s.sampleValue(fd, obsName, restrictions)
+		if err != nil {
+			return sample{}, err
+		}
+		if item.ProtoElem != nil {
+			item.Proto = item.ProtoElem
+		}
+		return sample{
+			Proto: jen.Index().Add(goType(fd)).Values(item.Proto),
+			OBS:   jen.Index().Any().Values(obsCode(item.OBS)),
+		}, nil
+	}
+
+	value, err := s.sampleValue(fd, obsName, restrictions)
+	if err != nil {
+		return sample{}, err
+	}
+	// the optional bytes and the members of (non-synthetic) oneofs are not pointers in Go
+	isOneofMember := fd.ContainingOneof() != nil && !fd.ContainingOneof().IsSynthetic()
+	if fd.HasPresence() && !isOneofMember && fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.BytesKind {
+		proto := value.Proto
+		if fd.Kind() == protoreflect.Int64Kind {
+			proto = jen.Int64().Call(proto)
+		}
+		value.Proto = jen.Id("ptr").Call(proto)
+	}
+	return value, nil
+}
+
+func goType(fd protoreflect.FieldDescriptor) jen.Code {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return jen.String()
+	case protoreflect.BytesKind:
+		return jen.Index().Byte()
+	case protoreflect.BoolKind:
+		return jen.Bool()
+	case protoreflect.Int64Kind:
+		return jen.Int64()
+	case protoreflect.DoubleKind:
+		return jen.Float64()
+	case protoreflect.MessageKind:
+		return jen.Op("*").Qual(obsGRPCPkg, string(fd.Message().Name()))
+	}
+	panic(fmt.Errorf("unexpected kind %s", fd.Kind()))
+}
+
+func (s *sampler) sampleValue(
+	fd protoreflect.FieldDescriptor,
+	obsName string,
+	restrictions any,
+) (sample, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return sample{Proto: jen.Lit(obsName), OBS: jen.Lit(obsName)}, nil
+	case protoreflect.BytesKind:
+		return sample{Proto: jen.Index().Byte().Call(jen.Lit(obsName)), OBS: jen.Lit(obsName)}, nil
+	case protoreflect.BoolKind:
+		return sample{Proto: jen.True(), OBS: jen.True()}, nil
+	case protoreflect.Int64Kind:
+		// the integers are sent as floating point numbers in JSON
+		v := math.Trunc(s.number(restrictions, false))
+		return sample{Proto: jen.Lit(int(v)), OBS: jen.Lit(v)}, nil
+	case protoreflect.DoubleKind:
+		// only the fields which are fractional in obs-websocket are
+		// doubles (see obsprotobufgen.IsFloatNumber)
+		v := s.number(restrictions, true)
+		return sample{Proto: jen.Lit(v), OBS: jen.Lit(v)}, nil
+	case protoreflect.MessageKind:
+		return s.sampleMessage(fd.Message())
+	}
+	return sample{}, fmt.Errorf("unexpected kind %s", fd.Kind())
+}
+
+func (s *sampler) sampleMessage(md protoreflect.MessageDescriptor) (sample, error) {
+	switch md.Name() {
+	case "Any":
+		return sample{
+			Proto: anyCode("Any_String_", "String_", jen.Index().Byte().Call(jen.Lit("value"))),
+			OBS:   jen.Lit("value"),
+		}, nil
+	case "InputAudioTracks":
+		// the tracks are numbered from 1
+		return sample{
+			Proto: jen.Op("&").Qual(obsGRPCPkg, "InputAudioTracks").Values(jen.Dict{
+				jen.Id("Fields"): jen.Map(jen.String()).Op("*").Qual(obsGRPCPkg, "Any").Values(jen.Dict{
+					jen.Lit("1"): anyCode("Any_Bool", "Bool", jen.True()),
+				}),
+			}),
+			OBS: obsObject{
+				"1": jen.True(),
+			},
+		}, nil
+	case "AbstractObject":
+		return sample{
+			Proto: jen.Op("&").Qual(obsGRPCPkg, "AbstractObject").Values(jen.Dict{
+				jen.Id("Fields"): jen.Map(jen.String()).Op("*").Qual(obsGRPCPkg, "Any").Values(jen.Dict{
+					jen.Lit("bool"):   anyCode("Any_Bool", "Bool", jen.True()),
+					jen.Lit("number"): anyCode("Any_Float", "Float", jen.Lit(0.5)),
+					jen.Lit("string"): anyCode("Any_String_", "String_", jen.Index().Byte().Call(jen.Lit("value"))),
+				}),
+			}),
+			OBS: obsObject{
+				"bool":   jen.True(),
+				"number": jen.Lit(0.5),
+				"string": jen.Lit("value"),
+			},
+		}, nil
+	}
+
+	protoFields := jen.Dict{}
+	obsData := obsObject{}
+	fields := md.Fields()
+	for idx := 0; idx < fields.Len(); idx++ {
+		fd := fields.Get(idx)
+		obsName := untitle(string(fd.Name()))
+		obsName = strings.ReplaceAll(obsName, "UUID", "Uuid")
+		if strings.HasSuffix(obsName, "ID") {
+			obsName = obsName[:len(obsName)-2] + "Id"
+		}
+		value, err := s.sampleField(fd, obsName, nil)
+		if err != nil {
+			return sample{}, fmt.Errorf("unable to sample field %s of %s: %w", fd.Name(), md.Name(), err)
+		}
+		goName, err := goFieldName(md, fd)
+		if err != nil {
+			return sample{}, err
+		}
+		protoFields[jen.Id(goName)] = value.Proto
+		obsData[obsName] = obsCode(value.OBS)
+	}
+	return sample{
+		Proto:     jen.Op("&").Qual(obsGRPCPkg, string(md.Name())).Values(protoFields),
+		OBS:       obsData,
+		ProtoElem: jen.Values(protoFields),
+	}, nil
+}
+
+func anyCode(unionType, unionField string, value jen.Code) jen.Code {
+	return jen.Op("&").Qual(obsGRPCPkg, "Any").Values(jen.Dict{
+		jen.Id("Union"): jen.Op("&").Qual(obsGRPCPkg, unionType).Values(jen.Dict{
+			jen.Id(unionField): value,
+		}),
+	})
+}
+
+// number returns the next number satisfying the restrictions
+// (like ">= 0, <= 20").
+func (s *sampler) number(restrictions any, fractional bool) float64 {
+	s.lastNumber++
+	v := float64(s.lastNumber)
+	step := 1.0
+	if fractional {
+		v += 0.5
+		step = 0.5
+	}
+
+	r, _ := obsdoc.ParseValueRestrictions(restrictions)
+	// the lower bounds first, so that the upper ones win
+	if r.Min != nil && v < *r.Min {
+		v = *r.Min
+	}
+	if r.ExclusiveMin != nil && v <= *r.ExclusiveMin {
+		v = *r.ExclusiveMin + step
+	}
+	if r.Max != nil && v > *r.Max {
+		v = *r.Max
+	}
+	if r.ExclusiveMax != nil && v >= *r.ExclusiveMax {
+		v = *r.ExclusiveMax - step
+	}
+	return v
+}
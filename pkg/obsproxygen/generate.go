@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 
 	"github.com/dave/jennifer/jen"
@@ -44,6 +45,14 @@ func Generate(
 		}
 	}
 
+	if err := generateRequestMetadata(code, p.Requests); err != nil {
+		return fmt.Errorf("unable to generate the request metadata: %w", err)
+	}
+	if err := generateRequestFieldRules(code, p.Requests); err != nil {
+		return fmt.Errorf("unable to generate the validation rules: %w", err)
+	}
+	generateEventMetadata(code, p.Events)
+
 	err := code.Render(w)
 	if err != nil {
 		return fmt.Errorf("unable to render the code: %w", err)
@@ -52,6 +61,104 @@ func Generate(
 	return nil
 }
 
+func generateRequestMetadata(
+	code *jen.File,
+	requests []obsdoc.Request,
+) error {
+	items := jen.Dict{}
+	for _, request := range requests {
+		rpcVersion, err := strconv.ParseInt(request.RPCVersion, 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to parse the RPC version '%s' of request %s: %w", request.RPCVersion, request.RequestType, err)
+		}
+		fields := jen.Dict{
+			jen.Id("Category"):       jen.Lit(request.Category),
+			jen.Id("InitialVersion"): jen.Lit(request.InitialVersion),
+			jen.Id("RPCVersion"):     jen.Lit(int(rpcVersion)),
+		}
+		if request.Deprecated {
+			fields[jen.Id("Deprecated")] = jen.True()
+		}
+		items[jen.Lit(request.RequestType)] = jen.Values(fields)
+	}
+	code.Var().Id("requestMetadata").Op("=").Map(jen.String()).Id("requestMetadataT").Values(items)
+	return nil
+}
+
+// generateRequestFieldRules generates the rules of the request fields
+// (from valueRestrictions and valueOptional) checked by validateRequest.
+func generateRequestFieldRules(
+	code *jen.File,
+	requests []obsdoc.Request,
+) error {
+	items := jen.Dict{}
+	for _, request := range requests {
+		rules, err := requestFieldRules(request)
+		if err != nil {
+			return err
+		}
+		if len(rules) == 0 {
+			continue
+		}
+		items[jen.Lit(request.RequestType)] = jen.Values(rules...)
+	}
+	code.Var().Id("requestFieldRules").Op("=").Map(jen.String()).Index().Id("fieldRule").Values(items)
+	return nil
+}
+
+func requestFieldRules(request obsdoc.Request) ([]jen.Code, error) {
+	var rules []jen.Code
+	oneofs := obsprotobufgen.RequestOneofs(request.RequestFields)
+	for _, field := range request.RequestFields {
+		if oneof, ok := oneofs[field.ValueName]; ok && oneof.Required && field.ValueName == oneof.NameField {
+			rules = append(rules, jen.Values(jen.Dict{
+				jen.Id("Field"):    jen.Lit(oneof.Name),
+				jen.Id("Required"): jen.True(),
+			}))
+		}
+		restrictions, err := obsdoc.ParseValueRestrictions(field.ValueRestrictions)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the restrictions of field '%s' of request %s: %w", field.ValueName, request.RequestType, err)
+		}
+		required := obsprotobufgen.IsRequiredObject(field)
+		if restrictions.IsEmpty() && !required {
+			continue
+		}
+		rule := jen.Dict{
+			jen.Id("Field"): jen.Lit(obsprotobufgen.FieldNameObs2Protobuf(field.ValueName)),
+		}
+		if required {
+			rule[jen.Id("Required")] = jen.True()
+		}
+		for name, value := range map[string]*float64{
+			"Min":          restrictions.Min,
+			"Max":          restrictions.Max,
+			"ExclusiveMin": restrictions.ExclusiveMin,
+			"ExclusiveMax": restrictions.ExclusiveMax,
+		} {
+			if value != nil {
+				rule[jen.Id(name)] = jen.Id("ptr").Call(jen.Lit(*value))
+			}
+		}
+		rules = append(rules, jen.Values(rule))
+	}
+	return rules, nil
+}
+
+func generateEventMetadata(
+	code *jen.File,
+	events []obsdoc.Event,
+) {
+	items := jen.Dict{}
+	for _, event := range events {
+		items[jen.Lit(event.EventType)] = jen.Values(jen.Dict{
+			jen.Id("Category"):     jen.Lit(event.Category),
+			jen.Id("Subscription"): jen.Qual("github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc", "EventSubscription_"+event.EventSubscription),
+		})
+	}
+	code.Var().Id("eventMetadata").Op("=").Map(jen.String()).Id("eventMetadataT").Values(items)
+}
+
 func goOBSIsInt(fieldName string) bool {
 	switch {
 	case strings.HasSuffix(fieldName, "Index"):
@@ -105,12 +212,21 @@ func generateRequest(
 ) error {
 	var requestFieldPreAssigns []jen.Code
 	var requestFieldAssigns []jen.Code
+	oneofs := obsprotobufgen.RequestOneofs(request.RequestFields)
 	for _, field := range request.RequestFields {
 		if strings.Contains(field.ValueName, ".") {
 			continue
 		}
 		assignField := jen.Id(title(field.ValueName)).Op(":")
 		fieldNameSrc := obsprotobufgen.FieldNameObs2Protobuf(title(field.ValueName))
+		if _, ok := oneofs[field.ValueName]; ok {
+			// a member of a name-or-UUID oneof, the getter returns an empty string if it is not set
+			requestFieldAssigns = append(
+				requestFieldAssigns,
+				assignField.Id("nilIfEmpty").Call(jen.Id("req").Dot("Get"+fieldNameSrc).Call()).Op(","),
+			)
+			continue
+		}
 		src := jen.Id("req").Dot(fieldNameSrc)
 		castToType := ""
 		convertFunc := ""
@@ -256,6 +372,17 @@ func generateRequest(
 		requestFieldAssigns...,
 	))
 
+	rules, err := requestFieldRules(request)
+	if err != nil {
+		return err
+	}
+	validateCode := jen.Null()
+	if len(rules) > 0 {
+		validateCode = jen.If(jen.Id("err").Op(":=").Id("validateRequest").Call(jen.Lit(request.RequestType), jen.Id("req")), jen.Id("err").Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Id("err")),
+		)
+	}
+
 	code.Func().Params(jen.Id("p").Op("*").Id("Proxy")).Id(request.RequestType).Params(
 		jen.Id("ctx").Qual("context", "Context"),
 		jen.Id("req").Op("*").Qual("github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc", request.RequestType+"Request"),
@@ -269,8 +396,16 @@ func generateRequest(
 			jen.If(jen.Id("r").Op("!=").Nil()).Block(
 				jen.Id("_err").Op("=").Qual("fmt", "Errorf").Call(jen.Lit("got panic: %v\n\n%s"), jen.Id("r"), jen.Qual("runtime/debug", "Stack").Call()),
 			),
+			jen.Id("_err").Op("=").Id("p").Dot("afterRequest").Call(jen.Id("ctx"), jen.Lit(request.RequestType), jen.Id("req"), jen.Id("_ret"), jen.Id("_err")),
+			jen.If(jen.Id("_err").Op("!=").Nil()).Block(
+				jen.Id("_ret").Op("=").Nil(),
+			),
 			jen.Qual("github.com/facebookincubator/go-belt/tool/logger", "Tracef").Call(jen.Id("ctx"), jen.Lit("/"+request.RequestType+": %v"), jen.Id("_err")),
 		).Call(),
+		jen.If(jen.Id("err").Op(":=").Id("p").Dot("beforeRequest").Call(jen.Id("ctx"), jen.Lit(request.RequestType), jen.Id("req")), jen.Id("err").Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Id("err")),
+		),
+		validateCode,
 		jen.List(jen.Id("client"), jen.Id("err")).Op(":=").Id("p").Dot("getClient").Call(jen.Id("ctx")),
 		jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.List(jen.Nil(), jen.Qual("fmt", "Errorf").Params(jen.Lit("unable to get a client: %w"), jen.Id("err"))))),
 		jen.Id("params").Op(":=").Op("&").Qual("github.com/andreykaipov/goobs/api/requests/"+categoryObs2GoPkgName(request.Category), request.RequestType+"Params").Block(),
@@ -280,18 +415,16 @@ func generateRequest(
 		jen.Var().Call(
 			jen.Id("resp").Op(" ").Op("*").Qual("github.com/andreykaipov/goobs/api/requests/"+categoryObs2GoPkgName(request.Category), request.RequestType+"Response"),
 		),
-		jen.For().Block(
-			jen.List(jen.Id("resp"), jen.Id("err")).Op("=").Id("client").Dot(categoryObs2Go(request.Category)).Dot(request.RequestType).Call(
-				jen.Id("params"),
-			),
-			jen.If(jen.Id("err").Op("!=").Nil().Op("&&").Id("p").Dot("QueryErrorHandler").Op("!=").Nil()).Block(
-				jen.Id("fixErr").Op(":=").Id("p").Dot("QueryErrorHandler").Call(jen.Id("ctx"), jen.Id("err")),
-				jen.If(jen.Id("fixErr").Op("==").Nil()).Block(
-					jen.Qual("github.com/facebookincubator/go-belt/tool/logger", "Tracef").Call(jen.Id("ctx"), jen.Lit("there was error '%s', but it was handled"), jen.Id("err")),
-					jen.Continue(),
+		jen.Id("err").Op("=").Id("p").Dot("query").Call(
+			jen.Id("ctx"),
+			jen.Lit(request.RequestType),
+			jen.Func().Params().Error().Block(
+				jen.Var().Id("err").Error(),
+				jen.List(jen.Id("resp"), jen.Id("err")).Op("=").Id("client").Dot(categoryObs2Go(request.Category)).Dot(request.RequestType).Call(
+					jen.Id("params"),
 				),
+				jen.Return(jen.Id("err")),
 			),
-			jen.Break(),
 		),
 		jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.List(jen.Nil(), jen.Qual("fmt", "Errorf").Params(jen.Lit("query error: %w"), jen.Id("err"))))),
 		jen.If(jen.Id("resp").Op("==").Nil()).Block(jen.Return(jen.List(jen.Nil(), jen.Qual("fmt", "Errorf").Call(jen.Lit("internal error: resp is nil"))))),
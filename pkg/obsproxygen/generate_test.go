@@ -0,0 +1,28 @@
+package obsproxygen
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsdoc"
+)
+
+func TestRequestFieldRulesOneofMembers(t *testing.T) {
+	rules, err := requestFieldRules(obsdoc.Request{
+		RequestType: "SetSceneItemThing",
+		RequestFields: []obsdoc.Field{
+			{ValueName: "sceneName", ValueType: "String", ValueOptional: true},
+			{ValueName: "sceneUuid", ValueType: "String", ValueOptional: true, ValueRestrictions: ">= 1"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, `[]fieldRule{{
+	Field:    "scene",
+	Required: true,
+}, {
+	Field: "sceneUUID",
+	Min:   ptr(1.0),
+}}`, fmt.Sprintf("%#v", jen.Index().Id("fieldRule").Values(rules...)))
+}
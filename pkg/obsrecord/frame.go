@@ -0,0 +1,64 @@
+// Package obsrecord records the obs-websocket sessions (the raw frames with
+// the timings) and replays them, so that a session of a bug report could be
+// reproduced without OBS.
+package obsrecord
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Direction is the direction of a frame.
+type Direction string
+
+const (
+	DirectionToOBS   Direction = "to_obs"
+	DirectionFromOBS Direction = "from_obs"
+)
+
+// Frame is a WebSocket message of a recorded session. A recording is
+// a sequence of frames serialized as JSON, one per line.
+type Frame struct {
+	// Session is the number of the connection to OBS (starting from 1).
+	Session uint64 `json:"session"`
+
+	// Time is the time since the start of the session.
+	Time time.Duration `json:"time"`
+
+	Direction Direction `json:"direction"`
+
+	// Message is a text message (obs-websocket uses JSON).
+	Message json.RawMessage `json:"message,omitempty"`
+
+	// Binary is a binary message.
+	Binary []byte `json:"binary,omitempty"`
+
+	// CloseCode is set if the connection was closed by the side with
+	// the code (Message and Binary are empty then).
+	CloseCode int    `json:"closeCode,omitempty"`
+	CloseText string `json:"closeText,omitempty"`
+}
+
+// ReadFrames reads a recording.
+func ReadFrames(r io.Reader) ([]Frame, error) {
+	var result []Frame
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64<<20)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var frame Frame
+		if err := json.Unmarshal(scanner.Bytes(), &frame); err != nil {
+			return nil, fmt.Errorf("unable to parse line %d: %w", lineNum, err)
+		}
+		result = append(result, frame)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read the recording: %w", err)
+	}
+	return result, nil
+}
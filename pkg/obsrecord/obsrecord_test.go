@@ -0,0 +1,128 @@
+package obsrecord
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/andreykaipov/goobs"
+	"github.com/andreykaipov/goobs/api/events"
+	"github.com/andreykaipov/goobs/api/requests/inputs"
+	"github.com/andreykaipov/goobs/api/requests/scenes"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsfake"
+)
+
+type syncBuffer struct {
+	locker sync.Mutex
+	buf    bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.locker.Lock()
+	defer b.locker.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) Frames(t *testing.T) []Frame {
+	b.locker.Lock()
+	defer b.locker.Unlock()
+	frames, err := ReadFrames(bytes.NewReader(b.buf.Bytes()))
+	require.NoError(t, err)
+	return frames
+}
+
+func serve(t *testing.T, srv *httptest.Server) string {
+	t.Cleanup(srv.Close)
+	return strings.TrimPrefix(srv.URL, "http://")
+}
+
+// session runs the same requests against OBS (or a replay), and returns
+// what the client observed.
+func session(t *testing.T, addr string) []any {
+	client, err := goobs.New(addr, goobs.WithPassword("secret"))
+	require.NoError(t, err)
+	defer client.Disconnect()
+
+	var observed []any
+	_, err = client.Scenes.CreateScene(scenes.NewCreateSceneParams().WithSceneName("Live"))
+	require.NoError(t, err)
+	select {
+	case ev := <-client.IncomingEvents:
+		observed = append(observed, ev)
+	case <-time.After(10 * time.Second):
+		t.Fatal("no event received")
+	}
+	sceneList, err := client.Scenes.GetSceneList()
+	require.NoError(t, err)
+	observed = append(observed, sceneList)
+	_, err = client.Inputs.GetInputMute(inputs.NewGetInputMuteParams().WithInputName("Mic"))
+	require.Error(t, err)
+	observed = append(observed, err.Error())
+	return observed
+}
+
+func TestRecordReplay(t *testing.T) {
+	obsAddr := serve(t, httptest.NewServer(obsfake.New(obsfake.OptionPassword("secret"))))
+	var recording syncBuffer
+	relayAddr := serve(t, httptest.NewServer(NewRecorder(&recording).Relay(obsAddr)))
+
+	recorded := session(t, relayAddr)
+	require.Equal(t, &events.SceneCreated{SceneName: "Live", SceneUuid: "00000000-0000-4000-8000-000000000002"}, recorded[0])
+	frames := recording.Frames(t)
+	require.NotEmpty(t, frames)
+	require.Equal(t, uint64(1), frames[0].Session)
+	require.Equal(t, DirectionFromOBS, frames[0].Direction)
+	require.Contains(t, string(frames[0].Message), `"op":0`)
+
+	replayer := NewReplayer(frames)
+	replayAddr := serve(t, httptest.NewServer(replayer))
+	require.Equal(t, recorded, session(t, replayAddr))
+
+	// only one session was recorded
+	_, err := goobs.New(replayAddr, goobs.WithPassword("secret"))
+	require.Error(t, err)
+	require.NoError(t, replayer.Err())
+}
+
+func TestReplayMismatch(t *testing.T) {
+	obsAddr := serve(t, httptest.NewServer(obsfake.New(obsfake.OptionPassword("secret"))))
+	var recording syncBuffer
+	relayAddr := serve(t, httptest.NewServer(NewRecorder(&recording).Relay(obsAddr)))
+	session(t, relayAddr)
+
+	replayer := NewReplayer(recording.Frames(t))
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+serve(t, httptest.NewServer(replayer)), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, _, err = conn.ReadMessage() // Hello
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteJSON(map[string]any{"op": 1, "d": map[string]any{"rpcVersion": 1, "eventSubscriptions": 2047}}))
+	_, _, err = conn.ReadMessage() // Identified
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteJSON(map[string]any{"op": 6, "d": map[string]any{
+		"requestType": "CreateScene",
+		"requestId":   "1",
+		"requestData": map[string]any{"sceneName": "Intro"},
+	}}))
+	_, _, err = conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, closeCodeUnknownReason), err)
+	require.ErrorContains(t, replayer.Err(), `"sceneName":"Intro"`)
+}
+
+func TestTruncateUTF8(t *testing.T) {
+	require.Equal(t, "abc", truncateUTF8("abc", 3))
+	require.Equal(t, "ab", truncateUTF8("abc", 2))
+	require.Equal(t, "a", truncateUTF8("aé", 2))
+	require.Equal(t, "aé", truncateUTF8("aéb", 3))
+	require.Equal(t, "", truncateUTF8("世界", 2))
+
+	text := truncateUTF8(strings.Repeat("é", 100), maxCloseReasonLength)
+	require.True(t, utf8.ValidString(text))
+	require.Len(t, text, maxCloseReasonLength)
+}
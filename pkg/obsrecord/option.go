@@ -0,0 +1,36 @@
+package obsrecord
+
+type configT struct {
+	TimeScale float64
+}
+
+func defaultConfig() configT {
+	return configT{}
+}
+
+type Option interface {
+	apply(cfg *configT)
+}
+
+type Options []Option
+
+func (s Options) apply(cfg *configT) {
+	for _, opt := range s {
+		opt.apply(cfg)
+	}
+}
+
+func (s Options) config() configT {
+	cfg := defaultConfig()
+	s.apply(&cfg)
+	return cfg
+}
+
+// OptionTimeScale makes Replayer keep the recorded pauses between
+// the frames sent to the client, multiplied by the value (1 is the real
+// time). By default the frames are sent without pauses.
+type OptionTimeScale float64
+
+func (opt OptionTimeScale) apply(cfg *configT) {
+	cfg.TimeScale = float64(opt)
+}
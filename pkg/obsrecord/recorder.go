@@ -0,0 +1,148 @@
+package obsrecord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/gorilla/websocket"
+)
+
+// Recorder writes the frames of the sessions to a recording.
+type Recorder struct {
+	locker      sync.Mutex
+	encoder     *json.Encoder
+	lastSession uint64
+}
+
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{
+		encoder: json.NewEncoder(w),
+	}
+}
+
+func (rec *Recorder) newSession() uint64 {
+	rec.locker.Lock()
+	defer rec.locker.Unlock()
+	rec.lastSession++
+	return rec.lastSession
+}
+
+// WriteFrame appends the frame to the recording.
+func (rec *Recorder) WriteFrame(frame Frame) error {
+	rec.locker.Lock()
+	defer rec.locker.Unlock()
+	if err := rec.encoder.Encode(frame); err != nil {
+		return fmt.Errorf("unable to write the frame: %w", err)
+	}
+	return nil
+}
+
+// Relay returns an http.Handler relaying the obs-websocket connections to
+// OBS at obsAddr and recording every frame. A client (for example goobs)
+// connects to the handler instead of OBS, and authenticates with OBS
+// the same as usual.
+func (rec *Recorder) Relay(obsAddr string) http.Handler {
+	return &relay{
+		recorder: rec,
+		obsURL:   url.URL{Scheme: "ws", Host: obsAddr},
+	}
+}
+
+type relay struct {
+	recorder *Recorder
+	obsURL   url.URL
+}
+
+func (r *relay) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	dialer := *websocket.DefaultDialer
+	dialer.Subprotocols = websocket.Subprotocols(req)
+	obsConn, _, err := dialer.DialContext(ctx, r.obsURL.String(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("unable to connect to OBS: %v", err), http.StatusBadGateway)
+		return
+	}
+	defer obsConn.Close()
+
+	upgrader := websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+	respHeader := http.Header{}
+	if subprotocol := obsConn.Subprotocol(); subprotocol != "" {
+		respHeader.Set("Sec-WebSocket-Protocol", subprotocol)
+	}
+	clientConn, err := upgrader.Upgrade(w, req, respHeader)
+	if err != nil {
+		logger.Debugf(ctx, "unable to upgrade the connection from %s: %v", req.RemoteAddr, err)
+		return
+	}
+	defer clientConn.Close()
+
+	s := &relaySession{
+		ctx:      ctx,
+		recorder: r.recorder,
+		id:       r.recorder.newSession(),
+		start:    time.Now(),
+	}
+	logger.Debugf(ctx, "recording session %d of %s", s.id, req.RemoteAddr)
+	errCh := make(chan error, 2)
+	go func() { errCh <- s.pump(clientConn, obsConn, DirectionToOBS) }()
+	go func() { errCh <- s.pump(obsConn, clientConn, DirectionFromOBS) }()
+	err = <-errCh
+	logger.Debugf(ctx, "recorded session %d ended: %v", s.id, err)
+}
+
+type relaySession struct {
+	ctx      context.Context
+	recorder *Recorder
+	id       uint64
+	start    time.Time
+}
+
+// pump copies the messages from src to dst until an error, recording them.
+// The closure of src is propagated to dst.
+func (s *relaySession) pump(src, dst *websocket.Conn, direction Direction) error {
+	for {
+		msgType, b, err := src.ReadMessage()
+		frame := Frame{
+			Session:   s.id,
+			Time:      time.Since(s.start),
+			Direction: direction,
+		}
+		if err != nil {
+			var closeErr *websocket.CloseError
+			if !errors.As(err, &closeErr) {
+				return fmt.Errorf("unable to read a message: %w", err)
+			}
+			frame.CloseCode, frame.CloseText = closeErr.Code, closeErr.Text
+			s.writeFrame(frame)
+			dst.WriteControl(
+				websocket.CloseMessage,
+				websocket.FormatCloseMessage(closeErr.Code, closeErr.Text),
+				time.Now().Add(time.Second),
+			)
+			return err
+		}
+		if msgType == websocket.TextMessage && json.Valid(b) {
+			frame.Message = b
+		} else {
+			frame.Binary = b
+		}
+		s.writeFrame(frame)
+		if err := dst.WriteMessage(msgType, b); err != nil {
+			return fmt.Errorf("unable to write a message: %w", err)
+		}
+	}
+}
+
+func (s *relaySession) writeFrame(frame Frame) {
+	if err := s.recorder.WriteFrame(frame); err != nil {
+		logger.Errorf(s.ctx, "unable to record session %d: %v", s.id, err)
+	}
+}
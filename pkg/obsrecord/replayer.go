@@ -0,0 +1,306 @@
+package obsrecord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/gorilla/websocket"
+)
+
+// closeCodeUnknownReason is the close code of obs-websocket used when
+// the client deviates from the recording.
+const closeCodeUnknownReason = 4000
+
+// maxCloseReasonLength is the limit of the close reason: the control
+// frames are limited to 125 bytes, including the close code.
+const maxCloseReasonLength = 120
+
+// Replayer is an http.Handler serving the recorded sessions back: the n-th
+// connection replays the n-th session, the connections beyond the recorded
+// ones are refused.
+//
+// The frames from OBS are sent in the recorded order, and each frame to OBS
+// is awaited from the client and compared with the recorded one. The request
+// IDs (which are random in most clients) are not compared, instead
+// the recorded IDs are replaced with the IDs used by the client. Field
+// "authentication" of message Identify is not compared either.
+// On a mismatch the connection is closed and the error is reported by Err.
+type Replayer struct {
+	cfg      configT
+	sessions [][]Frame
+	upgrader websocket.Upgrader
+
+	locker      sync.Mutex
+	nextSession int
+	errs        []error
+}
+
+var _ http.Handler = (*Replayer)(nil)
+
+func NewReplayer(frames []Frame, opts ...Option) *Replayer {
+	var (
+		sessions     [][]Frame
+		sessionIndex = map[uint64]int{}
+	)
+	for _, frame := range frames {
+		idx, ok := sessionIndex[frame.Session]
+		if !ok {
+			idx = len(sessions)
+			sessionIndex[frame.Session] = idx
+			sessions = append(sessions, nil)
+		}
+		sessions[idx] = append(sessions[idx], frame)
+	}
+	return &Replayer{
+		cfg:      Options(opts).config(),
+		sessions: sessions,
+		upgrader: websocket.Upgrader{
+			Subprotocols: []string{"obswebsocket.json"},
+			CheckOrigin:  func(*http.Request) bool { return true },
+		},
+	}
+}
+
+// Err returns the mismatches between the clients and the recording
+// found so far.
+func (r *Replayer) Err() error {
+	r.locker.Lock()
+	defer r.locker.Unlock()
+	return errors.Join(r.errs...)
+}
+
+func (r *Replayer) addErr(err error) {
+	r.locker.Lock()
+	defer r.locker.Unlock()
+	r.errs = append(r.errs, err)
+}
+
+func (r *Replayer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	r.locker.Lock()
+	sessionIdx := r.nextSession
+	r.nextSession++
+	r.locker.Unlock()
+	if sessionIdx >= len(r.sessions) {
+		http.Error(w, fmt.Sprintf("only %d sessions were recorded", len(r.sessions)), http.StatusServiceUnavailable)
+		return
+	}
+
+	conn, err := r.upgrader.Upgrade(w, req, nil)
+	if err != nil {
+		logger.Debugf(ctx, "unable to upgrade the connection from %s: %v", req.RemoteAddr, err)
+		return
+	}
+	defer conn.Close()
+
+	s := &replaySession{
+		ctx:      ctx,
+		replayer: r,
+		conn:     conn,
+		frames:   r.sessions[sessionIdx],
+		ids:      map[string]string{},
+	}
+	err = s.replay()
+	logger.Debugf(ctx, "replay of session %d to %s ended: %v", sessionIdx+1, req.RemoteAddr, err)
+}
+
+type replaySession struct {
+	ctx      context.Context
+	replayer *Replayer
+	conn     *websocket.Conn
+	frames   []Frame
+
+	// ids maps the recorded request IDs to the IDs used by the client.
+	ids map[string]string
+}
+
+func (s *replaySession) replay() error {
+	var lastTime time.Duration
+	for idx, frame := range s.frames {
+		if frame.Direction == DirectionToOBS {
+			if err := s.expect(frame); err != nil {
+				err = fmt.Errorf("session %d, frame %d: %w", frame.Session, idx+1, err)
+				s.mismatch(err)
+				return err
+			}
+			lastTime = frame.Time
+			continue
+		}
+
+		if scale := s.replayer.cfg.TimeScale; scale > 0 {
+			time.Sleep(time.Duration(float64(frame.Time-lastTime) * scale))
+		}
+		lastTime = frame.Time
+		if frame.CloseCode != 0 {
+			s.close(frame.CloseCode, frame.CloseText)
+			return nil
+		}
+		if err := s.send(frame); err != nil {
+			return err
+		}
+	}
+
+	// the recording is over, but the client may still expect something
+	for {
+		_, b, err := s.conn.ReadMessage()
+		if err != nil {
+			return nil
+		}
+		err = fmt.Errorf("session %d: unexpected message after the end of the recording: %s", s.frames[0].Session, b)
+		s.mismatch(err)
+		return err
+	}
+}
+
+// mismatch reports the deviation of the client from the recording and
+// closes the connection.
+func (s *replaySession) mismatch(err error) {
+	logger.Errorf(s.ctx, "the client deviated from the recording: %v", err)
+	s.replayer.addErr(err)
+	s.close(closeCodeUnknownReason, err.Error())
+}
+
+func (s *replaySession) close(code int, text string) {
+	text = truncateUTF8(text, maxCloseReasonLength)
+	s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), time.Now().Add(time.Second))
+}
+
+// truncateUTF8 truncates the text to at most maxLength bytes without
+// cutting a character.
+func truncateUTF8(text string, maxLength int) string {
+	if len(text) <= maxLength {
+		return text
+	}
+	n := maxLength
+	for n > 0 && !utf8.RuneStart(text[n]) {
+		n--
+	}
+	return text[:n]
+}
+
+func (s *replaySession) send(frame Frame) error {
+	if frame.Message == nil {
+		return s.conn.WriteMessage(websocket.BinaryMessage, frame.Binary)
+	}
+	var msg any
+	if err := json.Unmarshal(frame.Message, &msg); err != nil {
+		return fmt.Errorf("unable to parse the recorded message: %w", err)
+	}
+	msg = replaceRequestIDs(msg, s.ids)
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("unable to serialize the message: %w", err)
+	}
+	return s.conn.WriteMessage(websocket.TextMessage, b)
+}
+
+// expect receives a message from the client and compares it with the frame.
+func (s *replaySession) expect(frame Frame) error {
+	msgType, b, err := s.conn.ReadMessage()
+	if err != nil {
+		var closeErr *websocket.CloseError
+		if errors.As(err, &closeErr) && closeErr.Code == frame.CloseCode {
+			return nil
+		}
+		return fmt.Errorf("expected %s, but received an error: %w", frame.describe(), err)
+	}
+	if frame.Message == nil {
+		if msgType != websocket.BinaryMessage || string(b) != string(frame.Binary) {
+			return fmt.Errorf("expected %s, but received %s", frame.describe(), b)
+		}
+		return nil
+	}
+
+	var expected, actual any
+	if err := json.Unmarshal(frame.Message, &expected); err != nil {
+		return fmt.Errorf("unable to parse the recorded message: %w", err)
+	}
+	if err := json.Unmarshal(b, &actual); err != nil {
+		return fmt.Errorf("expected %s, but received %s", frame.describe(), b)
+	}
+	var expectedIDs, actualIDs []string
+	expected = normalizeMessage(expected, &expectedIDs)
+	actual = normalizeMessage(actual, &actualIDs)
+	if !reflect.DeepEqual(expected, actual) || len(expectedIDs) != len(actualIDs) {
+		return fmt.Errorf("expected %s, but received %s", frame.describe(), b)
+	}
+	for idx, id := range expectedIDs {
+		s.ids[id] = actualIDs[idx]
+	}
+	return nil
+}
+
+func (frame Frame) describe() string {
+	switch {
+	case frame.CloseCode != 0:
+		return fmt.Sprintf("the connection to be closed with code %d", frame.CloseCode)
+	case frame.Message != nil:
+		return string(frame.Message)
+	default:
+		return fmt.Sprintf("binary message %X", frame.Binary)
+	}
+}
+
+// normalizeMessage removes the values which are not expected to be
+// reproduced: the request IDs (they are appended to ids in a stable order)
+// and the authentication string.
+func normalizeMessage(msg any, ids *[]string) any {
+	if m, ok := msg.(map[string]any); ok {
+		if d, ok := m["d"].(map[string]any); ok {
+			delete(d, "authentication")
+		}
+	}
+	return removeRequestIDs(msg, ids)
+}
+
+func removeRequestIDs(v any, ids *[]string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		if id, ok := v["requestId"].(string); ok {
+			*ids = append(*ids, id)
+			delete(v, "requestId")
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			v[k] = removeRequestIDs(v[k], ids)
+		}
+	case []any:
+		for idx := range v {
+			v[idx] = removeRequestIDs(v[idx], ids)
+		}
+	}
+	return v
+}
+
+func replaceRequestIDs(v any, ids map[string]string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, item := range v {
+			if id, ok := item.(string); ok && k == "requestId" {
+				if newID, ok := ids[id]; ok {
+					v[k] = newID
+				}
+				continue
+			}
+			v[k] = replaceRequestIDs(item, ids)
+		}
+	case []any:
+		for idx := range v {
+			v[idx] = replaceRequestIDs(v[idx], ids)
+		}
+	}
+	return v
+}
@@ -0,0 +1,374 @@
+// Package obsrestgateway exposes the OBS service as an HTTP/JSON API:
+//
+//   - POST /v1/<RequestType> (with the request as the JSON body) calls the request;
+//   - GET /v1/events streams the events as Server-Sent Events;
+//   - GET /v1/openapi.json returns the OpenAPI document of the API.
+//
+// The JSON follows the protobuf JSON mapping (protojson), except that the
+// 'bytes' fields (used for OBS strings) are represented as text.
+package obsrestgateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsgrpcproxy"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsprotojson"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	PathPrefix = "/v1/"
+
+	sseKeepAliveInterval = 15 * time.Second
+	maxRequestBodySize   = 16 << 20
+)
+
+type route struct {
+	RequestType string
+	Category    string
+	Description string
+	Deprecated  bool
+	NewRequest  func() proto.Message
+	Call        func(ctx context.Context, client obs_grpc.OBSClient, req proto.Message) (proto.Message, error)
+}
+
+// Gateway is an http.Handler translating HTTP/JSON requests to calls of
+// an OBSClient (for example obsgrpcproxy.ProxyAsClient, or a gRPC client
+// connected to a proxy).
+type Gateway struct {
+	client obs_grpc.OBSClient
+	mux    *http.ServeMux
+}
+
+var _ http.Handler = (*Gateway)(nil)
+
+func New(client obs_grpc.OBSClient) *Gateway {
+	gw := &Gateway{
+		client: client,
+		mux:    http.NewServeMux(),
+	}
+	for _, r := range routes {
+		r := r
+		gw.mux.HandleFunc("POST "+PathPrefix+r.RequestType, func(w http.ResponseWriter, req *http.Request) {
+			gw.serveRoute(w, req, r)
+		})
+	}
+	gw.mux.HandleFunc("GET "+PathPrefix+"events", gw.serveEvents)
+	gw.mux.HandleFunc("GET "+PathPrefix+"openapi.json", gw.serveOpenAPI)
+	return gw
+}
+
+func (gw *Gateway) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	gw.mux.ServeHTTP(w, req)
+}
+
+func (gw *Gateway) serveRoute(
+	w http.ResponseWriter,
+	httpReq *http.Request,
+	r route,
+) {
+	ctx := httpReq.Context()
+
+	body, err := io.ReadAll(io.LimitReader(httpReq.Body, maxRequestBodySize))
+	if err != nil {
+		writeError(ctx, w, status.Errorf(codes.InvalidArgument, "unable to read the request body: %v", err))
+		return
+	}
+
+	req := r.NewRequest()
+	if len(strings.TrimSpace(string(body))) > 0 {
+		if err := obsprotojson.Unmarshal(protojson.UnmarshalOptions{}, body, req); err != nil {
+			writeError(ctx, w, status.Errorf(codes.InvalidArgument, "unable to parse the request: %v", err))
+			return
+		}
+	}
+
+	resp, err := r.Call(ctx, gw.client, req)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeMessage(ctx, w, http.StatusOK, resp)
+}
+
+var marshalOptions = protojson.MarshalOptions{
+	EmitUnpopulated: true,
+}
+
+func writeMessage(
+	ctx context.Context,
+	w http.ResponseWriter,
+	httpStatus int,
+	msg proto.Message,
+) {
+	b, err := obsprotojson.Marshal(marshalOptions, msg)
+	if err != nil {
+		logger.Errorf(ctx, "unable to serialize %T: %v", msg, err)
+		http.Error(w, "unable to serialize the response", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	if _, err := w.Write(b); err != nil {
+		logger.Debugf(ctx, "unable to write the response: %v", err)
+	}
+}
+
+// ErrorResponse is the body of a response to a failed request.
+type ErrorResponse struct {
+	// Code is the gRPC status code, for example "Unknown".
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// RequestStatus is the status reported by OBS (if it was reported),
+	// for example "ResourceNotFound".
+	RequestStatus     string `json:"requestStatus,omitempty"`
+	RequestStatusCode int    `json:"requestStatusCode,omitempty"`
+}
+
+func writeError(
+	ctx context.Context,
+	w http.ResponseWriter,
+	err error,
+) {
+	s := status.Convert(err)
+	result := ErrorResponse{
+		Code:    s.Code().String(),
+		Message: s.Message(),
+	}
+	httpStatus := httpStatusFromCode(s.Code())
+	// matching the status message: the "rpc error: code = ... desc = " prefix
+	// hides the beginning of the error reported by goobs
+	if requestStatus, ok := obsgrpcproxy.RequestStatusFromError(errors.New(s.Message())); ok {
+		result.RequestStatus = requestStatus.String()
+		result.RequestStatusCode = int(requestStatus)
+		httpStatus = httpStatusFromRequestStatus(requestStatus)
+	}
+	logger.Debugf(ctx, "replying with an error (HTTP status %d): %v", httpStatus, err)
+
+	b, err := json.Marshal(result)
+	if err != nil {
+		logger.Errorf(ctx, "unable to serialize the error: %v", err)
+		http.Error(w, s.Message(), httpStatus)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	w.Write(b)
+}
+
+func httpStatusFromRequestStatus(s obs_grpc.RequestStatus) int {
+	switch {
+	case s == obs_grpc.RequestStatus_ResourceNotFound:
+		return http.StatusNotFound
+	case s == obs_grpc.RequestStatus_NotReady:
+		return http.StatusServiceUnavailable
+	case s >= 200 && s < 500:
+		// invalid requests
+		return http.StatusBadRequest
+	case s >= 500 && s < 700:
+		// the state of OBS or its resources does not allow the request
+		return http.StatusConflict
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+// serveEvents streams the events as Server-Sent Events. Query parameters:
+//
+//   - afterSeqNo, maxAge (for example "30s"): replay the events kept
+//     in the history (see SubscribeToEventsRequest); header Last-Event-ID
+//     is handled as afterSeqNo, so that a reconnecting EventSource resumes;
+//   - eventType, category (could be repeated): filter the events.
+func (gw *Gateway) serveEvents(
+	w http.ResponseWriter,
+	httpReq *http.Request,
+) {
+	ctx := httpReq.Context()
+	query := httpReq.URL.Query()
+
+	req := &obs_grpc.SubscribeToEventsRequest{}
+	afterSeqNo := query.Get("afterSeqNo")
+	if lastEventID := httpReq.Header.Get("Last-Event-ID"); lastEventID != "" {
+		afterSeqNo = lastEventID
+	}
+	if afterSeqNo != "" {
+		v, err := strconv.ParseUint(afterSeqNo, 10, 64)
+		if err != nil {
+			writeError(ctx, w, status.Errorf(codes.InvalidArgument, "invalid afterSeqNo '%s': %v", afterSeqNo, err))
+			return
+		}
+		req.AfterSeqNo = &v
+	}
+	if maxAge := query.Get("maxAge"); maxAge != "" {
+		v, err := time.ParseDuration(maxAge)
+		if err != nil {
+			writeError(ctx, w, status.Errorf(codes.InvalidArgument, "invalid maxAge '%s': %v", maxAge, err))
+			return
+		}
+		req.MaxAgeNanoseconds = proto.Int64(v.Nanoseconds())
+	}
+	eventTypes := toSet(query["eventType"])
+	categories := toSet(query["category"])
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(ctx, w, status.Errorf(codes.Unimplemented, "streaming is not supported by the connection"))
+		return
+	}
+
+	ctx, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
+	stream, err := gw.client.SubscribeToEvents(ctx, req)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	type recvResult struct {
+		Event *obs_grpc.EventEnvelope
+		Err   error
+	}
+	ch := make(chan recvResult)
+	go func() {
+		defer close(ch)
+		for {
+			ev, err := stream.Recv()
+			select {
+			case ch <- recvResult{Event: ev, Err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	keepAlive := time.NewTicker(sseKeepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		var ev *obs_grpc.EventEnvelope
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			if _, err := io.WriteString(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+			continue
+		case r, ok := <-ch:
+			if !ok {
+				return
+			}
+			if r.Err != nil {
+				if !errors.Is(r.Err, io.EOF) {
+					logger.Debugf(ctx, "the event stream ended: %v", r.Err)
+				}
+				return
+			}
+			ev = r.Event
+		}
+
+		if ev.GetGap() == nil {
+			if len(eventTypes) > 0 && !eventTypes[ev.GetEventType()] {
+				continue
+			}
+			if len(categories) > 0 && !categories[ev.GetEventCategory()] {
+				continue
+			}
+		}
+		if err := writeEvent(w, ev); err != nil {
+			logger.Debugf(ctx, "unable to send an event: %v", err)
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+func writeEvent(w io.Writer, ev *obs_grpc.EventEnvelope) error {
+	b, err := obsprotojson.Marshal(protojson.MarshalOptions{}, ev)
+	if err != nil {
+		return fmt.Errorf("unable to serialize event #%d: %w", ev.GetSeqNo(), err)
+	}
+	if ev.GetGap() != nil {
+		_, err = fmt.Fprintf(w, "event: gap\ndata: %s\n\n", b)
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", ev.GetSeqNo(), ev.GetEventType(), b)
+	return err
+}
+
+func toSet(values []string) map[string]bool {
+	result := map[string]bool{}
+	for _, v := range values {
+		for _, item := range strings.Split(v, ",") {
+			if item != "" {
+				result[item] = true
+			}
+		}
+	}
+	return result
+}
+
+func (gw *Gateway) serveOpenAPI(
+	w http.ResponseWriter,
+	httpReq *http.Request,
+) {
+	b, err := OpenAPI()
+	if err != nil {
+		writeError(httpReq.Context(), w, status.Errorf(codes.Internal, "unable to build the OpenAPI document: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
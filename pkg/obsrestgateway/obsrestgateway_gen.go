@@ -0,0 +1,1431 @@
+// This file was automatically generated by github.com/xaionaro-go/obs-grpc-proxy/scripts/generate
+
+package obsrestgateway
+
+import (
+	"context"
+	obsgrpc "github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	proto "google.golang.org/protobuf/proto"
+)
+
+var routes = []route{{
+	RequestType: "GetPersistentData",
+	Category:    "config",
+	Description: "Gets the value of a \"slot\" from the selected persistent data realm.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetPersistentDataRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetPersistentData(ctx, req.(*obsgrpc.GetPersistentDataRequest))
+	},
+}, {
+	RequestType: "SetPersistentData",
+	Category:    "config",
+	Description: "Sets the value of a \"slot\" from the selected persistent data realm.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetPersistentDataRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetPersistentData(ctx, req.(*obsgrpc.SetPersistentDataRequest))
+	},
+}, {
+	RequestType: "GetSceneCollectionList",
+	Category:    "config",
+	Description: "Gets an array of all scene collections",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSceneCollectionListRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSceneCollectionList(ctx, req.(*obsgrpc.GetSceneCollectionListRequest))
+	},
+}, {
+	RequestType: "SetCurrentSceneCollection",
+	Category:    "config",
+	Description: "Switches to a scene collection.\n\nNote: This will block until the collection has finished changing.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetCurrentSceneCollectionRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetCurrentSceneCollection(ctx, req.(*obsgrpc.SetCurrentSceneCollectionRequest))
+	},
+}, {
+	RequestType: "CreateSceneCollection",
+	Category:    "config",
+	Description: "Creates a new scene collection, switching to it in the process.\n\nNote: This will block until the collection has finished changing.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.CreateSceneCollectionRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.CreateSceneCollection(ctx, req.(*obsgrpc.CreateSceneCollectionRequest))
+	},
+}, {
+	RequestType: "GetProfileList",
+	Category:    "config",
+	Description: "Gets an array of all profiles",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetProfileListRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetProfileList(ctx, req.(*obsgrpc.GetProfileListRequest))
+	},
+}, {
+	RequestType: "SetCurrentProfile",
+	Category:    "config",
+	Description: "Switches to a profile.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetCurrentProfileRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetCurrentProfile(ctx, req.(*obsgrpc.SetCurrentProfileRequest))
+	},
+}, {
+	RequestType: "CreateProfile",
+	Category:    "config",
+	Description: "Creates a new profile, switching to it in the process",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.CreateProfileRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.CreateProfile(ctx, req.(*obsgrpc.CreateProfileRequest))
+	},
+}, {
+	RequestType: "RemoveProfile",
+	Category:    "config",
+	Description: "Removes a profile. If the current profile is chosen, it will change to a different profile first.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.RemoveProfileRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.RemoveProfile(ctx, req.(*obsgrpc.RemoveProfileRequest))
+	},
+}, {
+	RequestType: "GetProfileParameter",
+	Category:    "config",
+	Description: "Gets a parameter from the current profile's configuration.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetProfileParameterRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetProfileParameter(ctx, req.(*obsgrpc.GetProfileParameterRequest))
+	},
+}, {
+	RequestType: "SetProfileParameter",
+	Category:    "config",
+	Description: "Sets the value of a parameter in the current profile's configuration.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetProfileParameterRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetProfileParameter(ctx, req.(*obsgrpc.SetProfileParameterRequest))
+	},
+}, {
+	RequestType: "GetVideoSettings",
+	Category:    "config",
+	Description: "Gets the current video settings.\n\nNote: To get the true FPS value, divide the FPS numerator by the FPS denominator. Example: `60000/1001`",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetVideoSettingsRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetVideoSettings(ctx, req.(*obsgrpc.GetVideoSettingsRequest))
+	},
+}, {
+	RequestType: "SetVideoSettings",
+	Category:    "config",
+	Description: "Sets the current video settings.\n\nNote: Fields must be specified in pairs. For example, you cannot set only `baseWidth` without needing to specify `baseHeight`.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetVideoSettingsRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetVideoSettings(ctx, req.(*obsgrpc.SetVideoSettingsRequest))
+	},
+}, {
+	RequestType: "GetStreamServiceSettings",
+	Category:    "config",
+	Description: "Gets the current stream service settings (stream destination).",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetStreamServiceSettingsRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetStreamServiceSettings(ctx, req.(*obsgrpc.GetStreamServiceSettingsRequest))
+	},
+}, {
+	RequestType: "SetStreamServiceSettings",
+	Category:    "config",
+	Description: "Sets the current stream service settings (stream destination).\n\nNote: Simple RTMP settings can be set with type `rtmp_custom` and the settings fields `server` and `key`.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetStreamServiceSettingsRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetStreamServiceSettings(ctx, req.(*obsgrpc.SetStreamServiceSettingsRequest))
+	},
+}, {
+	RequestType: "GetRecordDirectory",
+	Category:    "config",
+	Description: "Gets the current directory that the record output is set to.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetRecordDirectoryRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetRecordDirectory(ctx, req.(*obsgrpc.GetRecordDirectoryRequest))
+	},
+}, {
+	RequestType: "SetRecordDirectory",
+	Category:    "config",
+	Description: "Sets the current directory that the record output writes files to.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetRecordDirectoryRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetRecordDirectory(ctx, req.(*obsgrpc.SetRecordDirectoryRequest))
+	},
+}, {
+	RequestType: "GetSourceFilterKindList",
+	Category:    "filters",
+	Description: "Gets an array of all available source filter kinds.\n\nSimilar to `GetInputKindList`",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSourceFilterKindListRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSourceFilterKindList(ctx, req.(*obsgrpc.GetSourceFilterKindListRequest))
+	},
+}, {
+	RequestType: "GetSourceFilterList",
+	Category:    "filters",
+	Description: "Gets an array of all of a source's filters.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSourceFilterListRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSourceFilterList(ctx, req.(*obsgrpc.GetSourceFilterListRequest))
+	},
+}, {
+	RequestType: "GetSourceFilterDefaultSettings",
+	Category:    "filters",
+	Description: "Gets the default settings for a filter kind.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSourceFilterDefaultSettingsRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSourceFilterDefaultSettings(ctx, req.(*obsgrpc.GetSourceFilterDefaultSettingsRequest))
+	},
+}, {
+	RequestType: "CreateSourceFilter",
+	Category:    "filters",
+	Description: "Creates a new filter, adding it to the specified source.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.CreateSourceFilterRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.CreateSourceFilter(ctx, req.(*obsgrpc.CreateSourceFilterRequest))
+	},
+}, {
+	RequestType: "RemoveSourceFilter",
+	Category:    "filters",
+	Description: "Removes a filter from a source.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.RemoveSourceFilterRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.RemoveSourceFilter(ctx, req.(*obsgrpc.RemoveSourceFilterRequest))
+	},
+}, {
+	RequestType: "SetSourceFilterName",
+	Category:    "filters",
+	Description: "Sets the name of a source filter (rename).",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetSourceFilterNameRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetSourceFilterName(ctx, req.(*obsgrpc.SetSourceFilterNameRequest))
+	},
+}, {
+	RequestType: "GetSourceFilter",
+	Category:    "filters",
+	Description: "Gets the info for a specific source filter.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSourceFilterRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSourceFilter(ctx, req.(*obsgrpc.GetSourceFilterRequest))
+	},
+}, {
+	RequestType: "SetSourceFilterIndex",
+	Category:    "filters",
+	Description: "Sets the index position of a filter on a source.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetSourceFilterIndexRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetSourceFilterIndex(ctx, req.(*obsgrpc.SetSourceFilterIndexRequest))
+	},
+}, {
+	RequestType: "SetSourceFilterSettings",
+	Category:    "filters",
+	Description: "Sets the settings of a source filter.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetSourceFilterSettingsRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetSourceFilterSettings(ctx, req.(*obsgrpc.SetSourceFilterSettingsRequest))
+	},
+}, {
+	RequestType: "SetSourceFilterEnabled",
+	Category:    "filters",
+	Description: "Sets the enable state of a source filter.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetSourceFilterEnabledRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetSourceFilterEnabled(ctx, req.(*obsgrpc.SetSourceFilterEnabledRequest))
+	},
+}, {
+	RequestType: "GetVersion",
+	Category:    "general",
+	Description: "Gets data about the current plugin and RPC version.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetVersionRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetVersion(ctx, req.(*obsgrpc.GetVersionRequest))
+	},
+}, {
+	RequestType: "GetStats",
+	Category:    "general",
+	Description: "Gets statistics about OBS, obs-websocket, and the current session.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetStatsRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetStats(ctx, req.(*obsgrpc.GetStatsRequest))
+	},
+}, {
+	RequestType: "BroadcastCustomEvent",
+	Category:    "general",
+	Description: "Broadcasts a `CustomEvent` to all WebSocket clients. Receivers are clients which are identified and subscribed.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.BroadcastCustomEventRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.BroadcastCustomEvent(ctx, req.(*obsgrpc.BroadcastCustomEventRequest))
+	},
+}, {
+	RequestType: "CallVendorRequest",
+	Category:    "general",
+	Description: "Call a request registered to a vendor.\n\nA vendor is a unique name registered by a third-party plugin or script, which allows for custom requests and events to be added to obs-websocket.\nIf a plugin or script implements vendor requests or events, documentation is expected to be provided with them.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.CallVendorRequestRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.CallVendorRequest(ctx, req.(*obsgrpc.CallVendorRequestRequest))
+	},
+}, {
+	RequestType: "GetHotkeyList",
+	Category:    "general",
+	Description: "Gets an array of all hotkey names in OBS.\n\nNote: Hotkey functionality in obs-websocket comes as-is, and we do not guarantee support if things are broken. In 9/10 usages of hotkey requests, there exists a better, more reliable method via other requests.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetHotkeyListRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetHotkeyList(ctx, req.(*obsgrpc.GetHotkeyListRequest))
+	},
+}, {
+	RequestType: "TriggerHotkeyByName",
+	Category:    "general",
+	Description: "Triggers a hotkey using its name. See `GetHotkeyList`.\n\nNote: Hotkey functionality in obs-websocket comes as-is, and we do not guarantee support if things are broken. In 9/10 usages of hotkey requests, there exists a better, more reliable method via other requests.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.TriggerHotkeyByNameRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.TriggerHotkeyByName(ctx, req.(*obsgrpc.TriggerHotkeyByNameRequest))
+	},
+}, {
+	RequestType: "TriggerHotkeyByKeySequence",
+	Category:    "general",
+	Description: "Triggers a hotkey using a sequence of keys.\n\nNote: Hotkey functionality in obs-websocket comes as-is, and we do not guarantee support if things are broken. In 9/10 usages of hotkey requests, there exists a better, more reliable method via other requests.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.TriggerHotkeyByKeySequenceRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.TriggerHotkeyByKeySequence(ctx, req.(*obsgrpc.TriggerHotkeyByKeySequenceRequest))
+	},
+}, {
+	RequestType: "Sleep",
+	Category:    "general",
+	Description: "Sleeps for a time duration or number of frames. Only available in request batches with types `SERIAL_REALTIME` or `SERIAL_FRAME`.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SleepRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.Sleep(ctx, req.(*obsgrpc.SleepRequest))
+	},
+}, {
+	RequestType: "GetInputList",
+	Category:    "inputs",
+	Description: "Gets an array of all inputs in OBS.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetInputListRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetInputList(ctx, req.(*obsgrpc.GetInputListRequest))
+	},
+}, {
+	RequestType: "GetInputKindList",
+	Category:    "inputs",
+	Description: "Gets an array of all available input kinds in OBS.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetInputKindListRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetInputKindList(ctx, req.(*obsgrpc.GetInputKindListRequest))
+	},
+}, {
+	RequestType: "GetSpecialInputs",
+	Category:    "inputs",
+	Description: "Gets the names of all special inputs.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSpecialInputsRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSpecialInputs(ctx, req.(*obsgrpc.GetSpecialInputsRequest))
+	},
+}, {
+	RequestType: "CreateInput",
+	Category:    "inputs",
+	Description: "Creates a new input, adding it as a scene item to the specified scene.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.CreateInputRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.CreateInput(ctx, req.(*obsgrpc.CreateInputRequest))
+	},
+}, {
+	RequestType: "RemoveInput",
+	Category:    "inputs",
+	Description: "Removes an existing input.\n\nNote: Will immediately remove all associated scene items.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.RemoveInputRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.RemoveInput(ctx, req.(*obsgrpc.RemoveInputRequest))
+	},
+}, {
+	RequestType: "SetInputName",
+	Category:    "inputs",
+	Description: "Sets the name of an input (rename).",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetInputNameRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetInputName(ctx, req.(*obsgrpc.SetInputNameRequest))
+	},
+}, {
+	RequestType: "GetInputDefaultSettings",
+	Category:    "inputs",
+	Description: "Gets the default settings for an input kind.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetInputDefaultSettingsRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetInputDefaultSettings(ctx, req.(*obsgrpc.GetInputDefaultSettingsRequest))
+	},
+}, {
+	RequestType: "GetInputSettings",
+	Category:    "inputs",
+	Description: "Gets the settings of an input.\n\nNote: Does not include defaults. To create the entire settings object, overlay `inputSettings` over the `defaultInputSettings` provided by `GetInputDefaultSettings`.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetInputSettingsRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetInputSettings(ctx, req.(*obsgrpc.GetInputSettingsRequest))
+	},
+}, {
+	RequestType: "SetInputSettings",
+	Category:    "inputs",
+	Description: "Sets the settings of an input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetInputSettingsRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetInputSettings(ctx, req.(*obsgrpc.SetInputSettingsRequest))
+	},
+}, {
+	RequestType: "GetInputMute",
+	Category:    "inputs",
+	Description: "Gets the audio mute state of an input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetInputMuteRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetInputMute(ctx, req.(*obsgrpc.GetInputMuteRequest))
+	},
+}, {
+	RequestType: "SetInputMute",
+	Category:    "inputs",
+	Description: "Sets the audio mute state of an input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetInputMuteRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetInputMute(ctx, req.(*obsgrpc.SetInputMuteRequest))
+	},
+}, {
+	RequestType: "ToggleInputMute",
+	Category:    "inputs",
+	Description: "Toggles the audio mute state of an input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.ToggleInputMuteRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.ToggleInputMute(ctx, req.(*obsgrpc.ToggleInputMuteRequest))
+	},
+}, {
+	RequestType: "GetInputVolume",
+	Category:    "inputs",
+	Description: "Gets the current volume setting of an input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetInputVolumeRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetInputVolume(ctx, req.(*obsgrpc.GetInputVolumeRequest))
+	},
+}, {
+	RequestType: "SetInputVolume",
+	Category:    "inputs",
+	Description: "Sets the volume setting of an input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetInputVolumeRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetInputVolume(ctx, req.(*obsgrpc.SetInputVolumeRequest))
+	},
+}, {
+	RequestType: "GetInputAudioBalance",
+	Category:    "inputs",
+	Description: "Gets the audio balance of an input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetInputAudioBalanceRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetInputAudioBalance(ctx, req.(*obsgrpc.GetInputAudioBalanceRequest))
+	},
+}, {
+	RequestType: "SetInputAudioBalance",
+	Category:    "inputs",
+	Description: "Sets the audio balance of an input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetInputAudioBalanceRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetInputAudioBalance(ctx, req.(*obsgrpc.SetInputAudioBalanceRequest))
+	},
+}, {
+	RequestType: "GetInputAudioSyncOffset",
+	Category:    "inputs",
+	Description: "Gets the audio sync offset of an input.\n\nNote: The audio sync offset can be negative too!",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetInputAudioSyncOffsetRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetInputAudioSyncOffset(ctx, req.(*obsgrpc.GetInputAudioSyncOffsetRequest))
+	},
+}, {
+	RequestType: "SetInputAudioSyncOffset",
+	Category:    "inputs",
+	Description: "Sets the audio sync offset of an input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetInputAudioSyncOffsetRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetInputAudioSyncOffset(ctx, req.(*obsgrpc.SetInputAudioSyncOffsetRequest))
+	},
+}, {
+	RequestType: "GetInputAudioMonitorType",
+	Category:    "inputs",
+	Description: "Gets the audio monitor type of an input.\n\nThe available audio monitor types are:\n\n- `OBS_MONITORING_TYPE_NONE`\n- `OBS_MONITORING_TYPE_MONITOR_ONLY`\n- `OBS_MONITORING_TYPE_MONITOR_AND_OUTPUT`",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetInputAudioMonitorTypeRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetInputAudioMonitorType(ctx, req.(*obsgrpc.GetInputAudioMonitorTypeRequest))
+	},
+}, {
+	RequestType: "SetInputAudioMonitorType",
+	Category:    "inputs",
+	Description: "Sets the audio monitor type of an input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetInputAudioMonitorTypeRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetInputAudioMonitorType(ctx, req.(*obsgrpc.SetInputAudioMonitorTypeRequest))
+	},
+}, {
+	RequestType: "GetInputAudioTracks",
+	Category:    "inputs",
+	Description: "Gets the enable state of all audio tracks of an input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetInputAudioTracksRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetInputAudioTracks(ctx, req.(*obsgrpc.GetInputAudioTracksRequest))
+	},
+}, {
+	RequestType: "SetInputAudioTracks",
+	Category:    "inputs",
+	Description: "Sets the enable state of audio tracks of an input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetInputAudioTracksRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetInputAudioTracks(ctx, req.(*obsgrpc.SetInputAudioTracksRequest))
+	},
+}, {
+	RequestType: "GetInputPropertiesListPropertyItems",
+	Category:    "inputs",
+	Description: "Gets the items of a list property from an input's properties.\n\nNote: Use this in cases where an input provides a dynamic, selectable list of items. For example, display capture, where it provides a list of available displays.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetInputPropertiesListPropertyItemsRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetInputPropertiesListPropertyItems(ctx, req.(*obsgrpc.GetInputPropertiesListPropertyItemsRequest))
+	},
+}, {
+	RequestType: "PressInputPropertiesButton",
+	Category:    "inputs",
+	Description: "Presses a button in the properties of an input.\n\nSome known `propertyName` values are:\n\n- `refreshnocache` - Browser source reload button\n\nNote: Use this in cases where there is a button in the properties of an input that cannot be accessed in any other way. For example, browser sources, where there is a refresh button.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.PressInputPropertiesButtonRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.PressInputPropertiesButton(ctx, req.(*obsgrpc.PressInputPropertiesButtonRequest))
+	},
+}, {
+	RequestType: "GetMediaInputStatus",
+	Category:    "media inputs",
+	Description: "Gets the status of a media input.\n\nMedia States:\n\n- `OBS_MEDIA_STATE_NONE`\n- `OBS_MEDIA_STATE_PLAYING`\n- `OBS_MEDIA_STATE_OPENING`\n- `OBS_MEDIA_STATE_BUFFERING`\n- `OBS_MEDIA_STATE_PAUSED`\n- `OBS_MEDIA_STATE_STOPPED`\n- `OBS_MEDIA_STATE_ENDED`\n- `OBS_MEDIA_STATE_ERROR`",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetMediaInputStatusRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetMediaInputStatus(ctx, req.(*obsgrpc.GetMediaInputStatusRequest))
+	},
+}, {
+	RequestType: "SetMediaInputCursor",
+	Category:    "media inputs",
+	Description: "Sets the cursor position of a media input.\n\nThis request does not perform bounds checking of the cursor position.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetMediaInputCursorRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetMediaInputCursor(ctx, req.(*obsgrpc.SetMediaInputCursorRequest))
+	},
+}, {
+	RequestType: "OffsetMediaInputCursor",
+	Category:    "media inputs",
+	Description: "Offsets the current cursor position of a media input by the specified value.\n\nThis request does not perform bounds checking of the cursor position.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.OffsetMediaInputCursorRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.OffsetMediaInputCursor(ctx, req.(*obsgrpc.OffsetMediaInputCursorRequest))
+	},
+}, {
+	RequestType: "TriggerMediaInputAction",
+	Category:    "media inputs",
+	Description: "Triggers an action on a media input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.TriggerMediaInputActionRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.TriggerMediaInputAction(ctx, req.(*obsgrpc.TriggerMediaInputActionRequest))
+	},
+}, {
+	RequestType: "GetVirtualCamStatus",
+	Category:    "outputs",
+	Description: "Gets the status of the virtualcam output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetVirtualCamStatusRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetVirtualCamStatus(ctx, req.(*obsgrpc.GetVirtualCamStatusRequest))
+	},
+}, {
+	RequestType: "ToggleVirtualCam",
+	Category:    "outputs",
+	Description: "Toggles the state of the virtualcam output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.ToggleVirtualCamRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.ToggleVirtualCam(ctx, req.(*obsgrpc.ToggleVirtualCamRequest))
+	},
+}, {
+	RequestType: "StartVirtualCam",
+	Category:    "outputs",
+	Description: "Starts the virtualcam output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.StartVirtualCamRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.StartVirtualCam(ctx, req.(*obsgrpc.StartVirtualCamRequest))
+	},
+}, {
+	RequestType: "StopVirtualCam",
+	Category:    "outputs",
+	Description: "Stops the virtualcam output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.StopVirtualCamRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.StopVirtualCam(ctx, req.(*obsgrpc.StopVirtualCamRequest))
+	},
+}, {
+	RequestType: "GetReplayBufferStatus",
+	Category:    "outputs",
+	Description: "Gets the status of the replay buffer output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetReplayBufferStatusRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetReplayBufferStatus(ctx, req.(*obsgrpc.GetReplayBufferStatusRequest))
+	},
+}, {
+	RequestType: "ToggleReplayBuffer",
+	Category:    "outputs",
+	Description: "Toggles the state of the replay buffer output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.ToggleReplayBufferRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.ToggleReplayBuffer(ctx, req.(*obsgrpc.ToggleReplayBufferRequest))
+	},
+}, {
+	RequestType: "StartReplayBuffer",
+	Category:    "outputs",
+	Description: "Starts the replay buffer output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.StartReplayBufferRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.StartReplayBuffer(ctx, req.(*obsgrpc.StartReplayBufferRequest))
+	},
+}, {
+	RequestType: "StopReplayBuffer",
+	Category:    "outputs",
+	Description: "Stops the replay buffer output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.StopReplayBufferRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.StopReplayBuffer(ctx, req.(*obsgrpc.StopReplayBufferRequest))
+	},
+}, {
+	RequestType: "SaveReplayBuffer",
+	Category:    "outputs",
+	Description: "Saves the contents of the replay buffer output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SaveReplayBufferRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SaveReplayBuffer(ctx, req.(*obsgrpc.SaveReplayBufferRequest))
+	},
+}, {
+	RequestType: "GetLastReplayBufferReplay",
+	Category:    "outputs",
+	Description: "Gets the filename of the last replay buffer save file.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetLastReplayBufferReplayRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetLastReplayBufferReplay(ctx, req.(*obsgrpc.GetLastReplayBufferReplayRequest))
+	},
+}, {
+	RequestType: "GetOutputList",
+	Category:    "outputs",
+	Description: "Gets the list of available outputs.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetOutputListRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetOutputList(ctx, req.(*obsgrpc.GetOutputListRequest))
+	},
+}, {
+	RequestType: "GetOutputStatus",
+	Category:    "outputs",
+	Description: "Gets the status of an output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetOutputStatusRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetOutputStatus(ctx, req.(*obsgrpc.GetOutputStatusRequest))
+	},
+}, {
+	RequestType: "ToggleOutput",
+	Category:    "outputs",
+	Description: "Toggles the status of an output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.ToggleOutputRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.ToggleOutput(ctx, req.(*obsgrpc.ToggleOutputRequest))
+	},
+}, {
+	RequestType: "StartOutput",
+	Category:    "outputs",
+	Description: "Starts an output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.StartOutputRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.StartOutput(ctx, req.(*obsgrpc.StartOutputRequest))
+	},
+}, {
+	RequestType: "StopOutput",
+	Category:    "outputs",
+	Description: "Stops an output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.StopOutputRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.StopOutput(ctx, req.(*obsgrpc.StopOutputRequest))
+	},
+}, {
+	RequestType: "GetOutputSettings",
+	Category:    "outputs",
+	Description: "Gets the settings of an output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetOutputSettingsRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetOutputSettings(ctx, req.(*obsgrpc.GetOutputSettingsRequest))
+	},
+}, {
+	RequestType: "SetOutputSettings",
+	Category:    "outputs",
+	Description: "Sets the settings of an output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetOutputSettingsRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetOutputSettings(ctx, req.(*obsgrpc.SetOutputSettingsRequest))
+	},
+}, {
+	RequestType: "GetRecordStatus",
+	Category:    "record",
+	Description: "Gets the status of the record output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetRecordStatusRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetRecordStatus(ctx, req.(*obsgrpc.GetRecordStatusRequest))
+	},
+}, {
+	RequestType: "ToggleRecord",
+	Category:    "record",
+	Description: "Toggles the status of the record output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.ToggleRecordRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.ToggleRecord(ctx, req.(*obsgrpc.ToggleRecordRequest))
+	},
+}, {
+	RequestType: "StartRecord",
+	Category:    "record",
+	Description: "Starts the record output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.StartRecordRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.StartRecord(ctx, req.(*obsgrpc.StartRecordRequest))
+	},
+}, {
+	RequestType: "StopRecord",
+	Category:    "record",
+	Description: "Stops the record output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.StopRecordRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.StopRecord(ctx, req.(*obsgrpc.StopRecordRequest))
+	},
+}, {
+	RequestType: "ToggleRecordPause",
+	Category:    "record",
+	Description: "Toggles pause on the record output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.ToggleRecordPauseRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.ToggleRecordPause(ctx, req.(*obsgrpc.ToggleRecordPauseRequest))
+	},
+}, {
+	RequestType: "PauseRecord",
+	Category:    "record",
+	Description: "Pauses the record output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.PauseRecordRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.PauseRecord(ctx, req.(*obsgrpc.PauseRecordRequest))
+	},
+}, {
+	RequestType: "ResumeRecord",
+	Category:    "record",
+	Description: "Resumes the record output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.ResumeRecordRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.ResumeRecord(ctx, req.(*obsgrpc.ResumeRecordRequest))
+	},
+}, {
+	RequestType: "SplitRecordFile",
+	Category:    "record",
+	Description: "Splits the current file being recorded into a new file.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SplitRecordFileRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SplitRecordFile(ctx, req.(*obsgrpc.SplitRecordFileRequest))
+	},
+}, {
+	RequestType: "CreateRecordChapter",
+	Category:    "record",
+	Description: "Adds a new chapter marker to the file currently being recorded.\n\nNote: As of OBS 30.2.0, the only file format supporting this feature is Hybrid MP4.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.CreateRecordChapterRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.CreateRecordChapter(ctx, req.(*obsgrpc.CreateRecordChapterRequest))
+	},
+}, {
+	RequestType: "GetSceneItemList",
+	Category:    "scene items",
+	Description: "Gets a list of all scene items in a scene.\n\nScenes only",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSceneItemListRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSceneItemList(ctx, req.(*obsgrpc.GetSceneItemListRequest))
+	},
+}, {
+	RequestType: "GetGroupSceneItemList",
+	Category:    "scene items",
+	Description: "Basically GetSceneItemList, but for groups.\n\nUsing groups at all in OBS is discouraged, as they are very broken under the hood. Please use nested scenes instead.\n\nGroups only",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetGroupSceneItemListRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetGroupSceneItemList(ctx, req.(*obsgrpc.GetGroupSceneItemListRequest))
+	},
+}, {
+	RequestType: "GetSceneItemId",
+	Category:    "scene items",
+	Description: "Searches a scene for a source, and returns its id.\n\nScenes and Groups",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSceneItemIdRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSceneItemId(ctx, req.(*obsgrpc.GetSceneItemIdRequest))
+	},
+}, {
+	RequestType: "GetSceneItemSource",
+	Category:    "scene items",
+	Description: "Gets the source associated with a scene item.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSceneItemSourceRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSceneItemSource(ctx, req.(*obsgrpc.GetSceneItemSourceRequest))
+	},
+}, {
+	RequestType: "CreateSceneItem",
+	Category:    "scene items",
+	Description: "Creates a new scene item using a source.\n\nScenes only",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.CreateSceneItemRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.CreateSceneItem(ctx, req.(*obsgrpc.CreateSceneItemRequest))
+	},
+}, {
+	RequestType: "RemoveSceneItem",
+	Category:    "scene items",
+	Description: "Removes a scene item from a scene.\n\nScenes only",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.RemoveSceneItemRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.RemoveSceneItem(ctx, req.(*obsgrpc.RemoveSceneItemRequest))
+	},
+}, {
+	RequestType: "DuplicateSceneItem",
+	Category:    "scene items",
+	Description: "Duplicates a scene item, copying all transform and crop info.\n\nScenes only",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.DuplicateSceneItemRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.DuplicateSceneItem(ctx, req.(*obsgrpc.DuplicateSceneItemRequest))
+	},
+}, {
+	RequestType: "GetSceneItemTransform",
+	Category:    "scene items",
+	Description: "Gets the transform and crop info of a scene item.\n\nScenes and Groups",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSceneItemTransformRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSceneItemTransform(ctx, req.(*obsgrpc.GetSceneItemTransformRequest))
+	},
+}, {
+	RequestType: "SetSceneItemTransform",
+	Category:    "scene items",
+	Description: "Sets the transform and crop info of a scene item.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetSceneItemTransformRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetSceneItemTransform(ctx, req.(*obsgrpc.SetSceneItemTransformRequest))
+	},
+}, {
+	RequestType: "GetSceneItemEnabled",
+	Category:    "scene items",
+	Description: "Gets the enable state of a scene item.\n\nScenes and Groups",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSceneItemEnabledRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSceneItemEnabled(ctx, req.(*obsgrpc.GetSceneItemEnabledRequest))
+	},
+}, {
+	RequestType: "SetSceneItemEnabled",
+	Category:    "scene items",
+	Description: "Sets the enable state of a scene item.\n\nScenes and Groups",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetSceneItemEnabledRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetSceneItemEnabled(ctx, req.(*obsgrpc.SetSceneItemEnabledRequest))
+	},
+}, {
+	RequestType: "GetSceneItemLocked",
+	Category:    "scene items",
+	Description: "Gets the lock state of a scene item.\n\nScenes and Groups",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSceneItemLockedRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSceneItemLocked(ctx, req.(*obsgrpc.GetSceneItemLockedRequest))
+	},
+}, {
+	RequestType: "SetSceneItemLocked",
+	Category:    "scene items",
+	Description: "Sets the lock state of a scene item.\n\nScenes and Group",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetSceneItemLockedRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetSceneItemLocked(ctx, req.(*obsgrpc.SetSceneItemLockedRequest))
+	},
+}, {
+	RequestType: "GetSceneItemIndex",
+	Category:    "scene items",
+	Description: "Gets the index position of a scene item in a scene.\n\nAn index of 0 is at the bottom of the source list in the UI.\n\nScenes and Groups",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSceneItemIndexRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSceneItemIndex(ctx, req.(*obsgrpc.GetSceneItemIndexRequest))
+	},
+}, {
+	RequestType: "SetSceneItemIndex",
+	Category:    "scene items",
+	Description: "Sets the index position of a scene item in a scene.\n\nScenes and Groups",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetSceneItemIndexRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetSceneItemIndex(ctx, req.(*obsgrpc.SetSceneItemIndexRequest))
+	},
+}, {
+	RequestType: "GetSceneItemBlendMode",
+	Category:    "scene items",
+	Description: "Gets the blend mode of a scene item.\n\nBlend modes:\n\n- `OBS_BLEND_NORMAL`\n- `OBS_BLEND_ADDITIVE`\n- `OBS_BLEND_SUBTRACT`\n- `OBS_BLEND_SCREEN`\n- `OBS_BLEND_MULTIPLY`\n- `OBS_BLEND_LIGHTEN`\n- `OBS_BLEND_DARKEN`\n\nScenes and Groups",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSceneItemBlendModeRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSceneItemBlendMode(ctx, req.(*obsgrpc.GetSceneItemBlendModeRequest))
+	},
+}, {
+	RequestType: "SetSceneItemBlendMode",
+	Category:    "scene items",
+	Description: "Sets the blend mode of a scene item.\n\nScenes and Groups",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetSceneItemBlendModeRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetSceneItemBlendMode(ctx, req.(*obsgrpc.SetSceneItemBlendModeRequest))
+	},
+}, {
+	RequestType: "GetSceneList",
+	Category:    "scenes",
+	Description: "Gets an array of all scenes in OBS.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSceneListRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSceneList(ctx, req.(*obsgrpc.GetSceneListRequest))
+	},
+}, {
+	RequestType: "GetGroupList",
+	Category:    "scenes",
+	Description: "Gets an array of all groups in OBS.\n\nGroups in OBS are actually scenes, but renamed and modified. In obs-websocket, we treat them as scenes where we can.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetGroupListRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetGroupList(ctx, req.(*obsgrpc.GetGroupListRequest))
+	},
+}, {
+	RequestType: "GetCurrentProgramScene",
+	Category:    "scenes",
+	Description: "Gets the current program scene.\n\nNote: This request is slated to have the `currentProgram`-prefixed fields removed from in an upcoming RPC version.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetCurrentProgramSceneRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetCurrentProgramScene(ctx, req.(*obsgrpc.GetCurrentProgramSceneRequest))
+	},
+}, {
+	RequestType: "SetCurrentProgramScene",
+	Category:    "scenes",
+	Description: "Sets the current program scene.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetCurrentProgramSceneRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetCurrentProgramScene(ctx, req.(*obsgrpc.SetCurrentProgramSceneRequest))
+	},
+}, {
+	RequestType: "GetCurrentPreviewScene",
+	Category:    "scenes",
+	Description: "Gets the current preview scene.\n\nOnly available when studio mode is enabled.\n\nNote: This request is slated to have the `currentPreview`-prefixed fields removed from in an upcoming RPC version.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetCurrentPreviewSceneRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetCurrentPreviewScene(ctx, req.(*obsgrpc.GetCurrentPreviewSceneRequest))
+	},
+}, {
+	RequestType: "SetCurrentPreviewScene",
+	Category:    "scenes",
+	Description: "Sets the current preview scene.\n\nOnly available when studio mode is enabled.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetCurrentPreviewSceneRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetCurrentPreviewScene(ctx, req.(*obsgrpc.SetCurrentPreviewSceneRequest))
+	},
+}, {
+	RequestType: "CreateScene",
+	Category:    "scenes",
+	Description: "Creates a new scene in OBS.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.CreateSceneRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.CreateScene(ctx, req.(*obsgrpc.CreateSceneRequest))
+	},
+}, {
+	RequestType: "RemoveScene",
+	Category:    "scenes",
+	Description: "Removes a scene from OBS.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.RemoveSceneRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.RemoveScene(ctx, req.(*obsgrpc.RemoveSceneRequest))
+	},
+}, {
+	RequestType: "SetSceneName",
+	Category:    "scenes",
+	Description: "Sets the name of a scene (rename).",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetSceneNameRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetSceneName(ctx, req.(*obsgrpc.SetSceneNameRequest))
+	},
+}, {
+	RequestType: "GetSceneSceneTransitionOverride",
+	Category:    "scenes",
+	Description: "Gets the scene transition overridden for a scene.\n\nNote: A transition UUID response field is not currently able to be implemented as of 2024-1-18.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSceneSceneTransitionOverrideRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSceneSceneTransitionOverride(ctx, req.(*obsgrpc.GetSceneSceneTransitionOverrideRequest))
+	},
+}, {
+	RequestType: "SetSceneSceneTransitionOverride",
+	Category:    "scenes",
+	Description: "Sets the scene transition overridden for a scene.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetSceneSceneTransitionOverrideRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetSceneSceneTransitionOverride(ctx, req.(*obsgrpc.SetSceneSceneTransitionOverrideRequest))
+	},
+}, {
+	RequestType: "GetSourceActive",
+	Category:    "sources",
+	Description: "Gets the active and show state of a source.\n\n**Compatible with inputs and scenes.**",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSourceActiveRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSourceActive(ctx, req.(*obsgrpc.GetSourceActiveRequest))
+	},
+}, {
+	RequestType: "GetSourceScreenshot",
+	Category:    "sources",
+	Description: "Gets a Base64-encoded screenshot of a source.\n\nThe `imageWidth` and `imageHeight` parameters are treated as \"scale to inner\", meaning the smallest ratio will be used and the aspect ratio of the original resolution is kept.\nIf `imageWidth` and `imageHeight` are not specified, the compressed image will use the full resolution of the source.\n\n**Compatible with inputs and scenes.**",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSourceScreenshotRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSourceScreenshot(ctx, req.(*obsgrpc.GetSourceScreenshotRequest))
+	},
+}, {
+	RequestType: "SaveSourceScreenshot",
+	Category:    "sources",
+	Description: "Saves a screenshot of a source to the filesystem.\n\nThe `imageWidth` and `imageHeight` parameters are treated as \"scale to inner\", meaning the smallest ratio will be used and the aspect ratio of the original resolution is kept.\nIf `imageWidth` and `imageHeight` are not specified, the compressed image will use the full resolution of the source.\n\n**Compatible with inputs and scenes.**",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SaveSourceScreenshotRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SaveSourceScreenshot(ctx, req.(*obsgrpc.SaveSourceScreenshotRequest))
+	},
+}, {
+	RequestType: "GetStreamStatus",
+	Category:    "stream",
+	Description: "Gets the status of the stream output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetStreamStatusRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetStreamStatus(ctx, req.(*obsgrpc.GetStreamStatusRequest))
+	},
+}, {
+	RequestType: "ToggleStream",
+	Category:    "stream",
+	Description: "Toggles the status of the stream output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.ToggleStreamRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.ToggleStream(ctx, req.(*obsgrpc.ToggleStreamRequest))
+	},
+}, {
+	RequestType: "StartStream",
+	Category:    "stream",
+	Description: "Starts the stream output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.StartStreamRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.StartStream(ctx, req.(*obsgrpc.StartStreamRequest))
+	},
+}, {
+	RequestType: "StopStream",
+	Category:    "stream",
+	Description: "Stops the stream output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.StopStreamRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.StopStream(ctx, req.(*obsgrpc.StopStreamRequest))
+	},
+}, {
+	RequestType: "SendStreamCaption",
+	Category:    "stream",
+	Description: "Sends CEA-608 caption text over the stream output.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SendStreamCaptionRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SendStreamCaption(ctx, req.(*obsgrpc.SendStreamCaptionRequest))
+	},
+}, {
+	RequestType: "GetTransitionKindList",
+	Category:    "transitions",
+	Description: "Gets an array of all available transition kinds.\n\nSimilar to `GetInputKindList`",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetTransitionKindListRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetTransitionKindList(ctx, req.(*obsgrpc.GetTransitionKindListRequest))
+	},
+}, {
+	RequestType: "GetSceneTransitionList",
+	Category:    "transitions",
+	Description: "Gets an array of all scene transitions in OBS.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetSceneTransitionListRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetSceneTransitionList(ctx, req.(*obsgrpc.GetSceneTransitionListRequest))
+	},
+}, {
+	RequestType: "GetCurrentSceneTransition",
+	Category:    "transitions",
+	Description: "Gets information about the current scene transition.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetCurrentSceneTransitionRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetCurrentSceneTransition(ctx, req.(*obsgrpc.GetCurrentSceneTransitionRequest))
+	},
+}, {
+	RequestType: "SetCurrentSceneTransition",
+	Category:    "transitions",
+	Description: "Sets the current scene transition.\n\nSmall note: While the namespace of scene transitions is generally unique, that uniqueness is not a guarantee as it is with other resources like inputs.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetCurrentSceneTransitionRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetCurrentSceneTransition(ctx, req.(*obsgrpc.SetCurrentSceneTransitionRequest))
+	},
+}, {
+	RequestType: "SetCurrentSceneTransitionDuration",
+	Category:    "transitions",
+	Description: "Sets the duration of the current scene transition, if it is not fixed.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetCurrentSceneTransitionDurationRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetCurrentSceneTransitionDuration(ctx, req.(*obsgrpc.SetCurrentSceneTransitionDurationRequest))
+	},
+}, {
+	RequestType: "SetCurrentSceneTransitionSettings",
+	Category:    "transitions",
+	Description: "Sets the settings of the current scene transition.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetCurrentSceneTransitionSettingsRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetCurrentSceneTransitionSettings(ctx, req.(*obsgrpc.SetCurrentSceneTransitionSettingsRequest))
+	},
+}, {
+	RequestType: "GetCurrentSceneTransitionCursor",
+	Category:    "transitions",
+	Description: "Gets the cursor position of the current scene transition.\n\nNote: `transitionCursor` will return 1.0 when the transition is inactive.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetCurrentSceneTransitionCursorRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetCurrentSceneTransitionCursor(ctx, req.(*obsgrpc.GetCurrentSceneTransitionCursorRequest))
+	},
+}, {
+	RequestType: "TriggerStudioModeTransition",
+	Category:    "transitions",
+	Description: "Triggers the current scene transition. Same functionality as the `Transition` button in studio mode.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.TriggerStudioModeTransitionRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.TriggerStudioModeTransition(ctx, req.(*obsgrpc.TriggerStudioModeTransitionRequest))
+	},
+}, {
+	RequestType: "SetTBarPosition",
+	Category:    "transitions",
+	Description: "Sets the position of the TBar.\n\n**Very important note**: This will be deprecated and replaced in a future version of obs-websocket.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetTBarPositionRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetTBarPosition(ctx, req.(*obsgrpc.SetTBarPositionRequest))
+	},
+}, {
+	RequestType: "GetStudioModeEnabled",
+	Category:    "ui",
+	Description: "Gets whether studio is enabled.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetStudioModeEnabledRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetStudioModeEnabled(ctx, req.(*obsgrpc.GetStudioModeEnabledRequest))
+	},
+}, {
+	RequestType: "SetStudioModeEnabled",
+	Category:    "ui",
+	Description: "Enables or disables studio mode",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.SetStudioModeEnabledRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.SetStudioModeEnabled(ctx, req.(*obsgrpc.SetStudioModeEnabledRequest))
+	},
+}, {
+	RequestType: "OpenInputPropertiesDialog",
+	Category:    "ui",
+	Description: "Opens the properties dialog of an input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.OpenInputPropertiesDialogRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.OpenInputPropertiesDialog(ctx, req.(*obsgrpc.OpenInputPropertiesDialogRequest))
+	},
+}, {
+	RequestType: "OpenInputFiltersDialog",
+	Category:    "ui",
+	Description: "Opens the filters dialog of an input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.OpenInputFiltersDialogRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.OpenInputFiltersDialog(ctx, req.(*obsgrpc.OpenInputFiltersDialogRequest))
+	},
+}, {
+	RequestType: "OpenInputInteractDialog",
+	Category:    "ui",
+	Description: "Opens the interact dialog of an input.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.OpenInputInteractDialogRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.OpenInputInteractDialog(ctx, req.(*obsgrpc.OpenInputInteractDialogRequest))
+	},
+}, {
+	RequestType: "GetMonitorList",
+	Category:    "ui",
+	Description: "Gets a list of connected monitors and information about them.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.GetMonitorListRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.GetMonitorList(ctx, req.(*obsgrpc.GetMonitorListRequest))
+	},
+}, {
+	RequestType: "OpenVideoMixProjector",
+	Category:    "ui",
+	Description: "Opens a projector for a specific output video mix.\n\nMix types:\n\n- `OBS_WEBSOCKET_VIDEO_MIX_TYPE_PREVIEW`\n- `OBS_WEBSOCKET_VIDEO_MIX_TYPE_PROGRAM`\n- `OBS_WEBSOCKET_VIDEO_MIX_TYPE_MULTIVIEW`\n\nNote: This request serves to provide feature parity with 4.x. It is very likely to be changed/deprecated in a future release.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.OpenVideoMixProjectorRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.OpenVideoMixProjector(ctx, req.(*obsgrpc.OpenVideoMixProjectorRequest))
+	},
+}, {
+	RequestType: "OpenSourceProjector",
+	Category:    "ui",
+	Description: "Opens a projector for a source.\n\nNote: This request serves to provide feature parity with 4.x. It is very likely to be changed/deprecated in a future release.",
+	NewRequest: func() proto.Message {
+		return &obsgrpc.OpenSourceProjectorRequest{}
+	},
+	Call: func(ctx context.Context, client obsgrpc.OBSClient, req proto.Message) (proto.Message, error) {
+		return client.OpenSourceProjector(ctx, req.(*obsgrpc.OpenSourceProjectorRequest))
+	},
+}}
@@ -0,0 +1,150 @@
+package obsrestgateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andreykaipov/goobs/api/events"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsgrpcproxy"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type fakeServer struct {
+	obs_grpc.UnimplementedOBSServer
+	Events []any
+}
+
+func (srv *fakeServer) GetInputMute(
+	ctx context.Context,
+	req *obs_grpc.GetInputMuteRequest,
+) (*obs_grpc.GetInputMuteResponse, error) {
+	if req.GetInputName() != "Mic" {
+		return nil, fmt.Errorf("request GetInputMute: ResourceNotFound (600): No source was found by the name of `%s`.", req.GetInputName())
+	}
+	return &obs_grpc.GetInputMuteResponse{InputMuted: true}, nil
+}
+
+func (srv *fakeServer) SubscribeToEvents(
+	req *obs_grpc.SubscribeToEventsRequest,
+	stream obs_grpc.OBS_SubscribeToEventsServer,
+) error {
+	for idx, ev := range srv.Events {
+		envelope, err := obsgrpcproxy.EventGo2Protobuf(ev)
+		if err != nil {
+			return err
+		}
+		envelope.SeqNo = uint64(idx + 1)
+		if envelope.SeqNo <= req.GetAfterSeqNo() {
+			continue
+		}
+		if err := stream.Send(envelope); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTestGateway(t *testing.T, srv obs_grpc.OBSServer) *httptest.Server {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	obs_grpc.RegisterOBSServer(grpcServer, srv)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	httpServer := httptest.NewServer(New(obs_grpc.NewOBSClient(conn)))
+	t.Cleanup(httpServer.Close)
+	return httpServer
+}
+
+func TestGateway(t *testing.T) {
+	httpServer := newTestGateway(t, &fakeServer{
+		Events: []any{
+			&events.CurrentProgramSceneChanged{SceneName: "Intro"},
+			&events.InputMuteStateChanged{InputName: "Mic", InputMuted: true},
+			&events.CurrentProgramSceneChanged{SceneName: "Live"},
+		},
+	})
+
+	post := func(path, body string) (int, string) {
+		resp, err := http.Post(httpServer.URL+path, "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(b)
+	}
+
+	code, body := post("/v1/GetInputMute", `{"inputName": "Mic"}`)
+	require.Equal(t, http.StatusOK, code)
+	require.JSONEq(t, `{"inputMuted": true}`, body)
+
+	code, body = post("/v1/GetInputMute", `{"inputName": "Speakers"}`)
+	require.Equal(t, http.StatusNotFound, code)
+	var errResp ErrorResponse
+	require.NoError(t, json.Unmarshal([]byte(body), &errResp))
+	require.Equal(t, "ResourceNotFound", errResp.RequestStatus)
+	require.Equal(t, 600, errResp.RequestStatusCode)
+
+	code, _ = post("/v1/GetInputMute", `{"inputName": 1}`)
+	require.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = post("/v1/GetVersion", ``)
+	require.Equal(t, http.StatusNotImplemented, code)
+
+	req, err := http.NewRequest(http.MethodGet, httpServer.URL+"/v1/events?category=scenes", nil)
+	require.NoError(t, err)
+	req.Header.Set("Last-Event-ID", "1")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, 1, strings.Count(string(b), "id: "))
+	require.Contains(t, string(b), "id: 3\nevent: CurrentProgramSceneChanged\ndata: ")
+	require.Contains(t, string(b), `"sceneName":"Live"`)
+}
+
+func TestOpenAPI(t *testing.T) {
+	b, err := OpenAPI()
+	require.NoError(t, err)
+
+	var doc struct {
+		Paths      map[string]map[string]json.RawMessage `json:"paths"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(b, &doc))
+	require.Len(t, doc.Paths, len(routes)+1)
+	require.Contains(t, doc.Paths["/v1/GetInputMute"], "post")
+	for _, r := range routes {
+		var op struct {
+			Description string `json:"description"`
+		}
+		require.NoError(t, json.Unmarshal(doc.Paths["/v1/"+r.RequestType]["post"], &op))
+		require.NotEmpty(t, op.Description, r.RequestType)
+	}
+	require.Contains(t, doc.Components.Schemas, "GetInputMuteResponse")
+	require.Contains(t, doc.Components.Schemas, "AbstractObject")
+
+	for _, ref := range strings.Split(string(b), `"$ref": "#/components/schemas/`)[1:] {
+		name := ref[:strings.Index(ref, `"`)]
+		require.Contains(t, doc.Components.Schemas, name)
+	}
+}
@@ -0,0 +1,221 @@
+package obsrestgateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const errorSchemaName = "ErrorResponse"
+
+// OpenAPI returns the OpenAPI 3 document (as JSON) describing the API
+// served by Gateway.
+func OpenAPI() ([]byte, error) {
+	schemas := map[string]any{
+		errorSchemaName: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"code":              map[string]any{"type": "string"},
+				"message":           map[string]any{"type": "string"},
+				"requestStatus":     map[string]any{"type": "string"},
+				"requestStatusCode": map[string]any{"type": "integer"},
+			},
+		},
+	}
+	errorResponse := map[string]any{
+		"description": "the request failed",
+		"content": map[string]any{
+			"application/json": map[string]any{
+				"schema": schemaRef(errorSchemaName),
+			},
+		},
+	}
+
+	paths := map[string]any{}
+	for _, r := range routes {
+		reqDesc := r.NewRequest().ProtoReflect().Descriptor()
+		respDesc := responseDescriptor(reqDesc)
+		if respDesc == nil {
+			return nil, fmt.Errorf("unable to find the response message of '%s'", r.RequestType)
+		}
+		addSchema(schemas, reqDesc)
+		addSchema(schemas, respDesc)
+
+		op := map[string]any{
+			"operationId": r.RequestType,
+			"requestBody": map[string]any{
+				"content": map[string]any{
+					"application/json": map[string]any{
+						"schema": schemaRef(string(reqDesc.FullName())),
+					},
+				},
+			},
+			"responses": map[string]any{
+				"200": map[string]any{
+					"description": "the request succeeded",
+					"content": map[string]any{
+						"application/json": map[string]any{
+							"schema": schemaRef(string(respDesc.FullName())),
+						},
+					},
+				},
+				"default": errorResponse,
+			},
+		}
+		if r.Category != "" {
+			op["tags"] = []string{r.Category}
+		}
+		if r.Description != "" {
+			op["description"] = r.Description
+		}
+		if r.Deprecated {
+			op["deprecated"] = true
+		}
+		paths[PathPrefix+r.RequestType] = map[string]any{"post": op}
+	}
+
+	paths[PathPrefix+"events"] = map[string]any{
+		"get": map[string]any{
+			"operationId": "events",
+			"description": "streams the events as Server-Sent Events",
+			"parameters": []any{
+				queryParam("afterSeqNo", map[string]any{"type": "string", "format": "uint64"}),
+				queryParam("maxAge", map[string]any{"type": "string"}),
+				queryParam("eventType", map[string]any{"type": "array", "items": map[string]any{"type": "string"}}),
+				queryParam("category", map[string]any{"type": "array", "items": map[string]any{"type": "string"}}),
+			},
+			"responses": map[string]any{
+				"200": map[string]any{
+					"description": "the stream of events",
+					"content": map[string]any{
+						"text/event-stream": map[string]any{
+							"schema": map[string]any{"type": "string"},
+						},
+					},
+				},
+				"default": errorResponse,
+			},
+		},
+	}
+
+	doc := map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "OBS",
+			"version": "1",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": schemas,
+		},
+	}
+	b, err := json.MarshalIndent(doc, "", " ")
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize the document: %w", err)
+	}
+	return b, nil
+}
+
+func responseDescriptor(reqDesc protoreflect.MessageDescriptor) protoreflect.MessageDescriptor {
+	name := string(reqDesc.Name())
+	if len(name) < len("Request") || name[len(name)-len("Request"):] != "Request" {
+		return nil
+	}
+	respName := protoreflect.Name(name[:len(name)-len("Request")] + "Response")
+	return reqDesc.ParentFile().Messages().ByName(respName)
+}
+
+func queryParam(name string, schema map[string]any) map[string]any {
+	return map[string]any{
+		"name":   name,
+		"in":     "query",
+		"schema": schema,
+	}
+}
+
+func schemaRef(name string) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+// addSchema adds the schema of the message and of all the messages it
+// refers to.
+func addSchema(schemas map[string]any, desc protoreflect.MessageDescriptor) {
+	name := string(desc.FullName())
+	if _, ok := schemas[name]; ok {
+		return
+	}
+	properties := map[string]any{}
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	schemas[name] = schema
+
+	var required []string
+	fields := desc.Fields()
+	for idx := 0; idx < fields.Len(); idx++ {
+		fd := fields.Get(idx)
+		properties[fd.JSONName()] = fieldSchema(schemas, fd)
+		if fd.Cardinality() == protoreflect.Required {
+			required = append(required, fd.JSONName())
+		}
+	}
+	if len(required) > 0 {
+		sort.Strings(required)
+		schema["required"] = required
+	}
+}
+
+func fieldSchema(schemas map[string]any, fd protoreflect.FieldDescriptor) map[string]any {
+	switch {
+	case fd.IsMap():
+		return map[string]any{
+			"type":                 "object",
+			"additionalProperties": singularSchema(schemas, fd.MapValue()),
+		}
+	case fd.IsList():
+		return map[string]any{
+			"type":  "array",
+			"items": singularSchema(schemas, fd),
+		}
+	default:
+		return singularSchema(schemas, fd)
+	}
+}
+
+// singularSchema returns the schema of a single value of the field,
+// following the protobuf JSON mapping (except 'bytes', see obsprotojson).
+func singularSchema(schemas map[string]any, fd protoreflect.FieldDescriptor) map[string]any {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "uint32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return map[string]any{"type": "string"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		var names []string
+		for idx := 0; idx < values.Len(); idx++ {
+			names = append(names, string(values.Get(idx).Name()))
+		}
+		return map[string]any{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		addSchema(schemas, fd.Message())
+		return schemaRef(string(fd.Message().FullName()))
+	default:
+		return map[string]any{}
+	}
+}
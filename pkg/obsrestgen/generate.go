@@ -0,0 +1,74 @@
+package obsrestgen
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsdoc"
+)
+
+const (
+	pkgOBSGRPC = "github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	pkgProto   = "google.golang.org/protobuf/proto"
+)
+
+// Generate generates the table of the routes of the REST gateway
+// (package obsrestgateway).
+func Generate(
+	ctx context.Context,
+	w io.Writer,
+	p *obsdoc.Protocol,
+) error {
+	if p == nil {
+		return nil
+	}
+
+	code := jen.NewFile("obsrestgateway")
+	code.HeaderComment("This file was automatically generated by github.com/xaionaro-go/obs-grpc-proxy/scripts/generate")
+
+	var routes []jen.Code
+	for _, request := range p.Requests {
+		routes = append(routes, generateRoute(request))
+	}
+	code.Var().Id("routes").Op("=").Index().Id("route").Values(routes...)
+
+	err := code.Render(w)
+	if err != nil {
+		return fmt.Errorf("unable to render the code: %w", err)
+	}
+
+	return nil
+}
+
+func generateRoute(request obsdoc.Request) jen.Code {
+	reqType := jen.Qual(pkgOBSGRPC, request.RequestType+"Request")
+	fields := []jen.Code{
+		jen.Line().Id("RequestType").Op(":").Lit(request.RequestType),
+		jen.Line().Id("Category").Op(":").Lit(request.Category),
+	}
+	if request.Description != "" {
+		fields = append(fields, jen.Line().Id("Description").Op(":").Lit(request.Description))
+	}
+	if request.Deprecated {
+		fields = append(fields, jen.Line().Id("Deprecated").Op(":").True())
+	}
+	fields = append(fields,
+		jen.Line().Id("NewRequest").Op(":").Func().Params().Qual(pkgProto, "Message").Block(
+			jen.Return(jen.Op("&").Add(reqType).Values()),
+		),
+		jen.Line().Id("Call").Op(":").Func().Params(
+			jen.Id("ctx").Qual("context", "Context"),
+			jen.Id("client").Qual(pkgOBSGRPC, "OBSClient"),
+			jen.Id("req").Qual(pkgProto, "Message"),
+		).Params(jen.Qual(pkgProto, "Message"), jen.Error()).Block(
+			jen.Return(jen.Id("client").Dot(request.RequestType).Call(
+				jen.Id("ctx"),
+				jen.Id("req").Assert(jen.Op("*").Add(reqType)),
+			)),
+		),
+		jen.Line(),
+	)
+	return jen.Values(fields...)
+}
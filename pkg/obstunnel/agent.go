@@ -0,0 +1,217 @@
+package obstunnel
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsgrpcproxy"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ServeAgent connects to the backend (see Hub) and serves the OBS service
+// (for example, an obsgrpcproxy.Proxy) over the tunnel, reconnecting until
+// the context is cancelled.
+func ServeAgent(
+	ctx context.Context,
+	client obs_grpc.OBSTunnelClient,
+	instanceID string,
+	server obs_grpc.OBSServer,
+	opts ...Option,
+) error {
+	cfg := Options(opts).config()
+	backoff := cfg.ReconnectInitialBackoff
+	for {
+		startedAt := time.Now()
+		err := serveAgentTunnel(ctx, client, instanceID, server, cfg.Token)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if time.Since(startedAt) > cfg.ReconnectMaxBackoff {
+			// the tunnel worked for a while, so it is not a persistent failure
+			backoff = cfg.ReconnectInitialBackoff
+		}
+		logger.Warnf(ctx, "the tunnel ended: %v; reconnecting in %v", err, backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > cfg.ReconnectMaxBackoff {
+			backoff = cfg.ReconnectMaxBackoff
+		}
+	}
+}
+
+type agentTunnel struct {
+	stream obs_grpc.OBSTunnel_ConnectClient
+	server obs_grpc.OBSServer
+
+	sendLocker  sync.Mutex
+	callsLocker sync.Mutex
+	calls       map[uint64]context.CancelFunc
+}
+
+func serveAgentTunnel(
+	ctx context.Context,
+	client obs_grpc.OBSTunnelClient,
+	instanceID string,
+	server obs_grpc.OBSServer,
+	token string,
+) error {
+	ctx, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
+
+	connectCtx := ctx
+	if token != "" {
+		connectCtx = metadata.AppendToOutgoingContext(ctx, authorizationMetadataKey, authorizationPrefix+token)
+	}
+	stream, err := client.Connect(connectCtx)
+	if err != nil {
+		return fmt.Errorf("unable to connect: %w", err)
+	}
+	a := &agentTunnel{
+		stream: stream,
+		server: server,
+		calls:  map[uint64]context.CancelFunc{},
+	}
+	err = a.send(&obs_grpc.TunnelMessageFromOBS{
+		Union: &obs_grpc.TunnelMessageFromOBS_Hello{Hello: &obs_grpc.TunnelHello{InstanceID: instanceID}},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to send the hello: %w", err)
+	}
+	logger.Infof(ctx, "the tunnel is established")
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("unable to receive a message: %w", err)
+		}
+		switch msg := msg.Union.(type) {
+		case *obs_grpc.TunnelMessageToOBS_Call:
+			a.startCall(ctx, msg.Call)
+		case *obs_grpc.TunnelMessageToOBS_Cancel:
+			a.callsLocker.Lock()
+			cancelCall := a.calls[msg.Cancel.GetCallID()]
+			a.callsLocker.Unlock()
+			if cancelCall != nil {
+				cancelCall()
+			}
+		default:
+			logger.Warnf(ctx, "unexpected message from the backend: %T", msg)
+		}
+	}
+}
+
+func (a *agentTunnel) send(msg *obs_grpc.TunnelMessageFromOBS) error {
+	a.sendLocker.Lock()
+	defer a.sendLocker.Unlock()
+	return a.stream.Send(msg)
+}
+
+func (a *agentTunnel) startCall(ctx context.Context, call *obs_grpc.TunnelCall) {
+	ctx, cancelFn := context.WithCancel(ctx)
+	a.callsLocker.Lock()
+	a.calls[call.GetCallID()] = cancelFn
+	a.callsLocker.Unlock()
+
+	go func() {
+		defer func() {
+			a.callsLocker.Lock()
+			delete(a.calls, call.GetCallID())
+			a.callsLocker.Unlock()
+			cancelFn()
+		}()
+		s := status.Convert(a.execute(ctx, call))
+		err := a.send(&obs_grpc.TunnelMessageFromOBS{
+			Union: &obs_grpc.TunnelMessageFromOBS_CallEnd{CallEnd: &obs_grpc.TunnelCallEnd{
+				CallID:        call.GetCallID(),
+				StatusCode:    int32(s.Code()),
+				StatusMessage: s.Message(),
+			}},
+		})
+		if err != nil {
+			logger.Debugf(ctx, "unable to send the end of call #%d: %v", call.GetCallID(), err)
+		}
+	}()
+}
+
+func (a *agentTunnel) execute(ctx context.Context, call *obs_grpc.TunnelCall) error {
+	methodName, ok := strings.CutPrefix(call.GetMethod(), "/"+obs_grpc.OBS_ServiceDesc.ServiceName+"/")
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "unknown service of method '%s'", call.GetMethod())
+	}
+
+	if method := obsgrpcproxy.FindMethod(methodName); method != nil {
+		resp, err := method.Handler(a.server, ctx, func(req any) error {
+			return proto.Unmarshal(call.GetRequestData(), req.(proto.Message))
+		}, nil)
+		if err != nil {
+			return err
+		}
+		return a.sendResponse(call.GetCallID(), resp.(proto.Message))
+	}
+
+	for _, stream := range obs_grpc.OBS_ServiceDesc.Streams {
+		if stream.StreamName == methodName && !stream.ClientStreams {
+			return stream.Handler(a.server, &agentServerStream{
+				ctx:    ctx,
+				tunnel: a,
+				call:   call,
+			})
+		}
+	}
+	return status.Errorf(codes.Unimplemented, "unknown method '%s'", call.GetMethod())
+}
+
+func (a *agentTunnel) sendResponse(callID uint64, resp proto.Message) error {
+	b, err := proto.Marshal(resp)
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to serialize the response: %v", err)
+	}
+	return a.send(&obs_grpc.TunnelMessageFromOBS{
+		Union: &obs_grpc.TunnelMessageFromOBS_Response{Response: &obs_grpc.TunnelResponse{
+			CallID:       callID,
+			ResponseData: b,
+		}},
+	})
+}
+
+// agentServerStream is the grpc.ServerStream of a server-streaming call
+// received via the tunnel.
+type agentServerStream struct {
+	ctx         context.Context
+	tunnel      *agentTunnel
+	call        *obs_grpc.TunnelCall
+	requestRead bool
+}
+
+var _ grpc.ServerStream = (*agentServerStream)(nil)
+
+func (s *agentServerStream) SetHeader(metadata.MD) error  { return nil }
+func (s *agentServerStream) SendHeader(metadata.MD) error { return nil }
+func (s *agentServerStream) SetTrailer(metadata.MD)       {}
+func (s *agentServerStream) Context() context.Context     { return s.ctx }
+
+func (s *agentServerStream) SendMsg(m any) error {
+	return s.tunnel.sendResponse(s.call.GetCallID(), m.(proto.Message))
+}
+
+func (s *agentServerStream) RecvMsg(m any) error {
+	if s.requestRead {
+		return io.EOF
+	}
+	s.requestRead = true
+	return proto.Unmarshal(s.call.GetRequestData(), m.(proto.Message))
+}
@@ -0,0 +1,449 @@
+// Package obstunnel implements the reverse tunnel: a proxy next to OBS
+// (which could not be dialed, for example because of a NAT) dials a backend
+// (ServeAgent), and the backend calls the OBS service over the established
+// stream (Hub).
+package obstunnel
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// InstanceIDMetadataKey is the gRPC metadata key selecting the OBS instance
+// (see Hub.Conn).
+const InstanceIDMetadataKey = "obs-instance-id"
+
+const (
+	authorizationMetadataKey = "authorization"
+	authorizationPrefix      = "Bearer "
+)
+
+// callBufferSize is how many messages from OBS could be buffered for a call,
+// which is not received fast enough. If the buffer overflows, the call is
+// canceled, so that a slow call does not stall the other calls of
+// the tunnel.
+const callBufferSize = 1024
+
+// Hub is the backend side of the tunnels: it serves the OBSTunnel service
+// and routes the calls to the connected OBS instances.
+//
+// An agent is authenticated either with the token (see OptionToken) or
+// with a verified TLS client certificate (if the gRPC server requires
+// them). An unauthenticated agent could not replace the tunnel of an
+// already connected OBS instance.
+type Hub struct {
+	obs_grpc.UnimplementedOBSTunnelServer
+	config configT
+
+	locker  sync.Mutex
+	tunnels map[string]*hubTunnel
+}
+
+var _ obs_grpc.OBSTunnelServer = (*Hub)(nil)
+
+func NewHub(opts ...Option) *Hub {
+	return &Hub{
+		config:  Options(opts).config(),
+		tunnels: map[string]*hubTunnel{},
+	}
+}
+
+// Instances returns the IDs of the connected OBS instances.
+func (h *Hub) Instances() []string {
+	h.locker.Lock()
+	defer h.locker.Unlock()
+	result := make([]string, 0, len(h.tunnels))
+	for instanceID := range h.tunnels {
+		result = append(result, instanceID)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (h *Hub) Connect(stream obs_grpc.OBSTunnel_ConnectServer) error {
+	ctx := stream.Context()
+	authenticated, err := h.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+	msg, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("unable to receive the hello: %w", err)
+	}
+	hello := msg.GetHello()
+	if hello == nil {
+		return status.Errorf(codes.InvalidArgument, "the first message is expected to be a hello, but received %T", msg.Union)
+	}
+	instanceID := hello.GetInstanceID()
+
+	t := &hubTunnel{
+		stream: stream,
+		calls:  map[uint64]*hubCall{},
+		doneCh: make(chan struct{}),
+	}
+	h.locker.Lock()
+	if prev := h.tunnels[instanceID]; prev != nil {
+		if !authenticated {
+			h.locker.Unlock()
+			return status.Errorf(codes.AlreadyExists, "OBS instance '%s' is already connected, and an unauthenticated agent could not replace it", instanceID)
+		}
+		logger.Warnf(ctx, "OBS instance '%s' reconnected, replacing the previous tunnel", instanceID)
+	}
+	h.tunnels[instanceID] = t
+	h.locker.Unlock()
+	logger.Infof(ctx, "OBS instance '%s' connected", instanceID)
+
+	defer func() {
+		h.locker.Lock()
+		if h.tunnels[instanceID] == t {
+			delete(h.tunnels, instanceID)
+		}
+		h.locker.Unlock()
+		logger.Infof(ctx, "OBS instance '%s' disconnected", instanceID)
+	}()
+	return t.serve()
+}
+
+// authenticate checks the token (if it is configured) and returns true if
+// the agent is authenticated either with the token or with a verified TLS
+// client certificate.
+func (h *Hub) authenticate(ctx context.Context) (bool, error) {
+	if h.config.Token != "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(authorizationMetadataKey)
+		if len(values) == 0 || subtle.ConstantTimeCompare([]byte(values[0]), []byte(authorizationPrefix+h.config.Token)) != 1 {
+			return false, status.Errorf(codes.Unauthenticated, "invalid or missing token")
+		}
+		return true, nil
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	return ok && len(tlsInfo.State.VerifiedChains) > 0, nil
+}
+
+// Conn returns a connection to the OBS instance, to be used with
+// obs_grpc.NewOBSClient. If instanceID is empty, then the instance is
+// selected by the metadata InstanceIDMetadataKey of the context (incoming
+// or outgoing), or if there is no such metadata, the only connected
+// instance is used.
+func (h *Hub) Conn(instanceID string) grpc.ClientConnInterface {
+	return &hubConn{hub: h, instanceID: instanceID}
+}
+
+type hubConn struct {
+	hub        *Hub
+	instanceID string
+}
+
+var _ grpc.ClientConnInterface = (*hubConn)(nil)
+
+func (c *hubConn) tunnel(ctx context.Context) (*hubTunnel, error) {
+	instanceID := c.instanceID
+	if instanceID == "" {
+		instanceID = instanceIDFromContext(ctx)
+	}
+
+	c.hub.locker.Lock()
+	defer c.hub.locker.Unlock()
+	if instanceID != "" {
+		t := c.hub.tunnels[instanceID]
+		if t == nil {
+			return nil, status.Errorf(codes.Unavailable, "OBS instance '%s' is not connected", instanceID)
+		}
+		return t, nil
+	}
+	switch len(c.hub.tunnels) {
+	case 0:
+		return nil, status.Errorf(codes.Unavailable, "no OBS instances are connected")
+	case 1:
+		for _, t := range c.hub.tunnels {
+			return t, nil
+		}
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "%d OBS instances are connected, please select one with metadata '%s'", len(c.hub.tunnels), InstanceIDMetadataKey)
+}
+
+func instanceIDFromContext(ctx context.Context) string {
+	for _, fromContext := range []func(context.Context) (metadata.MD, bool){
+		metadata.FromOutgoingContext,
+		metadata.FromIncomingContext,
+	} {
+		md, _ := fromContext(ctx)
+		if values := md.Get(InstanceIDMetadataKey); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+func (c *hubConn) Invoke(
+	ctx context.Context,
+	method string,
+	args any,
+	reply any,
+	opts ...grpc.CallOption,
+) error {
+	t, err := c.tunnel(ctx)
+	if err != nil {
+		return err
+	}
+	call, err := t.startCall(ctx, method, args)
+	if err != nil {
+		return err
+	}
+	defer t.finishCall(call)
+	for {
+		msg, err := call.recv(ctx)
+		if err != nil {
+			return err
+		}
+		if msg.GetResponse() != nil {
+			if err := proto.Unmarshal(msg.GetResponse().GetResponseData(), reply.(proto.Message)); err != nil {
+				return status.Errorf(codes.Internal, "unable to parse the response: %v", err)
+			}
+			continue
+		}
+		return callEndError(msg.GetCallEnd())
+	}
+}
+
+func (c *hubConn) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	if desc.ClientStreams {
+		return nil, status.Errorf(codes.Unimplemented, "client-streaming calls are not supported by the tunnel")
+	}
+	t, err := c.tunnel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &hubClientStream{
+		ctx:    ctx,
+		tunnel: t,
+		method: method,
+	}, nil
+}
+
+func callEndError(end *obs_grpc.TunnelCallEnd) error {
+	if codes.Code(end.GetStatusCode()) == codes.OK {
+		return nil
+	}
+	return status.Error(codes.Code(end.GetStatusCode()), end.GetStatusMessage())
+}
+
+type hubTunnel struct {
+	stream obs_grpc.OBSTunnel_ConnectServer
+
+	sendLocker sync.Mutex
+	locker     sync.Mutex
+	nextCallID uint64
+	calls      map[uint64]*hubCall
+	doneCh     chan struct{}
+}
+
+type hubCall struct {
+	id       uint64
+	tunnel   *hubTunnel
+	ch       chan *obs_grpc.TunnelMessageFromOBS
+	doneCh   chan struct{}
+	doneOnce sync.Once
+	err      error // is set before closing doneCh
+}
+
+func (t *hubTunnel) serve() error {
+	defer close(t.doneCh)
+	for {
+		msg, err := t.stream.Recv()
+		if err != nil {
+			return err
+		}
+		var callID uint64
+		switch {
+		case msg.GetResponse() != nil:
+			callID = msg.GetResponse().GetCallID()
+		case msg.GetCallEnd() != nil:
+			callID = msg.GetCallEnd().GetCallID()
+		default:
+			logger.Warnf(t.stream.Context(), "unexpected message from OBS: %T", msg.Union)
+			continue
+		}
+
+		t.locker.Lock()
+		call := t.calls[callID]
+		t.locker.Unlock()
+		if call == nil {
+			continue
+		}
+		select {
+		case call.ch <- msg:
+		default:
+			logger.Warnf(t.stream.Context(), "call #%d does not receive the responses fast enough, canceling it", callID)
+			t.cancelCall(call, status.Errorf(codes.ResourceExhausted, "the responses are not received fast enough: more than %d responses are buffered", callBufferSize))
+		}
+	}
+}
+
+func (t *hubTunnel) send(msg *obs_grpc.TunnelMessageToOBS) error {
+	t.sendLocker.Lock()
+	defer t.sendLocker.Unlock()
+	if err := t.stream.Send(msg); err != nil {
+		return status.Errorf(codes.Unavailable, "unable to send to the tunnel: %v", err)
+	}
+	return nil
+}
+
+func (t *hubTunnel) startCall(
+	ctx context.Context,
+	method string,
+	args any,
+) (*hubCall, error) {
+	b, err := proto.Marshal(args.(proto.Message))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to serialize the request: %v", err)
+	}
+
+	t.locker.Lock()
+	t.nextCallID++
+	call := &hubCall{
+		id:     t.nextCallID,
+		tunnel: t,
+		ch:     make(chan *obs_grpc.TunnelMessageFromOBS, callBufferSize),
+		doneCh: make(chan struct{}),
+	}
+	t.calls[call.id] = call
+	t.locker.Unlock()
+
+	err = t.send(&obs_grpc.TunnelMessageToOBS{
+		Union: &obs_grpc.TunnelMessageToOBS_Call{Call: &obs_grpc.TunnelCall{
+			CallID:      call.id,
+			Method:      method,
+			RequestData: b,
+		}},
+	})
+	if err != nil {
+		t.finishCall(call)
+		return nil, err
+	}
+
+	go func() {
+		select {
+		case <-call.doneCh:
+		case <-ctx.Done():
+			t.cancelCall(call, status.FromContextError(ctx.Err()).Err())
+		}
+	}()
+	return call, nil
+}
+
+// cancelCall asks the agent to stop the call, and finishes it with
+// the error.
+func (t *hubTunnel) cancelCall(call *hubCall, err error) {
+	t.finishCallWithError(call, err)
+	go t.send(&obs_grpc.TunnelMessageToOBS{
+		Union: &obs_grpc.TunnelMessageToOBS_Cancel{Cancel: &obs_grpc.TunnelCancel{CallID: call.id}},
+	})
+}
+
+func (t *hubTunnel) finishCall(call *hubCall) {
+	t.finishCallWithError(call, status.Errorf(codes.Canceled, "the call is finished"))
+}
+
+func (t *hubTunnel) finishCallWithError(call *hubCall, err error) {
+	call.doneOnce.Do(func() {
+		t.locker.Lock()
+		delete(t.calls, call.id)
+		t.locker.Unlock()
+		call.err = err
+		close(call.doneCh)
+	})
+}
+
+// recv returns the next message of the call; the already buffered messages
+// are returned even if the call is finished.
+func (call *hubCall) recv(ctx context.Context) (*obs_grpc.TunnelMessageFromOBS, error) {
+	select {
+	case msg := <-call.ch:
+		return msg, nil
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	case <-call.doneCh:
+		select {
+		case msg := <-call.ch:
+			return msg, nil
+		default:
+		}
+		return nil, call.err
+	case <-call.tunnel.doneCh:
+		return nil, status.Errorf(codes.Unavailable, "the tunnel is closed")
+	}
+}
+
+// hubClientStream is the grpc.ClientStream of a server-streaming call
+// sent via the tunnel.
+type hubClientStream struct {
+	ctx    context.Context
+	tunnel *hubTunnel
+	method string
+	call   *hubCall
+}
+
+var _ grpc.ClientStream = (*hubClientStream)(nil)
+
+func (s *hubClientStream) Header() (metadata.MD, error) { return metadata.MD{}, nil }
+func (s *hubClientStream) Trailer() metadata.MD         { return metadata.MD{} }
+func (s *hubClientStream) CloseSend() error             { return nil }
+func (s *hubClientStream) Context() context.Context     { return s.ctx }
+
+func (s *hubClientStream) SendMsg(m any) error {
+	if s.call != nil {
+		return status.Errorf(codes.Unimplemented, "client-streaming calls are not supported by the tunnel")
+	}
+	call, err := s.tunnel.startCall(s.ctx, s.method, m)
+	if err != nil {
+		return err
+	}
+	s.call = call
+	return nil
+}
+
+func (s *hubClientStream) RecvMsg(m any) error {
+	if s.call == nil {
+		return status.Errorf(codes.Internal, "the request was not sent")
+	}
+	msg, err := s.call.recv(s.ctx)
+	if err != nil {
+		s.tunnel.finishCall(s.call)
+		return err
+	}
+	if msg.GetResponse() != nil {
+		if err := proto.Unmarshal(msg.GetResponse().GetResponseData(), m.(proto.Message)); err != nil {
+			return status.Errorf(codes.Internal, "unable to parse the response: %v", err)
+		}
+		return nil
+	}
+	s.tunnel.finishCall(s.call)
+	if err := callEndError(msg.GetCallEnd()); err != nil {
+		return err
+	}
+	return io.EOF
+}
@@ -0,0 +1,318 @@
+package obstunnel
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/andreykaipov/goobs/api/events"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsgrpcproxy"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type fakeServer struct {
+	obs_grpc.UnimplementedOBSServer
+	Events        []any
+	UnsubscribeCh chan struct{}
+}
+
+func (srv *fakeServer) GetVersion(
+	ctx context.Context,
+	req *obs_grpc.GetVersionRequest,
+) (*obs_grpc.GetVersionResponse, error) {
+	return &obs_grpc.GetVersionResponse{ObsVersion: []byte("30.2.0")}, nil
+}
+
+func (srv *fakeServer) SubscribeToEvents(
+	req *obs_grpc.SubscribeToEventsRequest,
+	stream obs_grpc.OBS_SubscribeToEventsServer,
+) error {
+	for idx, ev := range srv.Events {
+		envelope, err := obsgrpcproxy.EventGo2Protobuf(ev)
+		if err != nil {
+			return err
+		}
+		envelope.SeqNo = uint64(idx + 1)
+		if err := stream.Send(envelope); err != nil {
+			return err
+		}
+	}
+	<-stream.Context().Done()
+	close(srv.UnsubscribeCh)
+	return nil
+}
+
+func serveHub(t *testing.T, addr string, hub *Hub) (*grpc.Server, string) {
+	listener, err := net.Listen("tcp", addr)
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	obs_grpc.RegisterOBSTunnelServer(grpcServer, hub)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+	return grpcServer, listener.Addr().String()
+}
+
+func TestTunnel(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	hub := NewHub()
+	backend, backendAddr := serveHub(t, "127.0.0.1:0", hub)
+
+	conn, err := grpc.NewClient(backendAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	srv := &fakeServer{
+		Events: []any{
+			&events.CurrentProgramSceneChanged{SceneName: "Intro"},
+			&events.CurrentProgramSceneChanged{SceneName: "Live"},
+		},
+		UnsubscribeCh: make(chan struct{}),
+	}
+	go ServeAgent(ctx, obs_grpc.NewOBSTunnelClient(conn), "studio-1", srv, OptionReconnectBackoff{
+		Initial: 10 * time.Millisecond,
+		Max:     100 * time.Millisecond,
+	})
+	require.Eventually(t, func() bool {
+		return len(hub.Instances()) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{"studio-1"}, hub.Instances())
+
+	client := obs_grpc.NewOBSClient(hub.Conn(""))
+	version, err := client.GetVersion(ctx, &obs_grpc.GetVersionRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "30.2.0", string(version.GetObsVersion()))
+
+	_, err = client.GetStats(ctx, &obs_grpc.GetStatsRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	_, err = obs_grpc.NewOBSClient(hub.Conn("studio-2")).GetVersion(ctx, &obs_grpc.GetVersionRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	streamCtx, streamCancelFn := context.WithCancel(ctx)
+	stream, err := client.SubscribeToEvents(streamCtx, &obs_grpc.SubscribeToEventsRequest{})
+	require.NoError(t, err)
+	for _, sceneName := range []string{"Intro", "Live"} {
+		ev, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, sceneName, ev.GetCurrentProgramSceneChanged().GetSceneName())
+	}
+	streamCancelFn()
+	select {
+	case <-srv.UnsubscribeCh:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the cancellation was not propagated to the agent")
+	}
+
+	// the agent reconnects after the backend restarts
+	backend.Stop()
+	serveHub(t, backendAddr, hub)
+	require.Eventually(t, func() bool {
+		_, err := client.GetVersion(ctx, &obs_grpc.GetVersionRequest{})
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestTunnelStalledStream(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	hub := NewHub()
+	_, backendAddr := serveHub(t, "127.0.0.1:0", hub)
+	conn, err := grpc.NewClient(backendAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	srv := &fakeServer{
+		UnsubscribeCh: make(chan struct{}),
+	}
+	for i := 0; i < 2*callBufferSize; i++ {
+		srv.Events = append(srv.Events, &events.ExitStarted{})
+	}
+	go ServeAgent(ctx, obs_grpc.NewOBSTunnelClient(conn), "studio-1", srv)
+	require.Eventually(t, func() bool {
+		return len(hub.Instances()) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// the events are not received, so the stream stalls
+	client := obs_grpc.NewOBSClient(hub.Conn(""))
+	stream, err := client.SubscribeToEvents(ctx, &obs_grpc.SubscribeToEventsRequest{})
+	require.NoError(t, err)
+
+	// which does not stall the other calls
+	callCtx, callCancelFn := context.WithTimeout(ctx, 5*time.Second)
+	defer callCancelFn()
+	version, err := client.GetVersion(callCtx, &obs_grpc.GetVersionRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "30.2.0", string(version.GetObsVersion()))
+
+	// and the stalled stream is canceled
+	select {
+	case <-srv.UnsubscribeCh:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the stalled stream was not canceled")
+	}
+
+	// the buffered events are still received, and then the error
+	for i := 0; i < callBufferSize; i++ {
+		ev, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, uint64(i+1), ev.SeqNo)
+	}
+	_, err = stream.Recv()
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+// connectRaw opens a tunnel with the given instance ID without serving it,
+// and returns the error the hub ended it with (or nil if the tunnel is
+// established).
+func connectRaw(
+	ctx context.Context,
+	t *testing.T,
+	conn *grpc.ClientConn,
+	instanceID string,
+	token string,
+) error {
+	ctx, cancelFn := context.WithTimeout(ctx, 500*time.Millisecond)
+	t.Cleanup(cancelFn)
+	if token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, authorizationMetadataKey, authorizationPrefix+token)
+	}
+	stream, err := obs_grpc.NewOBSTunnelClient(conn).Connect(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&obs_grpc.TunnelMessageFromOBS{
+		Union: &obs_grpc.TunnelMessageFromOBS_Hello{Hello: &obs_grpc.TunnelHello{InstanceID: instanceID}},
+	}))
+	_, err = stream.Recv()
+	if status.Code(err) == codes.DeadlineExceeded {
+		return nil
+	}
+	return err
+}
+
+func TestTunnelDuplicateInstance(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	for _, token := range []string{"", "secret"} {
+		t.Run("token="+token, func(t *testing.T) {
+			hub := NewHub(OptionToken(token))
+			_, backendAddr := serveHub(t, "127.0.0.1:0", hub)
+			conn, err := grpc.NewClient(backendAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			require.NoError(t, err)
+			defer conn.Close()
+
+			go ServeAgent(ctx, obs_grpc.NewOBSTunnelClient(conn), "studio-1", &fakeServer{}, OptionToken(token))
+			require.Eventually(t, func() bool {
+				return len(hub.Instances()) == 1
+			}, 10*time.Second, 10*time.Millisecond)
+
+			err = connectRaw(ctx, t, conn, "studio-1", token)
+			if token == "" {
+				// an unauthenticated agent does not replace the tunnel
+				require.Equal(t, codes.AlreadyExists, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTunnelToken(t *testing.T) {
+	ctx := context.Background()
+	hub := NewHub(OptionToken("secret"))
+	_, backendAddr := serveHub(t, "127.0.0.1:0", hub)
+	conn, err := grpc.NewClient(backendAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.Equal(t, codes.Unauthenticated, status.Code(connectRaw(ctx, t, conn, "studio-1", "")))
+	require.Equal(t, codes.Unauthenticated, status.Code(connectRaw(ctx, t, conn, "studio-1", "wrong")))
+	require.Empty(t, hub.Instances())
+	require.NoError(t, connectRaw(ctx, t, conn, "studio-1", "secret"))
+}
+
+// newTestCert returns a certificate signed by the parent (or a self-signed
+// CA if the parent is nil).
+func newTestCert(t *testing.T, parent *tls.Certificate, isClient bool) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "obstunnel test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	if isClient {
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	}
+	signer, signerKey := template, any(key)
+	if parent == nil {
+		template.IsCA = true
+		template.ExtKeyUsage = nil
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.Leaf, parent.PrivateKey
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+func TestTunnelMutualTLS(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	ca := newTestCert(t, nil, false)
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.Leaf)
+	serverCert := newTestCert(t, &ca, false)
+	clientCert := newTestCert(t, &ca, true)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	hub := NewHub()
+	grpcServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})))
+	obs_grpc.RegisterOBSTunnelServer(grpcServer, hub)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{clientCert},
+		RootCAs:      pool,
+	})))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	go ServeAgent(ctx, obs_grpc.NewOBSTunnelClient(conn), "studio-1", &fakeServer{})
+	require.Eventually(t, func() bool {
+		return len(hub.Instances()) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// the agent with a verified certificate is authenticated, so it could
+	// replace the tunnel
+	require.NoError(t, connectRaw(ctx, t, conn, "studio-1", ""))
+}
@@ -0,0 +1,58 @@
+package obstunnel
+
+import (
+	"time"
+)
+
+type configT struct {
+	ReconnectInitialBackoff time.Duration
+	ReconnectMaxBackoff     time.Duration
+	Token                   string
+}
+
+func defaultConfig() configT {
+	return configT{
+		ReconnectInitialBackoff: time.Second,
+		ReconnectMaxBackoff:     time.Minute,
+	}
+}
+
+type Option interface {
+	apply(cfg *configT)
+}
+
+type Options []Option
+
+func (s Options) apply(cfg *configT) {
+	for _, opt := range s {
+		opt.apply(cfg)
+	}
+}
+
+func (s Options) config() configT {
+	cfg := defaultConfig()
+	s.apply(&cfg)
+	return cfg
+}
+
+// OptionReconnectBackoff sets how long ServeAgent waits before reconnecting:
+// the delay starts with Initial and doubles after each failed attempt up
+// to Max.
+type OptionReconnectBackoff struct {
+	Initial time.Duration
+	Max     time.Duration
+}
+
+func (opt OptionReconnectBackoff) apply(cfg *configT) {
+	cfg.ReconnectInitialBackoff = opt.Initial
+	cfg.ReconnectMaxBackoff = opt.Max
+}
+
+// OptionToken sets the token ServeAgent authenticates with, and the token
+// Hub requires from the agents. The token is sent as is, so the tunnel
+// should be protected with TLS.
+type OptionToken string
+
+func (opt OptionToken) apply(cfg *configT) {
+	cfg.Token = string(opt)
+}
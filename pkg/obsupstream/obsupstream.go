@@ -0,0 +1,242 @@
+// Package obsupstream connects to a list of upstream gRPC proxies (in
+// the order of preference) and fails over between them, so that a proxy
+// could forward the calls to another proxy (for example, an edge proxy
+// next to OBS reached via a relay).
+package obsupstream
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type upstream struct {
+	Addr    string
+	Conn    *grpc.ClientConn
+	Healthy atomic.Bool
+}
+
+// Upstreams is a grpc.ClientConnInterface sending each call to the active
+// upstream: the first healthy one in the list. An upstream is considered
+// unhealthy if it fails to respond to GetVersion (it is checked periodically)
+// or if a call fails with codes.Unavailable; in the latter case the call is
+// retried with the next healthy upstream, but only if it could not have
+// been run by the failed upstream (see canFailOver).
+//
+// Streams are not moved between the upstreams: if the upstream of a stream
+// fails, the stream fails and the caller should open a new one (for example,
+// the sequence numbers of the events are specific to an upstream, so the
+// events could not be resumed transparently).
+type Upstreams struct {
+	cfg       configT
+	upstreams []*upstream
+	activeIdx atomic.Int32
+	locker    sync.Mutex
+	cancelFn  context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+var _ grpc.ClientConnInterface = (*Upstreams)(nil)
+
+func New(
+	ctx context.Context,
+	addrs []string,
+	opts ...Option,
+) (*Upstreams, error) {
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no upstream addresses provided")
+	}
+	cfg := Options(opts).config()
+	u := &Upstreams{
+		cfg: cfg,
+	}
+	dialOpts := append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, cfg.DialOptions...)
+	for _, addr := range addrs {
+		conn, err := grpc.NewClient(addr, dialOpts...)
+		if err != nil {
+			u.closeConns()
+			return nil, fmt.Errorf("unable to initialize a client for upstream '%s': %w", addr, err)
+		}
+		up := &upstream{Addr: addr, Conn: conn}
+		up.Healthy.Store(true)
+		u.upstreams = append(u.upstreams, up)
+	}
+
+	ctx, u.cancelFn = context.WithCancel(ctx)
+	u.checkHealth(ctx)
+	u.wg.Add(1)
+	go func() {
+		defer u.wg.Done()
+		u.serveHealthChecks(ctx)
+	}()
+	return u, nil
+}
+
+// Close stops the health checks and closes the connections.
+func (u *Upstreams) Close() error {
+	u.cancelFn()
+	u.wg.Wait()
+	return u.closeConns()
+}
+
+func (u *Upstreams) closeConns() error {
+	var result error
+	for _, up := range u.upstreams {
+		if err := up.Conn.Close(); err != nil && result == nil {
+			result = fmt.Errorf("unable to close the connection to '%s': %w", up.Addr, err)
+		}
+	}
+	return result
+}
+
+// Active returns the address of the upstream the calls are currently sent to.
+func (u *Upstreams) Active() string {
+	return u.upstreams[u.activeIdx.Load()].Addr
+}
+
+func (u *Upstreams) serveHealthChecks(ctx context.Context) {
+	ticker := time.NewTicker(u.cfg.HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		u.checkHealth(ctx)
+	}
+}
+
+func (u *Upstreams) checkHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, up := range u.upstreams {
+		wg.Add(1)
+		go func(up *upstream) {
+			defer wg.Done()
+			ctx, cancelFn := context.WithTimeout(ctx, u.cfg.HealthCheckTimeout)
+			defer cancelFn()
+			_, err := obs_grpc.NewOBSClient(up.Conn).GetVersion(ctx, &obs_grpc.GetVersionRequest{})
+			if err != nil {
+				logger.Debugf(ctx, "upstream '%s' is unhealthy: %v", up.Addr, err)
+			}
+			up.Healthy.Store(err == nil)
+		}(up)
+	}
+	wg.Wait()
+	u.selectActive(ctx)
+}
+
+// selectActive makes the first healthy upstream active. If there are no
+// healthy upstreams, the active one is not changed.
+func (u *Upstreams) selectActive(ctx context.Context) {
+	u.locker.Lock()
+	defer u.locker.Unlock()
+	for idx, up := range u.upstreams {
+		if !up.Healthy.Load() {
+			continue
+		}
+		if prevIdx := u.activeIdx.Swap(int32(idx)); prevIdx != int32(idx) {
+			logger.Warnf(ctx, "switched from upstream '%s' to '%s'", u.upstreams[prevIdx].Addr, up.Addr)
+		}
+		return
+	}
+}
+
+// pick returns the active upstream, or (if it was already tried) the first
+// healthy upstream not tried yet, or nil.
+func (u *Upstreams) pick(tried map[*upstream]bool) *upstream {
+	if up := u.upstreams[u.activeIdx.Load()]; !tried[up] {
+		return up
+	}
+	for _, up := range u.upstreams {
+		if !tried[up] && up.Healthy.Load() {
+			return up
+		}
+	}
+	return nil
+}
+
+func (u *Upstreams) markUnavailable(ctx context.Context, up *upstream, err error) {
+	logger.Warnf(ctx, "upstream '%s' is unavailable: %v", up.Addr, err)
+	up.Healthy.Store(false)
+	u.selectActive(ctx)
+}
+
+// canFailOver checks if a call which failed with codes.Unavailable could
+// be retried with another upstream. codes.Unavailable could also be
+// returned after the upstream ran the request, so the calls are only
+// retried if the connection was not ready (a call is not sent then) or if
+// the request is read-only (so running it twice is harmless).
+func canFailOver(method string, stateBefore connectivity.State) bool {
+	if stateBefore != connectivity.Ready {
+		return true
+	}
+	return isReadOnly(method)
+}
+
+// isReadOnly checks if the method (like "/obs_grpc.OBS/GetSceneList") only
+// reads the state of OBS.
+func isReadOnly(method string) bool {
+	name := method[strings.LastIndex(method, "/")+1:]
+	return strings.HasPrefix(name, "Get")
+}
+
+func (u *Upstreams) Invoke(
+	ctx context.Context,
+	method string,
+	args any,
+	reply any,
+	opts ...grpc.CallOption,
+) error {
+	tried := map[*upstream]bool{}
+	var err error
+	// the health checks may mark the upstreams unhealthy concurrently,
+	// so pick is called once per attempt
+	for up := u.pick(tried); up != nil; up = u.pick(tried) {
+		stateBefore := up.Conn.GetState()
+		err = up.Conn.Invoke(ctx, method, args, reply, opts...)
+		if status.Code(err) != codes.Unavailable || ctx.Err() != nil {
+			return err
+		}
+		u.markUnavailable(ctx, up, err)
+		if !canFailOver(method, stateBefore) {
+			return err
+		}
+		tried[up] = true
+	}
+	return err
+}
+
+// NewStream opens the stream with the active upstream. A failure to open
+// a stream is always retried with the next upstream: the request message
+// is sent only after the stream is opened (see grpc.ClientConn.NewStream).
+func (u *Upstreams) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	tried := map[*upstream]bool{}
+	var err error
+	for up := u.pick(tried); up != nil; up = u.pick(tried) {
+		var stream grpc.ClientStream
+		stream, err = up.Conn.NewStream(ctx, desc, method, opts...)
+		if status.Code(err) != codes.Unavailable || ctx.Err() != nil {
+			return stream, err
+		}
+		u.markUnavailable(ctx, up, err)
+		tried[up] = true
+	}
+	return nil, err
+}
@@ -0,0 +1,148 @@
+package obsupstream
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+)
+
+type fakeServer struct {
+	obs_grpc.UnimplementedOBSServer
+	Name string
+
+	// Unavailable makes the requests fail with codes.Unavailable after
+	// they are handled.
+	Unavailable  bool
+	StreamStarts atomic.Int32
+}
+
+func (srv *fakeServer) StartStream(
+	ctx context.Context,
+	req *obs_grpc.StartStreamRequest,
+) (*obs_grpc.StartStreamResponse, error) {
+	srv.StreamStarts.Add(1)
+	if srv.Unavailable {
+		return nil, status.Error(codes.Unavailable, "the connection to OBS is lost")
+	}
+	return &obs_grpc.StartStreamResponse{}, nil
+}
+
+func (srv *fakeServer) GetVersion(
+	ctx context.Context,
+	req *obs_grpc.GetVersionRequest,
+) (*obs_grpc.GetVersionResponse, error) {
+	if srv.Unavailable {
+		return nil, status.Error(codes.Unavailable, "the connection to OBS is lost")
+	}
+	return &obs_grpc.GetVersionResponse{Platform: []byte(srv.Name)}, nil
+}
+
+func serve(t *testing.T, addr string, name string) (*grpc.Server, string) {
+	grpcServer, addr := serveFake(t, addr, &fakeServer{Name: name})
+	return grpcServer, addr
+}
+
+func serveFake(t *testing.T, addr string, srv *fakeServer) (*grpc.Server, string) {
+	listener, err := net.Listen("tcp", addr)
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	obs_grpc.RegisterOBSServer(grpcServer, srv)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+	return grpcServer, listener.Addr().String()
+}
+
+func TestFailover(t *testing.T) {
+	ctx := context.Background()
+	primary, primaryAddr := serve(t, "127.0.0.1:0", "primary")
+	_, secondaryAddr := serve(t, "127.0.0.1:0", "secondary")
+
+	upstreams, err := New(ctx, []string{primaryAddr, secondaryAddr}, OptionHealthCheckInterval(50*time.Millisecond))
+	require.NoError(t, err)
+	defer upstreams.Close()
+	client := obs_grpc.NewOBSClient(upstreams)
+
+	platform := func() string {
+		resp, err := client.GetVersion(ctx, &obs_grpc.GetVersionRequest{})
+		require.NoError(t, err)
+		return string(resp.GetPlatform())
+	}
+	require.Equal(t, "primary", platform())
+
+	// the call fails over immediately, without waiting for a health check
+	primary.Stop()
+	require.Equal(t, "secondary", platform())
+	require.Equal(t, secondaryAddr, upstreams.Active())
+
+	// and returns to the preferred upstream once it is healthy again
+	serve(t, primaryAddr, "primary")
+	require.Eventually(t, func() bool {
+		return upstreams.Active() == primaryAddr
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, "primary", platform())
+}
+
+func TestNoFailoverAfterRequestIsSent(t *testing.T) {
+	ctx := context.Background()
+	primary := &fakeServer{Name: "primary"}
+	secondary := &fakeServer{Name: "secondary"}
+	_, primaryAddr := serveFake(t, "127.0.0.1:0", primary)
+	_, secondaryAddr := serveFake(t, "127.0.0.1:0", secondary)
+
+	upstreams, err := New(ctx, []string{primaryAddr, secondaryAddr}, OptionHealthCheckInterval(time.Hour))
+	require.NoError(t, err)
+	defer upstreams.Close()
+	client := obs_grpc.NewOBSClient(upstreams)
+	require.Equal(t, primaryAddr, upstreams.Active())
+
+	// the primary could have started the stream, so it is not started again
+	primary.Unavailable = true
+	_, err = client.StartStream(ctx, &obs_grpc.StartStreamRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Equal(t, int32(1), primary.StreamStarts.Load())
+	require.Equal(t, int32(0), secondary.StreamStarts.Load())
+
+	// but the read-only requests are retried
+	upstreams.upstreams[0].Healthy.Store(true)
+	upstreams.selectActive(ctx)
+	resp, err := client.GetVersion(ctx, &obs_grpc.GetVersionRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "secondary", string(resp.GetPlatform()))
+}
+
+func TestCanFailOver(t *testing.T) {
+	require.True(t, canFailOver("/obs_grpc.OBS/StartStream", connectivity.TransientFailure))
+	require.True(t, canFailOver("/obs_grpc.OBS/GetSceneList", connectivity.Ready))
+	require.False(t, canFailOver("/obs_grpc.OBS/StartStream", connectivity.Ready))
+	require.False(t, canFailOver("/obs_grpc.OBS/CreateScene", connectivity.Ready))
+}
+
+func TestNoHealthyUpstreamLeft(t *testing.T) {
+	ctx := context.Background()
+	primary := &fakeServer{Name: "primary"}
+	secondary := &fakeServer{Name: "secondary"}
+	_, primaryAddr := serveFake(t, "127.0.0.1:0", primary)
+	_, secondaryAddr := serveFake(t, "127.0.0.1:0", secondary)
+
+	upstreams, err := New(ctx, []string{primaryAddr, secondaryAddr}, OptionHealthCheckInterval(time.Hour))
+	require.NoError(t, err)
+	defer upstreams.Close()
+	client := obs_grpc.NewOBSClient(upstreams)
+
+	// the call fails, and there is no healthy upstream to fail over to:
+	// the error of the last attempt is returned
+	primary.Unavailable = true
+	upstreams.upstreams[1].Healthy.Store(false)
+	_, err = client.GetVersion(ctx, &obs_grpc.GetVersionRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Contains(t, err.Error(), "the connection to OBS is lost")
+}
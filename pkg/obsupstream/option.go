@@ -0,0 +1,62 @@
+package obsupstream
+
+import (
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+type configT struct {
+	HealthCheckInterval time.Duration
+	HealthCheckTimeout  time.Duration
+	DialOptions         []grpc.DialOption
+}
+
+func defaultConfig() configT {
+	return configT{
+		HealthCheckInterval: 5 * time.Second,
+		HealthCheckTimeout:  2 * time.Second,
+	}
+}
+
+type Option interface {
+	apply(cfg *configT)
+}
+
+type Options []Option
+
+func (s Options) apply(cfg *configT) {
+	for _, opt := range s {
+		opt.apply(cfg)
+	}
+}
+
+func (s Options) config() configT {
+	cfg := defaultConfig()
+	s.apply(&cfg)
+	return cfg
+}
+
+// OptionHealthCheckInterval sets how often the health of the upstreams
+// is checked.
+type OptionHealthCheckInterval time.Duration
+
+func (opt OptionHealthCheckInterval) apply(cfg *configT) {
+	cfg.HealthCheckInterval = time.Duration(opt)
+}
+
+// OptionHealthCheckTimeout sets how long an upstream may take to respond
+// to a health check before it is considered unhealthy.
+type OptionHealthCheckTimeout time.Duration
+
+func (opt OptionHealthCheckTimeout) apply(cfg *configT) {
+	cfg.HealthCheckTimeout = time.Duration(opt)
+}
+
+// OptionDialOptions adds options of the connections to the upstreams
+// (by default the connections are insecure).
+type OptionDialOptions []grpc.DialOption
+
+func (opt OptionDialOptions) apply(cfg *configT) {
+	cfg.DialOptions = append(cfg.DialOptions, opt...)
+}
@@ -0,0 +1,118 @@
+package obswsbridge
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsgrpcproxy"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ClientBackend is a Backend forwarding the requests to an OBSClient.
+type ClientBackend struct {
+	obs_grpc.OBSClient
+}
+
+var _ Backend = (*ClientBackend)(nil)
+
+func (b *ClientBackend) OBSWebSocketVersion(ctx context.Context) string {
+	version, err := b.OBSClient.GetVersion(ctx, &obs_grpc.GetVersionRequest{})
+	if err != nil {
+		logger.Debugf(ctx, "unable to get the version of obs-websocket: %v", err)
+		return defaultOBSWebSocketVersion
+	}
+	return string(version.GetObsWebSocketVersion())
+}
+
+func (b *ClientBackend) ExecuteRequest(
+	ctx context.Context,
+	requestType string,
+	requestData map[string]any,
+) (map[string]any, RequestStatus) {
+	method := obsgrpcproxy.FindMethod(requestType)
+	if method == nil || requestType == "ExecuteRequestSequence" || requestType == "GetProxyCapabilities" {
+		return nil, RequestStatus{
+			Code:    int(obs_grpc.RequestStatus_UnknownRequestType),
+			Comment: "Your request type is not valid.",
+		}
+	}
+
+	var decodeErr error
+	resp, err := method.Handler(&obsgrpcproxy.ClientAsServer{OBSClient: b.OBSClient}, ctx, func(msg any) error {
+		decodeErr = obsgrpcproxy.OBSJSON2Protobuf(requestData, msg.(proto.Message).ProtoReflect())
+		return decodeErr
+	}, nil)
+	switch {
+	case decodeErr != nil:
+		return nil, RequestStatus{
+			Code:    int(obs_grpc.RequestStatus_InvalidRequestFieldType),
+			Comment: decodeErr.Error(),
+		}
+	case err != nil:
+		return nil, requestStatusFromError(err)
+	}
+	return obsgrpcproxy.Protobuf2OBSJSON(resp.(proto.Message).ProtoReflect()), RequestStatus{
+		Result: true,
+		Code:   int(obs_grpc.RequestStatus_Success),
+	}
+}
+
+func requestStatusFromError(err error) RequestStatus {
+	s := status.Convert(err)
+	if code, ok := obsgrpcproxy.RequestStatusFromError(errors.New(s.Message())); ok {
+		return RequestStatus{Code: int(code), Comment: s.Message()}
+	}
+	code := obs_grpc.RequestStatus_RequestProcessingFailed
+	switch s.Code() {
+	case codes.Unimplemented:
+		code = obs_grpc.RequestStatus_UnknownRequestType
+	case codes.InvalidArgument:
+		code = obs_grpc.RequestStatus_InvalidRequestField
+	case codes.Unavailable:
+		code = obs_grpc.RequestStatus_NotReady
+	}
+	return RequestStatus{Code: int(code), Comment: s.Message()}
+}
+
+func (b *ClientBackend) SubscribeToEvents(ctx context.Context) (<-chan Event, error) {
+	stream, err := b.OBSClient.SubscribeToEvents(ctx, &obs_grpc.SubscribeToEventsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan Event)
+	go func() {
+		defer close(ch)
+		for {
+			ev, err := stream.Recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+					logger.Errorf(ctx, "unable to receive an event: %v", err)
+				}
+				return
+			}
+			if ev.GetGap() != nil {
+				logger.Warnf(ctx, "some events were lost: %v", ev.GetGap())
+				continue
+			}
+			msg := Event{
+				EventType:   ev.GetEventType(),
+				EventIntent: int(ev.GetEventSubscription()),
+			}
+			envelope := ev.ProtoReflect()
+			if fd := envelope.WhichOneof(envelope.Descriptor().Oneofs().ByName("Union")); fd != nil {
+				msg.EventData = obsgrpcproxy.Protobuf2OBSJSON(envelope.Get(fd).Message())
+			}
+			select {
+			case ch <- msg:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
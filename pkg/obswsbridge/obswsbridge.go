@@ -0,0 +1,314 @@
+// Package obswsbridge implements an obs-websocket (v5) server. By default
+// it is backed by an OBSClient, so that the tools speaking obs-websocket
+// could work with an OBS reachable only via obsgrpcproxy.
+package obswsbridge
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/gorilla/websocket"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+)
+
+// Backend executes the requests received by a Server and provides
+// the events to relay to the clients.
+type Backend interface {
+	// OBSWebSocketVersion returns the version reported in message Hello.
+	OBSWebSocketVersion(ctx context.Context) string
+
+	ExecuteRequest(ctx context.Context, requestType string, requestData map[string]any) (map[string]any, RequestStatus)
+
+	// SubscribeToEvents returns a channel of the events, which is closed
+	// when the context is cancelled or there will be no more events.
+	SubscribeToEvents(ctx context.Context) (<-chan Event, error)
+}
+
+// Server is an http.Handler serving obs-websocket connections. Each request
+// is executed by the Backend, and the events of the Backend are relayed
+// to the identified clients.
+type Server struct {
+	backend  Backend
+	cfg      configT
+	upgrader websocket.Upgrader
+}
+
+var _ http.Handler = (*Server)(nil)
+
+// New returns a Server forwarding the requests to the OBSClient and
+// relaying the events received from its SubscribeToEvents.
+func New(client obs_grpc.OBSClient, opts ...Option) *Server {
+	return NewServer(&ClientBackend{OBSClient: client}, opts...)
+}
+
+func NewServer(backend Backend, opts ...Option) *Server {
+	return &Server{
+		backend: backend,
+		cfg:     Options(opts).config(),
+		upgrader: websocket.Upgrader{
+			Subprotocols: []string{Subprotocol},
+			// the same as obs-websocket: the clients are not limited by origin
+			CheckOrigin: func(*http.Request) bool { return true },
+		},
+	}
+}
+
+func (srv *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	conn, err := srv.upgrader.Upgrade(w, req, nil)
+	if err != nil {
+		logger.Debugf(ctx, "unable to upgrade the connection from %s: %v", req.RemoteAddr, err)
+		return
+	}
+	defer conn.Close()
+
+	logger.Debugf(ctx, "new obs-websocket connection from %s", req.RemoteAddr)
+	s := &session{
+		server: srv,
+		conn:   conn,
+	}
+	err = s.serve(ctx)
+	logger.Debugf(ctx, "obs-websocket connection from %s ended: %v", req.RemoteAddr, err)
+}
+
+type session struct {
+	server *Server
+	conn   *websocket.Conn
+
+	writeLocker        sync.Mutex
+	eventSubscriptions atomic.Uint32
+}
+
+// closeError is returned by the handlers to close the connection with
+// the code.
+type closeError struct {
+	Code   CloseCode
+	Reason string
+}
+
+func (err closeError) Error() string {
+	return fmt.Sprintf("%s (%d)", err.Reason, err.Code)
+}
+
+func (s *session) serve(ctx context.Context) error {
+	ctx, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
+
+	err := s.handshake(ctx)
+	if err == nil {
+		go s.relayEvents(ctx)
+		err = s.serveMessages(ctx)
+	}
+	var closeErr closeError
+	if errors.As(err, &closeErr) {
+		s.writeLocker.Lock()
+		defer s.writeLocker.Unlock()
+		s.conn.WriteMessage(
+			websocket.CloseMessage,
+			websocket.FormatCloseMessage(int(closeErr.Code), closeErr.Reason),
+		)
+	}
+	return err
+}
+
+func (s *session) handshake(ctx context.Context) error {
+	h := hello{
+		OBSWebSocketVersion: s.server.backend.OBSWebSocketVersion(ctx),
+		RPCVersion:          RPCVersion,
+	}
+	if s.server.cfg.Password != "" {
+		h.Authentication = &helloAuthentication{
+			Challenge: randomString(),
+			Salt:      randomString(),
+		}
+	}
+	if err := s.send(OpCodeHello, h); err != nil {
+		return err
+	}
+
+	op, d, err := s.receive()
+	if err != nil {
+		return err
+	}
+	if op != OpCodeIdentify {
+		return closeError{Code: CloseCodeNotIdentified, Reason: "The session has not been identified yet."}
+	}
+	var msg identify
+	if err := json.Unmarshal(d, &msg); err != nil {
+		return closeError{Code: CloseCodeInvalidDataFieldType, Reason: err.Error()}
+	}
+	if msg.RPCVersion != RPCVersion {
+		return closeError{Code: CloseCodeUnsupportedRPCVersion, Reason: fmt.Sprintf("Only RPC version %d is supported.", RPCVersion)}
+	}
+	if auth := h.Authentication; auth != nil {
+		if msg.Authentication != authenticationString(s.server.cfg.Password, auth.Salt, auth.Challenge) {
+			return closeError{Code: CloseCodeAuthenticationFailed, Reason: "Authentication failed."}
+		}
+	}
+	s.setEventSubscriptions(msg.EventSubscriptions)
+	return s.send(OpCodeIdentified, identified{NegotiatedRPCVersion: RPCVersion})
+}
+
+func (s *session) setEventSubscriptions(subscriptions *uint32) {
+	if subscriptions == nil {
+		s.eventSubscriptions.Store(uint32(obs_grpc.EventSubscription_All))
+		return
+	}
+	s.eventSubscriptions.Store(*subscriptions)
+}
+
+func (s *session) serveMessages(ctx context.Context) error {
+	for {
+		op, d, err := s.receive()
+		if err != nil {
+			return err
+		}
+		switch op {
+		case OpCodeIdentify:
+			return closeError{Code: CloseCodeAlreadyIdentified, Reason: "The session has already been identified."}
+		case OpCodeReidentify:
+			var msg reidentify
+			if err := json.Unmarshal(d, &msg); err != nil {
+				return closeError{Code: CloseCodeInvalidDataFieldType, Reason: err.Error()}
+			}
+			s.setEventSubscriptions(msg.EventSubscriptions)
+			if err := s.send(OpCodeIdentified, identified{NegotiatedRPCVersion: RPCVersion}); err != nil {
+				return err
+			}
+		case OpCodeRequest:
+			var msg request
+			if err := json.Unmarshal(d, &msg); err != nil {
+				return closeError{Code: CloseCodeInvalidDataFieldType, Reason: err.Error()}
+			}
+			go func() {
+				resp := s.server.executeRequest(ctx, msg)
+				if err := s.send(OpCodeRequestResponse, resp); err != nil {
+					logger.Debugf(ctx, "unable to send the response to request '%s': %v", msg.RequestID, err)
+				}
+			}()
+		case OpCodeRequestBatch:
+			var msg requestBatch
+			if err := json.Unmarshal(d, &msg); err != nil {
+				return closeError{Code: CloseCodeInvalidDataFieldType, Reason: err.Error()}
+			}
+			go func() {
+				resp := s.server.executeRequestBatch(ctx, msg)
+				if err := s.send(OpCodeRequestBatchResponse, resp); err != nil {
+					logger.Debugf(ctx, "unable to send the response to request batch '%s': %v", msg.RequestID, err)
+				}
+			}()
+		default:
+			return closeError{Code: CloseCodeUnknownOpCode, Reason: fmt.Sprintf("Unknown OpCode %d.", op)}
+		}
+	}
+}
+
+func (s *session) receive() (OpCode, json.RawMessage, error) {
+	msgType, b, err := s.conn.ReadMessage()
+	if err != nil {
+		return 0, nil, fmt.Errorf("unable to read a message: %w", err)
+	}
+	if msgType != websocket.TextMessage {
+		return 0, nil, closeError{Code: CloseCodeMessageDecodeError, Reason: "Only JSON text messages are supported."}
+	}
+	var msg message
+	if err := json.Unmarshal(b, &msg); err != nil {
+		return 0, nil, closeError{Code: CloseCodeMessageDecodeError, Reason: err.Error()}
+	}
+	if len(msg.D) == 0 {
+		return 0, nil, closeError{Code: CloseCodeMissingDataField, Reason: "Your payload is missing data (`d`)."}
+	}
+	return msg.Op, msg.D, nil
+}
+
+func (s *session) send(op OpCode, d any) error {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Errorf("unable to serialize %T: %w", d, err)
+	}
+	s.writeLocker.Lock()
+	defer s.writeLocker.Unlock()
+	return s.conn.WriteJSON(message{Op: op, D: b})
+}
+
+func (s *session) relayEvents(ctx context.Context) {
+	ch, err := s.server.backend.SubscribeToEvents(ctx)
+	if err != nil {
+		logger.Errorf(ctx, "unable to subscribe to the events: %v", err)
+		return
+	}
+	for ev := range ch {
+		if s.eventSubscriptions.Load()&uint32(ev.EventIntent) == 0 {
+			continue
+		}
+		if err := s.send(OpCodeEvent, ev); err != nil {
+			logger.Debugf(ctx, "unable to send event '%s': %v", ev.EventType, err)
+			return
+		}
+	}
+}
+
+func (srv *Server) executeRequest(
+	ctx context.Context,
+	req request,
+) requestResponse {
+	result := requestResponse{
+		RequestType: req.RequestType,
+		RequestID:   req.RequestID,
+	}
+	result.ResponseData, result.RequestStatus = srv.backend.ExecuteRequest(ctx, req.RequestType, req.RequestData)
+	if len(result.ResponseData) == 0 {
+		result.ResponseData = nil
+	}
+	return result
+}
+
+// executeRequestBatch executes the requests of the batch. Execution type
+// SerialFrame is handled as SerialRealtime (there are no frames here).
+func (srv *Server) executeRequestBatch(
+	ctx context.Context,
+	batch requestBatch,
+) requestBatchResponse {
+	result := requestBatchResponse{
+		RequestID: batch.RequestID,
+		Results:   []requestResponse{},
+	}
+	if obs_grpc.RequestBatchExecutionType(batch.ExecutionType) != obs_grpc.RequestBatchExecutionType_Parallel {
+		for _, req := range batch.Requests {
+			resp := srv.executeRequest(ctx, req)
+			result.Results = append(result.Results, resp)
+			if !resp.RequestStatus.Result && batch.HaltOnFailure {
+				break
+			}
+		}
+		return result
+	}
+
+	result.Results = make([]requestResponse, len(batch.Requests))
+	var wg sync.WaitGroup
+	for idx, req := range batch.Requests {
+		wg.Add(1)
+		go func(idx int, req request) {
+			defer wg.Done()
+			result.Results[idx] = srv.executeRequest(ctx, req)
+		}(idx, req)
+	}
+	wg.Wait()
+	return result
+}
+
+func randomString() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Errorf("unable to get random bytes: %w", err))
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
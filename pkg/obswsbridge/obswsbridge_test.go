@@ -0,0 +1,163 @@
+package obswsbridge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andreykaipov/goobs"
+	"github.com/andreykaipov/goobs/api/events"
+	"github.com/andreykaipov/goobs/api/requests/inputs"
+	"github.com/andreykaipov/goobs/api/requests/sceneitems"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsgrpcproxy"
+	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type fakeServer struct {
+	obs_grpc.UnimplementedOBSServer
+	Events []any
+}
+
+func (srv *fakeServer) GetVersion(
+	ctx context.Context,
+	req *obs_grpc.GetVersionRequest,
+) (*obs_grpc.GetVersionResponse, error) {
+	return &obs_grpc.GetVersionResponse{
+		ObsVersion:          []byte("30.2.0"),
+		ObsWebSocketVersion: []byte("5.5.0"),
+		RpcVersion:          RPCVersion,
+	}, nil
+}
+
+func (srv *fakeServer) GetInputMute(
+	ctx context.Context,
+	req *obs_grpc.GetInputMuteRequest,
+) (*obs_grpc.GetInputMuteResponse, error) {
+	if req.GetInputName() != "Mic" {
+		return nil, fmt.Errorf("query error: request GetInputMute: ResourceNotFound (600): No source was found by the name of `%s`.", req.GetInputName())
+	}
+	return &obs_grpc.GetInputMuteResponse{InputMuted: true}, nil
+}
+
+func (srv *fakeServer) GetSceneItemId(
+	ctx context.Context,
+	req *obs_grpc.GetSceneItemIdRequest,
+) (*obs_grpc.GetSceneItemIdResponse, error) {
+	return &obs_grpc.GetSceneItemIdResponse{SceneItemID: int64(len(req.GetSourceName()))}, nil
+}
+
+func (srv *fakeServer) SubscribeToEvents(
+	req *obs_grpc.SubscribeToEventsRequest,
+	stream obs_grpc.OBS_SubscribeToEventsServer,
+) error {
+	for idx, ev := range srv.Events {
+		envelope, err := obsgrpcproxy.EventGo2Protobuf(ev)
+		if err != nil {
+			return err
+		}
+		envelope.SeqNo = uint64(idx + 1)
+		if err := stream.Send(envelope); err != nil {
+			return err
+		}
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+func newTestBridge(t *testing.T, opts ...Option) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	obs_grpc.RegisterOBSServer(grpcServer, &fakeServer{
+		Events: []any{
+			&events.InputVolumeMeters{},
+			&events.InputMuteStateChanged{InputName: "Mic", InputMuted: true},
+		},
+	})
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	httpServer := httptest.NewServer(New(obs_grpc.NewOBSClient(conn), opts...))
+	t.Cleanup(httpServer.Close)
+	return strings.TrimPrefix(httpServer.URL, "http://")
+}
+
+func TestBridge(t *testing.T) {
+	addr := newTestBridge(t, OptionPassword("secret"))
+
+	_, err := goobs.New(addr, goobs.WithPassword("wrong"))
+	require.Error(t, err)
+
+	client, err := goobs.New(addr, goobs.WithPassword("secret"))
+	require.NoError(t, err)
+	defer client.Disconnect()
+
+	mute, err := client.Inputs.GetInputMute(&inputs.GetInputMuteParams{InputName: ptr("Mic")})
+	require.NoError(t, err)
+	require.True(t, mute.InputMuted)
+
+	_, err = client.Inputs.GetInputMute(&inputs.GetInputMuteParams{InputName: ptr("Speakers")})
+	require.ErrorContains(t, err, "(600)")
+
+	sceneItem, err := client.SceneItems.GetSceneItemId(&sceneitems.GetSceneItemIdParams{SceneName: ptr("Live"), SourceName: ptr("Camera")})
+	require.NoError(t, err)
+	require.Equal(t, len("Camera"), sceneItem.SceneItemId)
+
+	select {
+	case ev := <-client.IncomingEvents:
+		require.Equal(t, &events.InputMuteStateChanged{InputName: "Mic", InputMuted: true}, ev)
+	case <-time.After(10 * time.Second):
+		t.Fatal("no event received")
+	}
+}
+
+func TestBridgeRequestBatch(t *testing.T) {
+	addr := newTestBridge(t)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+addr, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var msg message
+	require.NoError(t, conn.ReadJSON(&msg))
+	require.Equal(t, OpCodeHello, msg.Op)
+	require.NoError(t, conn.WriteJSON(map[string]any{"op": OpCodeIdentify, "d": map[string]any{"rpcVersion": RPCVersion, "eventSubscriptions": 0}}))
+	require.NoError(t, conn.ReadJSON(&msg))
+	require.Equal(t, OpCodeIdentified, msg.Op)
+
+	require.NoError(t, conn.WriteJSON(map[string]any{"op": OpCodeRequestBatch, "d": map[string]any{
+		"requestId":     "batch",
+		"haltOnFailure": true,
+		"requests": []any{
+			map[string]any{"requestType": "GetInputMute", "requestId": "1", "requestData": map[string]any{"inputName": "Mic"}},
+			map[string]any{"requestType": "NoSuchRequest", "requestId": "2"},
+			map[string]any{"requestType": "GetInputMute", "requestId": "3", "requestData": map[string]any{"inputName": "Mic"}},
+		},
+	}}))
+	require.NoError(t, conn.ReadJSON(&msg))
+	require.Equal(t, OpCodeRequestBatchResponse, msg.Op)
+	var resp requestBatchResponse
+	require.NoError(t, json.Unmarshal(msg.D, &resp))
+	require.Equal(t, "batch", resp.RequestID)
+	require.Len(t, resp.Results, 2)
+	require.Equal(t, "1", resp.Results[0].RequestID)
+	require.Equal(t, map[string]any{"inputMuted": true}, resp.Results[0].ResponseData)
+	require.Equal(t, int(obs_grpc.RequestStatus_UnknownRequestType), resp.Results[1].RequestStatus.Code)
+}
+
+func ptr[T any](in T) *T {
+	return &in
+}
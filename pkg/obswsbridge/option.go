@@ -0,0 +1,35 @@
+package obswsbridge
+
+type configT struct {
+	Password string
+}
+
+func defaultConfig() configT {
+	return configT{}
+}
+
+type Option interface {
+	apply(cfg *configT)
+}
+
+type Options []Option
+
+func (s Options) apply(cfg *configT) {
+	for _, opt := range s {
+		opt.apply(cfg)
+	}
+}
+
+func (s Options) config() configT {
+	cfg := defaultConfig()
+	s.apply(&cfg)
+	return cfg
+}
+
+// OptionPassword enables the authentication of the clients with
+// the password. By default the authentication is disabled.
+type OptionPassword string
+
+func (opt OptionPassword) apply(cfg *configT) {
+	cfg.Password = string(opt)
+}
@@ -0,0 +1,128 @@
+package obswsbridge
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+)
+
+const (
+	// RPCVersion is the version of the obs-websocket RPC implemented by Server.
+	RPCVersion = 1
+
+	// Subprotocol is the WebSocket subprotocol of obs-websocket using JSON
+	// (MessagePack is not supported).
+	Subprotocol = "obswebsocket.json"
+
+	defaultOBSWebSocketVersion = "5.0.0"
+)
+
+// OpCode is the type of a message of obs-websocket.
+type OpCode int
+
+const (
+	OpCodeHello                OpCode = 0
+	OpCodeIdentify             OpCode = 1
+	OpCodeIdentified           OpCode = 2
+	OpCodeReidentify           OpCode = 3
+	OpCodeEvent                OpCode = 5
+	OpCodeRequest              OpCode = 6
+	OpCodeRequestResponse      OpCode = 7
+	OpCodeRequestBatch         OpCode = 8
+	OpCodeRequestBatchResponse OpCode = 9
+)
+
+// CloseCode is the WebSocket close code used by obs-websocket to explain
+// why the connection was closed.
+type CloseCode int
+
+const (
+	CloseCodeUnknownReason         CloseCode = 4000
+	CloseCodeMessageDecodeError    CloseCode = 4002
+	CloseCodeMissingDataField      CloseCode = 4003
+	CloseCodeInvalidDataFieldType  CloseCode = 4004
+	CloseCodeInvalidDataFieldValue CloseCode = 4005
+	CloseCodeUnknownOpCode         CloseCode = 4006
+	CloseCodeNotIdentified         CloseCode = 4007
+	CloseCodeAlreadyIdentified     CloseCode = 4008
+	CloseCodeAuthenticationFailed  CloseCode = 4009
+	CloseCodeUnsupportedRPCVersion CloseCode = 4010
+)
+
+type message struct {
+	Op OpCode          `json:"op"`
+	D  json.RawMessage `json:"d"`
+}
+
+type helloAuthentication struct {
+	Challenge string `json:"challenge"`
+	Salt      string `json:"salt"`
+}
+
+type hello struct {
+	OBSWebSocketVersion string               `json:"obsWebSocketVersion"`
+	RPCVersion          int                  `json:"rpcVersion"`
+	Authentication      *helloAuthentication `json:"authentication,omitempty"`
+}
+
+type identify struct {
+	RPCVersion         int     `json:"rpcVersion"`
+	Authentication     string  `json:"authentication,omitempty"`
+	EventSubscriptions *uint32 `json:"eventSubscriptions,omitempty"`
+}
+
+type identified struct {
+	NegotiatedRPCVersion int `json:"negotiatedRpcVersion"`
+}
+
+type reidentify struct {
+	EventSubscriptions *uint32 `json:"eventSubscriptions,omitempty"`
+}
+
+// Event is an event sent to the clients. EventIntent is the event
+// subscription (see obs_grpc.EventSubscription) the event belongs to.
+type Event struct {
+	EventType   string         `json:"eventType"`
+	EventIntent int            `json:"eventIntent"`
+	EventData   map[string]any `json:"eventData,omitempty"`
+}
+
+type request struct {
+	RequestType string         `json:"requestType"`
+	RequestID   string         `json:"requestId"`
+	RequestData map[string]any `json:"requestData,omitempty"`
+}
+
+// RequestStatus is the result of a request; Code is an obs_grpc.RequestStatus.
+type RequestStatus struct {
+	Result  bool   `json:"result"`
+	Code    int    `json:"code"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type requestResponse struct {
+	RequestType   string         `json:"requestType"`
+	RequestID     string         `json:"requestId,omitempty"`
+	RequestStatus RequestStatus  `json:"requestStatus"`
+	ResponseData  map[string]any `json:"responseData,omitempty"`
+}
+
+type requestBatch struct {
+	RequestID     string    `json:"requestId"`
+	HaltOnFailure bool      `json:"haltOnFailure,omitempty"`
+	ExecutionType int       `json:"executionType,omitempty"`
+	Requests      []request `json:"requests"`
+}
+
+type requestBatchResponse struct {
+	RequestID string            `json:"requestId"`
+	Results   []requestResponse `json:"results"`
+}
+
+// authenticationString returns the value of "authentication" of message
+// Identify expected for the password and the values of message Hello.
+func authenticationString(password, salt, challenge string) string {
+	secret := sha256.Sum256([]byte(password + salt))
+	auth := sha256.Sum256([]byte(base64.StdEncoding.EncodeToString(secret[:]) + challenge))
+	return base64.StdEncoding.EncodeToString(auth[:])
+}
@@ -164,6 +164,9 @@ type OBSClient interface {
 	GetMonitorList(ctx context.Context, in *GetMonitorListRequest, opts ...grpc.CallOption) (*GetMonitorListResponse, error)
 	OpenVideoMixProjector(ctx context.Context, in *OpenVideoMixProjectorRequest, opts ...grpc.CallOption) (*OpenVideoMixProjectorResponse, error)
 	OpenSourceProjector(ctx context.Context, in *OpenSourceProjectorRequest, opts ...grpc.CallOption) (*OpenSourceProjectorResponse, error)
+	SubscribeToEvents(ctx context.Context, in *SubscribeToEventsRequest, opts ...grpc.CallOption) (OBS_SubscribeToEventsClient, error)
+	ExecuteRequestSequence(ctx context.Context, in *ExecuteRequestSequenceRequest, opts ...grpc.CallOption) (*ExecuteRequestSequenceResponse, error)
+	GetProxyCapabilities(ctx context.Context, in *GetProxyCapabilitiesRequest, opts ...grpc.CallOption) (*GetProxyCapabilitiesResponse, error)
 }
 
 type oBSClient struct {
@@ -1452,6 +1455,56 @@ func (c *oBSClient) OpenSourceProjector(ctx context.Context, in *OpenSourceProje
 	return out, nil
 }
 
+func (c *oBSClient) SubscribeToEvents(ctx context.Context, in *SubscribeToEventsRequest, opts ...grpc.CallOption) (OBS_SubscribeToEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OBS_ServiceDesc.Streams[0], "/OBS/SubscribeToEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oBSSubscribeToEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OBS_SubscribeToEventsClient interface {
+	Recv() (*EventEnvelope, error)
+	grpc.ClientStream
+}
+
+type oBSSubscribeToEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *oBSSubscribeToEventsClient) Recv() (*EventEnvelope, error) {
+	m := new(EventEnvelope)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *oBSClient) ExecuteRequestSequence(ctx context.Context, in *ExecuteRequestSequenceRequest, opts ...grpc.CallOption) (*ExecuteRequestSequenceResponse, error) {
+	out := new(ExecuteRequestSequenceResponse)
+	err := c.cc.Invoke(ctx, "/OBS/ExecuteRequestSequence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oBSClient) GetProxyCapabilities(ctx context.Context, in *GetProxyCapabilitiesRequest, opts ...grpc.CallOption) (*GetProxyCapabilitiesResponse, error) {
+	out := new(GetProxyCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/OBS/GetProxyCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OBSServer is the server API for OBS service.
 // All implementations must embed UnimplementedOBSServer
 // for forward compatibility
@@ -1598,6 +1651,9 @@ type OBSServer interface {
 	GetMonitorList(context.Context, *GetMonitorListRequest) (*GetMonitorListResponse, error)
 	OpenVideoMixProjector(context.Context, *OpenVideoMixProjectorRequest) (*OpenVideoMixProjectorResponse, error)
 	OpenSourceProjector(context.Context, *OpenSourceProjectorRequest) (*OpenSourceProjectorResponse, error)
+	SubscribeToEvents(*SubscribeToEventsRequest, OBS_SubscribeToEventsServer) error
+	ExecuteRequestSequence(context.Context, *ExecuteRequestSequenceRequest) (*ExecuteRequestSequenceResponse, error)
+	GetProxyCapabilities(context.Context, *GetProxyCapabilitiesRequest) (*GetProxyCapabilitiesResponse, error)
 	mustEmbedUnimplementedOBSServer()
 }
 
@@ -2031,6 +2087,15 @@ func (UnimplementedOBSServer) OpenVideoMixProjector(context.Context, *OpenVideoM
 func (UnimplementedOBSServer) OpenSourceProjector(context.Context, *OpenSourceProjectorRequest) (*OpenSourceProjectorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OpenSourceProjector not implemented")
 }
+func (UnimplementedOBSServer) SubscribeToEvents(*SubscribeToEventsRequest, OBS_SubscribeToEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeToEvents not implemented")
+}
+func (UnimplementedOBSServer) ExecuteRequestSequence(context.Context, *ExecuteRequestSequenceRequest) (*ExecuteRequestSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteRequestSequence not implemented")
+}
+func (UnimplementedOBSServer) GetProxyCapabilities(context.Context, *GetProxyCapabilitiesRequest) (*GetProxyCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProxyCapabilities not implemented")
+}
 func (UnimplementedOBSServer) mustEmbedUnimplementedOBSServer() {}
 
 // UnsafeOBSServer may be embedded to opt out of forward compatibility for this service.
@@ -4600,6 +4665,63 @@ func _OBS_OpenSourceProjector_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OBS_SubscribeToEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeToEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OBSServer).SubscribeToEvents(m, &oBSSubscribeToEventsServer{stream})
+}
+
+type OBS_SubscribeToEventsServer interface {
+	Send(*EventEnvelope) error
+	grpc.ServerStream
+}
+
+type oBSSubscribeToEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *oBSSubscribeToEventsServer) Send(m *EventEnvelope) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OBS_ExecuteRequestSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteRequestSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OBSServer).ExecuteRequestSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OBS/ExecuteRequestSequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OBSServer).ExecuteRequestSequence(ctx, req.(*ExecuteRequestSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OBS_GetProxyCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProxyCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OBSServer).GetProxyCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OBS/GetProxyCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OBSServer).GetProxyCapabilities(ctx, req.(*GetProxyCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OBS_ServiceDesc is the grpc.ServiceDesc for OBS service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -5175,7 +5297,21 @@ var OBS_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OpenSourceProjector",
 			Handler:    _OBS_OpenSourceProjector_Handler,
 		},
+		{
+			MethodName: "ExecuteRequestSequence",
+			Handler:    _OBS_ExecuteRequestSequence_Handler,
+		},
+		{
+			MethodName: "GetProxyCapabilities",
+			Handler:    _OBS_GetProxyCapabilities_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeToEvents",
+			Handler:       _OBS_SubscribeToEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "obs.proto",
 }
@@ -0,0 +1,689 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: tunnel.proto
+
+package obs_grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TunnelHello is the first message of a tunnel, it identifies the OBS instance.
+type TunnelHello struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceID string `protobuf:"bytes,1,opt,name=instanceID,proto3" json:"instanceID,omitempty"`
+}
+
+func (x *TunnelHello) Reset() {
+	*x = TunnelHello{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelHello) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelHello) ProtoMessage() {}
+
+func (x *TunnelHello) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelHello.ProtoReflect.Descriptor instead.
+func (*TunnelHello) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TunnelHello) GetInstanceID() string {
+	if x != nil {
+		return x.InstanceID
+	}
+	return ""
+}
+
+// TunnelCall starts a call of a method (for example "/OBS/GetVersion")
+// of the OBS service.
+type TunnelCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CallID      uint64 `protobuf:"varint,1,opt,name=callID,proto3" json:"callID,omitempty"`
+	Method      string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	RequestData []byte `protobuf:"bytes,3,opt,name=requestData,proto3" json:"requestData,omitempty"`
+}
+
+func (x *TunnelCall) Reset() {
+	*x = TunnelCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelCall) ProtoMessage() {}
+
+func (x *TunnelCall) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelCall.ProtoReflect.Descriptor instead.
+func (*TunnelCall) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TunnelCall) GetCallID() uint64 {
+	if x != nil {
+		return x.CallID
+	}
+	return 0
+}
+
+func (x *TunnelCall) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *TunnelCall) GetRequestData() []byte {
+	if x != nil {
+		return x.RequestData
+	}
+	return nil
+}
+
+// TunnelCancel cancels a call.
+type TunnelCancel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CallID uint64 `protobuf:"varint,1,opt,name=callID,proto3" json:"callID,omitempty"`
+}
+
+func (x *TunnelCancel) Reset() {
+	*x = TunnelCancel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelCancel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelCancel) ProtoMessage() {}
+
+func (x *TunnelCancel) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelCancel.ProtoReflect.Descriptor instead.
+func (*TunnelCancel) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TunnelCancel) GetCallID() uint64 {
+	if x != nil {
+		return x.CallID
+	}
+	return 0
+}
+
+// TunnelResponse is a response message of a call (a server-streaming call
+// may have many of them).
+type TunnelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CallID       uint64 `protobuf:"varint,1,opt,name=callID,proto3" json:"callID,omitempty"`
+	ResponseData []byte `protobuf:"bytes,2,opt,name=responseData,proto3" json:"responseData,omitempty"`
+}
+
+func (x *TunnelResponse) Reset() {
+	*x = TunnelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelResponse) ProtoMessage() {}
+
+func (x *TunnelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelResponse.ProtoReflect.Descriptor instead.
+func (*TunnelResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TunnelResponse) GetCallID() uint64 {
+	if x != nil {
+		return x.CallID
+	}
+	return 0
+}
+
+func (x *TunnelResponse) GetResponseData() []byte {
+	if x != nil {
+		return x.ResponseData
+	}
+	return nil
+}
+
+// TunnelCallEnd finishes a call with a gRPC status.
+type TunnelCallEnd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CallID        uint64 `protobuf:"varint,1,opt,name=callID,proto3" json:"callID,omitempty"`
+	StatusCode    int32  `protobuf:"varint,2,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
+	StatusMessage string `protobuf:"bytes,3,opt,name=statusMessage,proto3" json:"statusMessage,omitempty"`
+}
+
+func (x *TunnelCallEnd) Reset() {
+	*x = TunnelCallEnd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelCallEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelCallEnd) ProtoMessage() {}
+
+func (x *TunnelCallEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelCallEnd.ProtoReflect.Descriptor instead.
+func (*TunnelCallEnd) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TunnelCallEnd) GetCallID() uint64 {
+	if x != nil {
+		return x.CallID
+	}
+	return 0
+}
+
+func (x *TunnelCallEnd) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *TunnelCallEnd) GetStatusMessage() string {
+	if x != nil {
+		return x.StatusMessage
+	}
+	return ""
+}
+
+type TunnelMessageToOBS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Union:
+	//
+	//	*TunnelMessageToOBS_Call
+	//	*TunnelMessageToOBS_Cancel
+	Union isTunnelMessageToOBS_Union `protobuf_oneof:"Union"`
+}
+
+func (x *TunnelMessageToOBS) Reset() {
+	*x = TunnelMessageToOBS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelMessageToOBS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelMessageToOBS) ProtoMessage() {}
+
+func (x *TunnelMessageToOBS) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelMessageToOBS.ProtoReflect.Descriptor instead.
+func (*TunnelMessageToOBS) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *TunnelMessageToOBS) GetUnion() isTunnelMessageToOBS_Union {
+	if m != nil {
+		return m.Union
+	}
+	return nil
+}
+
+func (x *TunnelMessageToOBS) GetCall() *TunnelCall {
+	if x, ok := x.GetUnion().(*TunnelMessageToOBS_Call); ok {
+		return x.Call
+	}
+	return nil
+}
+
+func (x *TunnelMessageToOBS) GetCancel() *TunnelCancel {
+	if x, ok := x.GetUnion().(*TunnelMessageToOBS_Cancel); ok {
+		return x.Cancel
+	}
+	return nil
+}
+
+type isTunnelMessageToOBS_Union interface {
+	isTunnelMessageToOBS_Union()
+}
+
+type TunnelMessageToOBS_Call struct {
+	Call *TunnelCall `protobuf:"bytes,1,opt,name=call,proto3,oneof"`
+}
+
+type TunnelMessageToOBS_Cancel struct {
+	Cancel *TunnelCancel `protobuf:"bytes,2,opt,name=cancel,proto3,oneof"`
+}
+
+func (*TunnelMessageToOBS_Call) isTunnelMessageToOBS_Union() {}
+
+func (*TunnelMessageToOBS_Cancel) isTunnelMessageToOBS_Union() {}
+
+type TunnelMessageFromOBS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Union:
+	//
+	//	*TunnelMessageFromOBS_Hello
+	//	*TunnelMessageFromOBS_Response
+	//	*TunnelMessageFromOBS_CallEnd
+	Union isTunnelMessageFromOBS_Union `protobuf_oneof:"Union"`
+}
+
+func (x *TunnelMessageFromOBS) Reset() {
+	*x = TunnelMessageFromOBS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelMessageFromOBS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelMessageFromOBS) ProtoMessage() {}
+
+func (x *TunnelMessageFromOBS) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelMessageFromOBS.ProtoReflect.Descriptor instead.
+func (*TunnelMessageFromOBS) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *TunnelMessageFromOBS) GetUnion() isTunnelMessageFromOBS_Union {
+	if m != nil {
+		return m.Union
+	}
+	return nil
+}
+
+func (x *TunnelMessageFromOBS) GetHello() *TunnelHello {
+	if x, ok := x.GetUnion().(*TunnelMessageFromOBS_Hello); ok {
+		return x.Hello
+	}
+	return nil
+}
+
+func (x *TunnelMessageFromOBS) GetResponse() *TunnelResponse {
+	if x, ok := x.GetUnion().(*TunnelMessageFromOBS_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *TunnelMessageFromOBS) GetCallEnd() *TunnelCallEnd {
+	if x, ok := x.GetUnion().(*TunnelMessageFromOBS_CallEnd); ok {
+		return x.CallEnd
+	}
+	return nil
+}
+
+type isTunnelMessageFromOBS_Union interface {
+	isTunnelMessageFromOBS_Union()
+}
+
+type TunnelMessageFromOBS_Hello struct {
+	Hello *TunnelHello `protobuf:"bytes,1,opt,name=hello,proto3,oneof"`
+}
+
+type TunnelMessageFromOBS_Response struct {
+	Response *TunnelResponse `protobuf:"bytes,2,opt,name=response,proto3,oneof"`
+}
+
+type TunnelMessageFromOBS_CallEnd struct {
+	CallEnd *TunnelCallEnd `protobuf:"bytes,3,opt,name=callEnd,proto3,oneof"`
+}
+
+func (*TunnelMessageFromOBS_Hello) isTunnelMessageFromOBS_Union() {}
+
+func (*TunnelMessageFromOBS_Response) isTunnelMessageFromOBS_Union() {}
+
+func (*TunnelMessageFromOBS_CallEnd) isTunnelMessageFromOBS_Union() {}
+
+var File_tunnel_proto protoreflect.FileDescriptor
+
+var file_tunnel_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2d,
+	0x0a, 0x0b, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x1e, 0x0a,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x22, 0x5e, 0x0a,
+	0x0a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x6c,
+	0x6c, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0x26, 0x0a,
+	0x0c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63,
+	0x61, 0x6c, 0x6c, 0x49, 0x44, 0x22, 0x4c, 0x0a, 0x0e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0x6d, 0x0a, 0x0d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x61, 0x6c,
+	0x6c, 0x45, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x69, 0x0a, 0x12, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x4f, 0x42, 0x53, 0x12, 0x21, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x43,
+	0x61, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x27, 0x0a, 0x06, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x06, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x22, 0xa0, 0x01,
+	0x0a, 0x14, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x4f, 0x42, 0x53, 0x12, 0x24, 0x0a, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x65,
+	0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x2d, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x63,
+	0x61, 0x6c, 0x6c, 0x45, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x07,
+	0x63, 0x61, 0x6c, 0x6c, 0x45, 0x6e, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x55, 0x6e, 0x69, 0x6f, 0x6e,
+	0x32, 0x48, 0x0a, 0x09, 0x4f, 0x42, 0x53, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x3b, 0x0a,
+	0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x15, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x42, 0x53, 0x1a,
+	0x13, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x4f, 0x42, 0x53, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x0d, 0x5a, 0x0b, 0x67, 0x6f,
+	0x2f, 0x6f, 0x62, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_tunnel_proto_rawDescOnce sync.Once
+	file_tunnel_proto_rawDescData = file_tunnel_proto_rawDesc
+)
+
+func file_tunnel_proto_rawDescGZIP() []byte {
+	file_tunnel_proto_rawDescOnce.Do(func() {
+		file_tunnel_proto_rawDescData = protoimpl.X.CompressGZIP(file_tunnel_proto_rawDescData)
+	})
+	return file_tunnel_proto_rawDescData
+}
+
+var file_tunnel_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_tunnel_proto_goTypes = []interface{}{
+	(*TunnelHello)(nil),          // 0: TunnelHello
+	(*TunnelCall)(nil),           // 1: TunnelCall
+	(*TunnelCancel)(nil),         // 2: TunnelCancel
+	(*TunnelResponse)(nil),       // 3: TunnelResponse
+	(*TunnelCallEnd)(nil),        // 4: TunnelCallEnd
+	(*TunnelMessageToOBS)(nil),   // 5: TunnelMessageToOBS
+	(*TunnelMessageFromOBS)(nil), // 6: TunnelMessageFromOBS
+}
+var file_tunnel_proto_depIdxs = []int32{
+	1, // 0: TunnelMessageToOBS.call:type_name -> TunnelCall
+	2, // 1: TunnelMessageToOBS.cancel:type_name -> TunnelCancel
+	0, // 2: TunnelMessageFromOBS.hello:type_name -> TunnelHello
+	3, // 3: TunnelMessageFromOBS.response:type_name -> TunnelResponse
+	4, // 4: TunnelMessageFromOBS.callEnd:type_name -> TunnelCallEnd
+	6, // 5: OBSTunnel.Connect:input_type -> TunnelMessageFromOBS
+	5, // 6: OBSTunnel.Connect:output_type -> TunnelMessageToOBS
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_tunnel_proto_init() }
+func file_tunnel_proto_init() {
+	if File_tunnel_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tunnel_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelHello); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelCancel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelCallEnd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelMessageToOBS); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelMessageFromOBS); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_tunnel_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*TunnelMessageToOBS_Call)(nil),
+		(*TunnelMessageToOBS_Cancel)(nil),
+	}
+	file_tunnel_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*TunnelMessageFromOBS_Hello)(nil),
+		(*TunnelMessageFromOBS_Response)(nil),
+		(*TunnelMessageFromOBS_CallEnd)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tunnel_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tunnel_proto_goTypes,
+		DependencyIndexes: file_tunnel_proto_depIdxs,
+		MessageInfos:      file_tunnel_proto_msgTypes,
+	}.Build()
+	File_tunnel_proto = out.File
+	file_tunnel_proto_rawDesc = nil
+	file_tunnel_proto_goTypes = nil
+	file_tunnel_proto_depIdxs = nil
+}
@@ -0,0 +1,137 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: tunnel.proto
+
+package obs_grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// OBSTunnelClient is the client API for OBSTunnel service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OBSTunnelClient interface {
+	Connect(ctx context.Context, opts ...grpc.CallOption) (OBSTunnel_ConnectClient, error)
+}
+
+type oBSTunnelClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOBSTunnelClient(cc grpc.ClientConnInterface) OBSTunnelClient {
+	return &oBSTunnelClient{cc}
+}
+
+func (c *oBSTunnelClient) Connect(ctx context.Context, opts ...grpc.CallOption) (OBSTunnel_ConnectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OBSTunnel_ServiceDesc.Streams[0], "/OBSTunnel/Connect", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oBSTunnelConnectClient{stream}
+	return x, nil
+}
+
+type OBSTunnel_ConnectClient interface {
+	Send(*TunnelMessageFromOBS) error
+	Recv() (*TunnelMessageToOBS, error)
+	grpc.ClientStream
+}
+
+type oBSTunnelConnectClient struct {
+	grpc.ClientStream
+}
+
+func (x *oBSTunnelConnectClient) Send(m *TunnelMessageFromOBS) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *oBSTunnelConnectClient) Recv() (*TunnelMessageToOBS, error) {
+	m := new(TunnelMessageToOBS)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// OBSTunnelServer is the server API for OBSTunnel service.
+// All implementations must embed UnimplementedOBSTunnelServer
+// for forward compatibility
+type OBSTunnelServer interface {
+	Connect(OBSTunnel_ConnectServer) error
+	mustEmbedUnimplementedOBSTunnelServer()
+}
+
+// UnimplementedOBSTunnelServer must be embedded to have forward compatible implementations.
+type UnimplementedOBSTunnelServer struct {
+}
+
+func (UnimplementedOBSTunnelServer) Connect(OBSTunnel_ConnectServer) error {
+	return status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedOBSTunnelServer) mustEmbedUnimplementedOBSTunnelServer() {}
+
+// UnsafeOBSTunnelServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OBSTunnelServer will
+// result in compilation errors.
+type UnsafeOBSTunnelServer interface {
+	mustEmbedUnimplementedOBSTunnelServer()
+}
+
+func RegisterOBSTunnelServer(s grpc.ServiceRegistrar, srv OBSTunnelServer) {
+	s.RegisterService(&OBSTunnel_ServiceDesc, srv)
+}
+
+func _OBSTunnel_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OBSTunnelServer).Connect(&oBSTunnelConnectServer{stream})
+}
+
+type OBSTunnel_ConnectServer interface {
+	Send(*TunnelMessageToOBS) error
+	Recv() (*TunnelMessageFromOBS, error)
+	grpc.ServerStream
+}
+
+type oBSTunnelConnectServer struct {
+	grpc.ServerStream
+}
+
+func (x *oBSTunnelConnectServer) Send(m *TunnelMessageToOBS) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *oBSTunnelConnectServer) Recv() (*TunnelMessageFromOBS, error) {
+	m := new(TunnelMessageFromOBS)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// OBSTunnel_ServiceDesc is the grpc.ServiceDesc for OBSTunnel service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OBSTunnel_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "OBSTunnel",
+	HandlerType: (*OBSTunnelServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Connect",
+			Handler:       _OBSTunnel_Connect_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "tunnel.proto",
+}
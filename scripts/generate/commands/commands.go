@@ -3,14 +3,18 @@ package commands
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/spf13/cobra"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsclientgen"
 	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsdoc"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsdocdiff"
 	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsprotobufgen"
 	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsproxygen"
+	"github.com/xaionaro-go/obs-grpc-proxy/pkg/obsrestgen"
 	protoparser "github.com/yoheimuta/go-protoparser/v4"
 )
 
@@ -42,6 +46,31 @@ var (
 		Run:  proxy,
 	}
 
+	REST = &cobra.Command{
+		Use:  "rest",
+		Args: cobra.ExactArgs(2),
+		Run:  rest,
+	}
+
+	Client = &cobra.Command{
+		Use:  "client",
+		Args: cobra.ExactArgs(2),
+		Run:  client,
+	}
+
+	ConformanceTest = &cobra.Command{
+		Use:  "conformance-test",
+		Args: cobra.ExactArgs(2),
+		Run:  conformanceTest,
+	}
+
+	Diff = &cobra.Command{
+		Use:   "diff old_protocol.json new_protocol.json",
+		Short: "prints the changelog between two versions of protocol.json",
+		Args:  cobra.ExactArgs(2),
+		Run:   diff,
+	}
+
 	LoggerLevel = logger.LevelWarning
 )
 
@@ -49,6 +78,12 @@ func init() {
 	Root.PersistentFlags().Var(&LoggerLevel, "log-level", "")
 	Root.AddCommand(Protobuf)
 	Root.AddCommand(Proxy)
+	Root.AddCommand(REST)
+	Root.AddCommand(Client)
+	Root.AddCommand(ConformanceTest)
+	Root.AddCommand(Diff)
+
+	Diff.Flags().Bool("fail-on-breaking", false, "exit with a non-zero code if there are breaking changes")
 }
 func assertNoError(ctx context.Context, err error) {
 	if err != nil {
@@ -119,3 +154,94 @@ func proxy(cmd *cobra.Command, args []string) {
 	err = obsproxygen.Generate(ctx, proxyFile, protocol, staticProto)
 	assertNoError(ctx, err)
 }
+
+func rest(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	protocolFilePath := args[0]
+	restOutFilePath := args[1]
+
+	protocolBytes, err := os.ReadFile(protocolFilePath)
+	assertNoError(ctx, err)
+
+	protocol, err := obsdoc.ParseProtocol(protocolBytes)
+	assertNoError(ctx, err)
+
+	restFile, err := os.OpenFile(restOutFilePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	assertNoError(ctx, err)
+	defer restFile.Close()
+
+	err = obsrestgen.Generate(ctx, restFile, protocol)
+	assertNoError(ctx, err)
+}
+
+func client(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	protocolFilePath := args[0]
+	clientOutFilePath := args[1]
+
+	protocolBytes, err := os.ReadFile(protocolFilePath)
+	assertNoError(ctx, err)
+
+	protocol, err := obsdoc.ParseProtocol(protocolBytes)
+	assertNoError(ctx, err)
+
+	clientFile, err := os.OpenFile(clientOutFilePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	assertNoError(ctx, err)
+	defer clientFile.Close()
+
+	err = obsclientgen.Generate(ctx, clientFile, protocol)
+	assertNoError(ctx, err)
+}
+
+func conformanceTest(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	protocolFilePath := args[0]
+	testOutFilePath := args[1]
+
+	protocolBytes, err := os.ReadFile(protocolFilePath)
+	assertNoError(ctx, err)
+
+	protocol, err := obsdoc.ParseProtocol(protocolBytes)
+	assertNoError(ctx, err)
+
+	testFile, err := os.OpenFile(testOutFilePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	assertNoError(ctx, err)
+	defer testFile.Close()
+
+	err = obsproxygen.GenerateConformanceTests(ctx, testFile, protocol)
+	assertNoError(ctx, err)
+}
+
+func diff(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	oldProtocolFilePath := args[0]
+	newProtocolFilePath := args[1]
+
+	failOnBreaking, err := cmd.Flags().GetBool("fail-on-breaking")
+	assertNoError(ctx, err)
+
+	oldProtocolBytes, err := os.ReadFile(oldProtocolFilePath)
+	assertNoError(ctx, err)
+
+	oldProtocol, err := obsdoc.ParseProtocol(oldProtocolBytes)
+	assertNoError(ctx, err)
+
+	newProtocolBytes, err := os.ReadFile(newProtocolFilePath)
+	assertNoError(ctx, err)
+
+	newProtocol, err := obsdoc.ParseProtocol(newProtocolBytes)
+	assertNoError(ctx, err)
+
+	changes := obsdocdiff.Diff(oldProtocol, newProtocol)
+	err = obsdocdiff.WriteChangelog(cmd.OutOrStdout(), changes)
+	assertNoError(ctx, err)
+
+	if breaking := changes.Breaking(); failOnBreaking && len(breaking) > 0 {
+		fmt.Fprintf(cmd.ErrOrStderr(), "breaking changes: %d\n", len(breaking))
+		os.Exit(1)
+	}
+}